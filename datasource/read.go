@@ -5,6 +5,7 @@ package datasource
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -20,8 +21,25 @@ type ReadRequest struct {
 	// from knowing the value at request time.
 	Config tfsdk.Config
 
+	// Private is provider-defined data source private data which was saved by
+	// the most recent successful Read of this data source type. Terraform
+	// does not store private data for data sources, so the data only lasts
+	// for the lifetime of the provider process and is shared by every
+	// configuration of the data source type. Include an identifying value,
+	// such as the remote object ID, in keys which should only apply to one
+	// configuration. Any existing data is copied to ReadResponse.Private to
+	// prevent accidental private data loss.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// ReadResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ReadDataSource RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ReadClientCapabilities
 }
 
 // ReadResponse represents a response to a ReadRequest. An
@@ -33,8 +51,25 @@ type ReadResponse struct {
 	// This field should be set during the resource's Read operation.
 	State tfsdk.State
 
+	// Private is the data source private data following the Read operation.
+	// This field is pre-populated from ReadRequest.Private and can be
+	// modified during the data source's Read operation. It is saved for the
+	// next Read of this data source type if no error diagnostics are
+	// returned.
+	Private *privatestate.ProviderData
+
 	// Diagnostics report errors or warnings related to reading the data
 	// source. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer this data source read until a
+	// followup apply operation.
+	//
+	// This field can only be set if
+	// `(datasource.ReadRequest).ClientCapabilities.DeferralAllowed` is true.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	Deferred *Deferred
 }
@@ -185,3 +185,9 @@ func (a BoolAttribute) IsRequired() bool {
 func (a BoolAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly returns false as write-only attributes are not supported in
+// data source schemas, which describe data that is only read.
+func (a BoolAttribute) IsWriteOnly() bool {
+	return false
+}
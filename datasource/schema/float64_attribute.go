@@ -188,3 +188,9 @@ func (a Float64Attribute) IsRequired() bool {
 func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly returns false as write-only attributes are not supported in
+// data source schemas, which describe data that is only read.
+func (a Float64Attribute) IsWriteOnly() bool {
+	return false
+}
@@ -188,3 +188,9 @@ func (a Int64Attribute) IsRequired() bool {
 func (a Int64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly returns false as write-only attributes are not supported in
+// data source schemas, which describe data that is only read.
+func (a Int64Attribute) IsWriteOnly() bool {
+	return false
+}
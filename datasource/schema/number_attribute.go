@@ -185,6 +185,12 @@ func (a NumberAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns false as write-only attributes are not supported in
+// data source schemas, which describe data that is only read.
+func (a NumberAttribute) IsWriteOnly() bool {
+	return false
+}
+
 // NumberValidators returns the Validators field value.
 func (a NumberAttribute) NumberValidators() []validator.Number {
 	return a.Validators
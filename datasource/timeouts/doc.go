@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeouts contains the framework-provided timeouts block and
+// attribute for data source schemas, which enable practitioners to configure
+// how long the read operation can take.
+//
+// Add the timeouts to the data source schema with the Block or Attributes
+// functions, then read the configured timeout in the data source Read method
+// with a Value field in the data source data model:
+//
+//	type exampleDataSourceModel struct {
+//		Timeouts timeouts.Value `tfsdk:"timeouts"`
+//	}
+//
+// The ReadContext method returns a context with a deadline for the read
+// operation.
+package timeouts
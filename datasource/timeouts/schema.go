@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Block returns a schema.Block containing the read timeout attribute. The
+// block is named "timeouts" by convention and configured as:
+//
+//	timeouts {
+//		read = "60m"
+//	}
+func Block(ctx context.Context) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: timeoutsAttributes(),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes(),
+			},
+		},
+	}
+}
+
+// Attributes returns a schema.SingleNestedAttribute containing the read
+// timeout attribute. The attribute is named "timeouts" by convention and
+// configured as:
+//
+//	timeouts = {
+//		read = "60m"
+//	}
+//
+// Nested attributes are only compatible with protocol version 6.
+func Attributes(ctx context.Context) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: timeoutsAttributes(),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes(),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// attributeTypes returns the object attribute types of the timeouts.
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		fwtimeouts.AttributeNameRead: types.StringType,
+	}
+}
+
+// timeoutsAttributes returns the schema attributes of the timeouts.
+func timeoutsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		fwtimeouts.AttributeNameRead: schema.StringAttribute{
+			Description: fwtimeouts.AttributeDescription,
+			Optional:    true,
+			Validators: []validator.String{
+				fwtimeouts.DurationValidator{},
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	expected := schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"read": schema.StringAttribute{
+				Description: fwtimeouts.AttributeDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					fwtimeouts.DurationValidator{},
+				},
+			},
+		},
+		CustomType: timeouts.Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"read": types.StringType,
+				},
+			},
+		},
+	}
+
+	got := timeouts.Block(context.Background())
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	t.Parallel()
+
+	expected := schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"read": schema.StringAttribute{
+				Description: fwtimeouts.AttributeDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					fwtimeouts.DurationValidator{},
+				},
+			},
+		},
+		CustomType: timeouts.Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"read": types.StringType,
+				},
+			},
+		},
+		Optional: true,
+	}
+
+	got := timeouts.Attributes(context.Background())
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
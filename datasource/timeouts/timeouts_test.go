@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueRead(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"read": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		value         timeouts.Value
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value: timeouts.Value{
+				Object: types.ObjectNull(testAttrTypes),
+			},
+			expected: 20 * time.Minute,
+		},
+		"read-null": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"read": types.StringNull(),
+				}),
+			},
+			expected: 20 * time.Minute,
+		},
+		"read": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"read": types.StringValue("5m"),
+				}),
+			},
+			expected: 5 * time.Minute,
+		},
+		"read-invalid": {
+			value: timeouts.Value{
+				Object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"read": types.StringValue("invalid"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`The "read" timeout cannot be parsed: time: invalid duration "invalid"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.Read(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ArgumentsData is the zero-based positional argument data sent by Terraform
+// for a single function call. Use the Get method or GetArgument method in the
+// Function type Run method to fetch the data.
+//
+// This data is automatically populated by the framework based on the function
+// definition. For unit testing, use the NewArgumentsData function to manually
+// create the data.
+type ArgumentsData struct {
+	values []attr.Value
+}
+
+// Equal returns true if all the underlying values are equivalent.
+func (d ArgumentsData) Equal(o ArgumentsData) bool {
+	if len(d.values) != len(o.values) {
+		return false
+	}
+
+	for index, value := range d.values {
+		if !value.Equal(o.values[index]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get retrieves all argument data and populates the targets with the values.
+// All arguments must be present in the targets, including all parameters and an
+// optional variadic parameter, otherwise an error will be returned. Each target
+// must be a pointer and follows the same rules as tfsdk.Config.Get, where
+// struct fields need a tfsdk tag.
+//
+// The variadic parameter argument data, if defined in the function definition,
+// is always the final target and is represented as a list of the variadic
+// parameter data type. Each target type must be acceptable for the data type
+// in the parameter definition.
+func (d ArgumentsData) Get(ctx context.Context, targets ...any) *FuncError {
+	var funcErr *FuncError
+
+	if len(d.values) == 0 {
+		funcErr = ConcatFuncErrors(funcErr, NewFuncError(
+			"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. "+
+				"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+				"Function does not have argument data.",
+		))
+
+		return funcErr
+	}
+
+	if len(targets) != len(d.values) {
+		funcErr = ConcatFuncErrors(funcErr, NewFuncError(
+			"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. "+
+				"The Get call requires all parameters and the final variadic parameter, if implemented, to be in the targets. "+
+				"This is always an error in the provider code and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Given targets count: %d, expected targets count: %d", len(targets), len(d.values)),
+		))
+
+		return funcErr
+	}
+
+	for position, attrValue := range d.values {
+		funcErr = ConcatFuncErrors(funcErr, d.get(ctx, int64(position), attrValue, targets[position]))
+	}
+
+	return funcErr
+}
+
+// GetArgument retrieves the argument data found at the given zero-based
+// position and populates the target with the value. The target must be a
+// pointer and follows the same rules as tfsdk.Config.Get, where struct fields
+// need a tfsdk tag.
+//
+// The variadic parameter argument data, if defined in the function definition,
+// is always the final position and is represented as a list of the variadic
+// parameter data type. The target type must be acceptable for the data type in
+// the parameter definition.
+func (d ArgumentsData) GetArgument(ctx context.Context, position int, target any) *FuncError {
+	if len(d.values) == 0 {
+		return NewArgumentFuncError(
+			int64(position),
+			"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. "+
+				"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+				"Function does not have argument data.",
+		)
+	}
+
+	if position < 0 || position >= len(d.values) {
+		return NewArgumentFuncError(
+			int64(position),
+			"Invalid Argument Data Position: When attempting to fetch argument data during the function call, the provider code attempted to read a non-existent argument position. "+
+				"Function argument positions are 0-based and any final variadic parameter is represented as one argument position with a list of the variadic parameter data type. "+
+				"This is always an error in the provider code and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Given argument position: %d, last argument position: %d", position, len(d.values)-1),
+		)
+	}
+
+	return d.get(ctx, int64(position), d.values[position], target)
+}
+
+// get populates the target with the given argument value at the given
+// position, returning a function error associated with the argument position
+// if the value cannot be converted.
+func (d ArgumentsData) get(ctx context.Context, position int64, attrValue attr.Value, target any) *FuncError {
+	if attrValue == nil {
+		return NewArgumentFuncError(
+			position,
+			"Invalid Argument Data: When attempting to fetch argument data during the function call, the argument data was missing. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+		)
+	}
+
+	tfValue, err := attrValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		return NewArgumentFuncError(
+			position,
+			"Value Conversion Error: An unexpected error was encountered converting the argument data. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				err.Error(),
+		)
+	}
+
+	diags := reflect.Into(ctx, attrValue.Type(ctx), tfValue, target, reflect.Options{}, path.Empty())
+
+	return argumentFuncErrorFromDiags(ctx, position, diags)
+}
+
+// argumentFuncErrorFromDiags converts the error diagnostics into a function
+// error associated with the given argument position.
+func argumentFuncErrorFromDiags(ctx context.Context, position int64, diags diag.Diagnostics) *FuncError {
+	funcErr := FuncErrorFromDiags(ctx, diags)
+
+	if funcErr == nil {
+		return nil
+	}
+
+	return NewArgumentFuncError(position, funcErr.Text)
+}
+
+// NewArgumentsData creates an ArgumentsData. This is only necessary for unit
+// testing as the framework automatically creates this data.
+func NewArgumentsData(values []attr.Value) ArgumentsData {
+	return ArgumentsData{
+		values: values,
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestArgumentsDataEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		argumentsData function.ArgumentsData
+		other         function.ArgumentsData
+		expected      bool
+	}{
+		"zero-zero": {
+			argumentsData: function.ArgumentsData{},
+			other:         function.ArgumentsData{},
+			expected:      true,
+		},
+		"different-length": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolNull(),
+			}),
+			other:    function.ArgumentsData{},
+			expected: false,
+		},
+		"different-value": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+			}),
+			other: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(false),
+			}),
+			expected: false,
+		},
+		"equal": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+			other: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.argumentsData.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestArgumentsDataGet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		argumentsData   function.ArgumentsData
+		targets         []any
+		expected        []any
+		expectedFuncErr *function.FuncError
+	}{
+		"no-argument-data": {
+			argumentsData: function.NewArgumentsData(nil),
+			targets:       []any{new(bool)},
+			expected:      []any{false},
+			expectedFuncErr: function.NewFuncError(
+				"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. " +
+					"This is always an issue in the provider code and should be reported to the provider developers.\n\n" +
+					"Function does not have argument data.",
+			),
+		},
+		"invalid-targets-count": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+			targets:  []any{new(bool)},
+			expected: []any{false},
+			expectedFuncErr: function.NewFuncError(
+				"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. " +
+					"The Get call requires all parameters and the final variadic parameter, if implemented, to be in the targets. " +
+					"This is always an error in the provider code and should be reported to the provider developers.\n\n" +
+					"Given targets count: 1, expected targets count: 2",
+			),
+		},
+		"invalid-target-type": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+			}),
+			targets:  []any{new(string)},
+			expected: []any{""},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Value Conversion Error: An unexpected error was encountered trying to convert tftypes.Value into string. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"can't unmarshal tftypes.Bool into *string, expected string",
+			),
+		},
+		"attr-value": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringNull(),
+				basetypes.NewListValueMust(
+					basetypes.StringType{},
+					[]attr.Value{
+						basetypes.NewStringValue("test1"),
+						basetypes.NewStringValue("test2"),
+					},
+				),
+			}),
+			targets: []any{
+				new(basetypes.BoolValue),
+				new(basetypes.StringValue),
+				new(basetypes.ListValue),
+			},
+			expected: []any{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringNull(),
+				basetypes.NewListValueMust(
+					basetypes.StringType{},
+					[]attr.Value{
+						basetypes.NewStringValue("test1"),
+						basetypes.NewStringValue("test2"),
+					},
+				),
+			},
+		},
+		"reflection": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringNull(),
+				basetypes.NewListValueMust(
+					basetypes.StringType{},
+					[]attr.Value{
+						basetypes.NewStringValue("test1"),
+						basetypes.NewStringValue("test2"),
+					},
+				),
+			}),
+			targets: []any{
+				new(bool),
+				new(*string),
+				new([]string),
+			},
+			expected: []any{
+				true,
+				(*string)(nil),
+				[]string{"test1", "test2"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			funcErr := testCase.argumentsData.Get(context.Background(), testCase.targets...)
+
+			// Prevent awkwardness in the test cases by only comparing errors
+			// without the potential for recursive pointer checks.
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			for position, target := range testCase.targets {
+				got := reflect.ValueOf(target).Elem().Interface()
+
+				if diff := cmp.Diff(got, testCase.expected[position]); diff != "" {
+					t.Errorf("unexpected difference for target %d: %s", position, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestArgumentsDataGetArgument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		argumentsData   function.ArgumentsData
+		position        int
+		target          any
+		expected        any
+		expectedFuncErr *function.FuncError
+	}{
+		"no-argument-data": {
+			argumentsData: function.NewArgumentsData(nil),
+			position:      0,
+			target:        new(bool),
+			expected:      false,
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. "+
+					"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+					"Function does not have argument data.",
+			),
+		},
+		"invalid-position": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+			}),
+			position: 1,
+			target:   new(bool),
+			expected: false,
+			expectedFuncErr: function.NewArgumentFuncError(
+				1,
+				"Invalid Argument Data Position: When attempting to fetch argument data during the function call, the provider code attempted to read a non-existent argument position. "+
+					"Function argument positions are 0-based and any final variadic parameter is represented as one argument position with a list of the variadic parameter data type. "+
+					"This is always an error in the provider code and should be reported to the provider developers.\n\n"+
+					"Given argument position: 1, last argument position: 0",
+			),
+		},
+		"attr-value": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+			position: 1,
+			target:   new(basetypes.StringValue),
+			expected: basetypes.NewStringValue("test"),
+		},
+		"reflection": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+			position: 1,
+			target:   new(string),
+			expected: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			funcErr := testCase.argumentsData.GetArgument(context.Background(), testCase.position, testCase.target)
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			got := reflect.ValueOf(testCase.target).Elem().Interface()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
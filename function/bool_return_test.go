@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestBoolReturnGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef function.BoolReturn
+		expected  attr.Type
+	}{
+		"unset": {
+			returnDef: function.BoolReturn{},
+			expected:  basetypes.BoolType{},
+		},
+		"CustomType": {
+			returnDef: function.BoolReturn{
+				CustomType: testtypes.BoolTypeWithSemanticEquals{},
+			},
+			expected: testtypes.BoolTypeWithSemanticEquals{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.returnDef.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBoolReturnNewResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef       function.BoolReturn
+		expected        function.ResultData
+		expectedFuncErr *function.FuncError
+	}{
+		"unset": {
+			returnDef: function.BoolReturn{},
+			expected:  function.NewResultData(basetypes.NewBoolUnknown()),
+		},
+		"CustomType": {
+			returnDef: function.BoolReturn{
+				CustomType: testtypes.BoolTypeWithSemanticEquals{},
+			},
+			expected: function.NewResultData(testtypes.BoolValueWithSemanticEquals{
+				BoolValue: basetypes.NewBoolUnknown(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := testCase.returnDef.NewResultData(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
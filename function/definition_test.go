@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func TestDefinitionParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition    function.Definition
+		position      int
+		expected      function.Parameter
+		expectedDiags diag.Diagnostics
+	}{
+		"none": {
+			definition: function.Definition{},
+			position:   0,
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Parameter Position for Definition",
+					"When determining the parameter for the given argument position, an invalid value was given. "+
+						"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+						"Function does not implement parameters.\n"+
+						"Given position: 0",
+				),
+			},
+		},
+		"parameters-first": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+					function.Int64Parameter{},
+					function.StringParameter{},
+				},
+			},
+			position: 0,
+			expected: function.BoolParameter{},
+		},
+		"parameters-last": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+					function.Int64Parameter{},
+					function.StringParameter{},
+				},
+			},
+			position: 2,
+			expected: function.StringParameter{},
+		},
+		"parameters-over": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+				},
+			},
+			position: 1,
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Parameter Position for Definition",
+					"When determining the parameter for the given argument position, an invalid value was given. "+
+						"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+						"Max argument position: 0\n"+
+						"Given position: 1",
+				),
+			},
+		},
+		"variadicparameter-and-parameters-select-variadic": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+				},
+				VariadicParameter: function.StringParameter{},
+			},
+			position: 3,
+			expected: function.StringParameter{},
+		},
+		"variadicparameter-only": {
+			definition: function.Definition{
+				VariadicParameter: function.StringParameter{},
+			},
+			position: 0,
+			expected: function.StringParameter{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.definition.Parameter(context.Background(), testCase.position)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefinitionValidateImplementation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition function.Definition
+		expected   function.DefinitionValidateResponse
+	}{
+		"valid-no-params": {
+			definition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{},
+		},
+		"valid-only-variadic": {
+			definition: function.Definition{
+				VariadicParameter: function.StringParameter{
+					Name: "variadic",
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{},
+		},
+		"valid-params-and-variadic": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{
+						Name: "string_param",
+					},
+				},
+				VariadicParameter: function.StringParameter{
+					Name: "variadic",
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{},
+		},
+		"missing-return": {
+			definition: function.Definition{},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Function \"test_function\" - Definition Return field is undefined",
+					),
+				},
+			},
+		},
+		"missing-parameter-name": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{},
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Function \"test_function\" - Parameter at position 0 does not have a name",
+					),
+				},
+			},
+		},
+		"missing-variadic-parameter-name": {
+			definition: function.Definition{
+				VariadicParameter: function.StringParameter{},
+				Return:            function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Function \"test_function\" - The variadic parameter does not have a name",
+					),
+				},
+			},
+		},
+		"conflicting-parameter-names": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{
+						Name: "param",
+					},
+					function.Int64Parameter{
+						Name: "param",
+					},
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Parameter names must be unique. "+
+							"Function \"test_function\" - Parameters at position 0 and 1 have the same name \"param\"",
+					),
+				},
+			},
+		},
+		"conflicting-variadic-parameter-name": {
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{
+						Name: "param",
+					},
+				},
+				VariadicParameter: function.StringParameter{
+					Name: "param",
+				},
+				Return: function.StringReturn{},
+			},
+			expected: function.DefinitionValidateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Parameter names must be unique. "+
+							"Function \"test_function\" - Parameter at position 0 and the variadic parameter have the same name \"param\"",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.DefinitionValidateResponse{}
+
+			testCase.definition.ValidateImplementation(
+				context.Background(),
+				function.DefinitionValidateRequest{
+					FuncName: "test_function",
+				},
+				&got,
+			)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package function contains all interfaces, request types, and response
+// types for a Terraform Provider function implementation.
+//
+// In Terraform, a function is a concept which enables provider developers
+// to offer practitioners a pure function call in their configuration. Functions
+// are defined by a function name, such as "parse_xyz", a definition
+// representing the ordered list of parameter and return types, and logic to
+// compute the result from the given arguments. Functions are not aware of
+// provider configuration and should not call remote systems.
+//
+// The main starting point for implementations in this package is the
+// Function type which represents an instance of a function that has its own
+// definition and logic. The Function implementations are referenced by a
+// [provider.ProviderWithFunctions] type Functions method, which enables the
+// function for practitioner and testing usage.
+//
+// Provider functions require Terraform 1.8 or later.
+package function
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFloat64ReturnGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef function.Float64Return
+		expected  attr.Type
+	}{
+		"unset": {
+			returnDef: function.Float64Return{},
+			expected:  basetypes.Float64Type{},
+		},
+		"CustomType": {
+			returnDef: function.Float64Return{
+				CustomType: testtypes.Float64TypeWithSemanticEquals{},
+			},
+			expected: testtypes.Float64TypeWithSemanticEquals{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.returnDef.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64ReturnNewResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef       function.Float64Return
+		expected        function.ResultData
+		expectedFuncErr *function.FuncError
+	}{
+		"unset": {
+			returnDef: function.Float64Return{},
+			expected:  function.NewResultData(basetypes.NewFloat64Unknown()),
+		},
+		"CustomType": {
+			returnDef: function.Float64Return{
+				CustomType: testtypes.Float64TypeWithSemanticEquals{},
+			},
+			expected: function.NewResultData(testtypes.Float64ValueWithSemanticEquals{
+				Float64Value: basetypes.NewFloat64Unknown(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := testCase.returnDef.NewResultData(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
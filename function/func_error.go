@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// FuncError is an error type specifically for function errors. Function
+// errors are returned to Terraform as a single error message, optionally
+// associated with a function argument position, rather than diagnostics.
+type FuncError struct {
+	// Text is a practitioner-oriented description of the problem. This
+	// should contain sufficient detail to provide both general and more
+	// specific information regarding the issue.
+	Text string
+
+	// FunctionArgument is a zero-based, int64 value that identifies the
+	// specific function argument position that caused the error. Only errors
+	// that pertain to a function argument will include this information.
+	FunctionArgument *int64
+}
+
+// Equal returns true if the other function error is wholly equivalent.
+func (fe *FuncError) Equal(other *FuncError) bool {
+	if fe == nil && other == nil {
+		return true
+	}
+
+	if fe == nil || other == nil {
+		return false
+	}
+
+	if fe.Text != other.Text {
+		return false
+	}
+
+	if fe.FunctionArgument == nil && other.FunctionArgument == nil {
+		return true
+	}
+
+	if fe.FunctionArgument == nil || other.FunctionArgument == nil {
+		return false
+	}
+
+	return *fe.FunctionArgument == *other.FunctionArgument
+}
+
+// Error returns the error text.
+func (fe *FuncError) Error() string {
+	if fe == nil {
+		return ""
+	}
+
+	return fe.Text
+}
+
+// NewFuncError returns a new function error with the given text.
+func NewFuncError(text string) *FuncError {
+	return &FuncError{
+		Text: text,
+	}
+}
+
+// NewArgumentFuncError returns a new function error with the given text and
+// the zero-based function argument position that caused the error.
+func NewArgumentFuncError(functionArgument int64, text string) *FuncError {
+	return &FuncError{
+		Text:             text,
+		FunctionArgument: &functionArgument,
+	}
+}
+
+// ConcatFuncErrors returns a new function error with the text from all the
+// given function errors joined by newlines. The first non-nil function
+// argument position is retained. Nil function errors are skipped and nil is
+// returned if no non-nil function errors are given.
+func ConcatFuncErrors(funcErrs ...*FuncError) *FuncError {
+	var texts []string
+	var functionArgument *int64
+
+	for _, fe := range funcErrs {
+		if fe == nil {
+			continue
+		}
+
+		if fe.Text != "" {
+			texts = append(texts, fe.Text)
+		}
+
+		if functionArgument == nil && fe.FunctionArgument != nil {
+			functionArgument = fe.FunctionArgument
+		}
+	}
+
+	if len(texts) == 0 && functionArgument == nil {
+		return nil
+	}
+
+	return &FuncError{
+		Text:             strings.Join(texts, "\n"),
+		FunctionArgument: functionArgument,
+	}
+}
+
+// FuncErrorFromDiags converts the error diagnostics into a single function
+// error, which joins each error diagnostic summary and detail. Warning
+// diagnostics are logged as they cannot be returned to Terraform. Nil is
+// returned if there are no error diagnostics.
+func FuncErrorFromDiags(ctx context.Context, diags diag.Diagnostics) *FuncError {
+	var funcErr *FuncError
+
+	for _, d := range diags {
+		switch d.Severity() {
+		case diag.SeverityError:
+			funcErr = ConcatFuncErrors(funcErr, NewFuncError(d.Summary()+": "+d.Detail()))
+		case diag.SeverityWarning:
+			logging.FrameworkWarn(
+				ctx,
+				"warning: call function",
+				map[string]interface{}{
+					"summary": d.Summary(),
+					"detail":  d.Detail(),
+				},
+			)
+		}
+	}
+
+	return funcErr
+}
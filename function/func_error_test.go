@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func TestFuncErrorEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		funcErr  *function.FuncError
+		other    *function.FuncError
+		expected bool
+	}{
+		"nil-nil": {
+			expected: true,
+		},
+		"nil-error": {
+			other:    function.NewFuncError("test"),
+			expected: false,
+		},
+		"different-text": {
+			funcErr:  function.NewFuncError("test 1"),
+			other:    function.NewFuncError("test 2"),
+			expected: false,
+		},
+		"different-argument": {
+			funcErr:  function.NewArgumentFuncError(0, "test"),
+			other:    function.NewArgumentFuncError(1, "test"),
+			expected: false,
+		},
+		"missing-argument": {
+			funcErr:  function.NewArgumentFuncError(0, "test"),
+			other:    function.NewFuncError("test"),
+			expected: false,
+		},
+		"equal": {
+			funcErr:  function.NewArgumentFuncError(1, "test"),
+			other:    function.NewArgumentFuncError(1, "test"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.funcErr.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestConcatFuncErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		funcErrs []*function.FuncError
+		expected *function.FuncError
+	}{
+		"empty": {
+			expected: nil,
+		},
+		"all-nil": {
+			funcErrs: []*function.FuncError{nil, nil},
+			expected: nil,
+		},
+		"single": {
+			funcErrs: []*function.FuncError{
+				function.NewFuncError("test"),
+			},
+			expected: function.NewFuncError("test"),
+		},
+		"multiple": {
+			funcErrs: []*function.FuncError{
+				function.NewFuncError("test 1"),
+				nil,
+				function.NewArgumentFuncError(2, "test 2"),
+				function.NewArgumentFuncError(3, "test 3"),
+			},
+			expected: function.NewArgumentFuncError(2, "test 1\ntest 2\ntest 3"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.ConcatFuncErrors(testCase.funcErrs...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFuncErrorFromDiags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected *function.FuncError
+	}{
+		"nil": {
+			expected: nil,
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+			expected: nil,
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary 1", "error detail 1"),
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				diag.NewErrorDiagnostic("error summary 2", "error detail 2"),
+			},
+			expected: function.NewFuncError("error summary 1: error detail 1\nerror summary 2: error detail 2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.FuncErrorFromDiags(context.Background(), testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
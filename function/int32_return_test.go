@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestInt32ReturnGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef function.Int32Return
+		expected  attr.Type
+	}{
+		"unset": {
+			returnDef: function.Int32Return{},
+			expected:  basetypes.Int32Type{},
+		},
+		"CustomType": {
+			returnDef: function.Int32Return{
+				CustomType: testtypes.Int32TypeWithSemanticEquals{},
+			},
+			expected: testtypes.Int32TypeWithSemanticEquals{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.returnDef.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ReturnNewResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef       function.Int32Return
+		expected        function.ResultData
+		expectedFuncErr *function.FuncError
+	}{
+		"unset": {
+			returnDef: function.Int32Return{},
+			expected:  function.NewResultData(basetypes.NewInt32Unknown()),
+		},
+		"CustomType": {
+			returnDef: function.Int32Return{
+				CustomType: testtypes.Int32TypeWithSemanticEquals{},
+			},
+			expected: function.NewResultData(testtypes.Int32ValueWithSemanticEquals{
+				Int32Value: basetypes.NewInt32Unknown(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := testCase.returnDef.NewResultData(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestListParameterGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.ListParameter
+		expected  attr.Type
+	}{
+		"unset": {
+			parameter: function.ListParameter{
+				ElementType: basetypes.StringType{},
+			},
+			expected: basetypes.ListType{
+				ElemType: basetypes.StringType{},
+			},
+		},
+		"CustomType": {
+			parameter: function.ListParameter{
+				CustomType: testtypes.ListTypeWithSemanticEquals{
+					ListType: basetypes.ListType{
+						ElemType: basetypes.StringType{},
+					},
+				},
+				ElementType: basetypes.StringType{},
+			},
+			expected: testtypes.ListTypeWithSemanticEquals{
+				ListType: basetypes.ListType{
+					ElemType: basetypes.StringType{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestMapReturnGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef function.MapReturn
+		expected  attr.Type
+	}{
+		"unset": {
+			returnDef: function.MapReturn{
+				ElementType: basetypes.StringType{},
+			},
+			expected: basetypes.MapType{
+				ElemType: basetypes.StringType{},
+			},
+		},
+		"CustomType": {
+			returnDef: function.MapReturn{
+				CustomType: testtypes.MapTypeWithSemanticEquals{
+					MapType: basetypes.MapType{
+						ElemType: basetypes.StringType{},
+					},
+				},
+				ElementType: basetypes.StringType{},
+			},
+			expected: testtypes.MapTypeWithSemanticEquals{
+				MapType: basetypes.MapType{
+					ElemType: basetypes.StringType{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.returnDef.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapReturnNewResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef       function.MapReturn
+		expected        function.ResultData
+		expectedFuncErr *function.FuncError
+	}{
+		"unset": {
+			returnDef: function.MapReturn{
+				ElementType: basetypes.StringType{},
+			},
+			expected: function.NewResultData(basetypes.NewMapUnknown(basetypes.StringType{})),
+		},
+		"CustomType": {
+			returnDef: function.MapReturn{
+				CustomType: testtypes.MapTypeWithSemanticEquals{
+					MapType: basetypes.MapType{
+						ElemType: basetypes.StringType{},
+					},
+				},
+				ElementType: basetypes.StringType{},
+			},
+			expected: function.NewResultData(testtypes.MapValueWithSemanticEquals{
+				MapValue: basetypes.NewMapUnknown(basetypes.StringType{}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := testCase.returnDef.NewResultData(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
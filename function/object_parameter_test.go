@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestObjectParameterGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.ObjectParameter
+		expected  attr.Type
+	}{
+		"unset": {
+			parameter: function.ObjectParameter{
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: basetypes.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+		},
+		"CustomType": {
+			parameter: function.ObjectParameter{
+				CustomType: testtypes.ObjectTypeWithSemanticEquals{
+					ObjectType: basetypes.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"test_attr": basetypes.StringType{},
+						},
+					},
+				},
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: testtypes.ObjectTypeWithSemanticEquals{
+				ObjectType: basetypes.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_attr": basetypes.StringType{},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestObjectReturnGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef function.ObjectReturn
+		expected  attr.Type
+	}{
+		"unset": {
+			returnDef: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: basetypes.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+		},
+		"CustomType": {
+			returnDef: function.ObjectReturn{
+				CustomType: testtypes.ObjectTypeWithSemanticEquals{
+					ObjectType: basetypes.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"test_attr": basetypes.StringType{},
+						},
+					},
+				},
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: testtypes.ObjectTypeWithSemanticEquals{
+				ObjectType: basetypes.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_attr": basetypes.StringType{},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.returnDef.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectReturnNewResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		returnDef       function.ObjectReturn
+		expected        function.ResultData
+		expectedFuncErr *function.FuncError
+	}{
+		"unset": {
+			returnDef: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: function.NewResultData(basetypes.NewObjectUnknown(map[string]attr.Type{
+				"test_attr": basetypes.StringType{},
+			})),
+		},
+		"CustomType": {
+			returnDef: function.ObjectReturn{
+				CustomType: testtypes.ObjectTypeWithSemanticEquals{
+					ObjectType: basetypes.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"test_attr": basetypes.StringType{},
+						},
+					},
+				},
+				AttributeTypes: map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				},
+			},
+			expected: function.NewResultData(testtypes.ObjectValueWithSemanticEquals{
+				ObjectValue: basetypes.NewObjectUnknown(map[string]attr.Type{
+					"test_attr": basetypes.StringType{},
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := testCase.returnDef.NewResultData(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Parameter is the interface for defining function parameters.
+type Parameter interface {
+	// GetAllowNullValue should return if the parameter accepts a null value.
+	GetAllowNullValue() bool
+
+	// GetAllowUnknownValues should return if the parameter accepts an unknown
+	// value.
+	GetAllowUnknownValues() bool
+
+	// GetDescription should return the plaintext documentation for the
+	// parameter.
+	GetDescription() string
+
+	// GetMarkdownDescription should return the Markdown documentation for the
+	// parameter.
+	GetMarkdownDescription() string
+
+	// GetName should return a usage name for the parameter, which must be
+	// unique within the function definition.
+	GetName() string
+
+	// GetType should return the data type for the parameter, which determines
+	// what data type Terraform requires for configurations setting the argument
+	// during a function call and the argument data type received by the
+	// Function type Run method.
+	GetType() attr.Type
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestResultDataEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resultData function.ResultData
+		other      function.ResultData
+		expected   bool
+	}{
+		"zero-zero": {
+			resultData: function.ResultData{},
+			other:      function.ResultData{},
+			expected:   true,
+		},
+		"zero-value": {
+			resultData: function.ResultData{},
+			other:      function.NewResultData(basetypes.NewBoolValue(true)),
+			expected:   false,
+		},
+		"different-value": {
+			resultData: function.NewResultData(basetypes.NewBoolValue(true)),
+			other:      function.NewResultData(basetypes.NewBoolValue(false)),
+			expected:   false,
+		},
+		"equal": {
+			resultData: function.NewResultData(basetypes.NewBoolValue(true)),
+			other:      function.NewResultData(basetypes.NewBoolValue(true)),
+			expected:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.resultData.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestResultDataSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resultData      function.ResultData
+		value           any
+		expected        attr.Value
+		expectedFuncErr *function.FuncError
+	}{
+		"uninitialized": {
+			resultData: function.ResultData{},
+			value:      true,
+			expected:   nil,
+			expectedFuncErr: function.NewFuncError(
+				"Invalid Result Data: When attempting to set the result data during the function call, the result data was not initialized. " +
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.",
+			),
+		},
+		"attr-value": {
+			resultData: function.NewResultData(basetypes.NewStringUnknown()),
+			value:      basetypes.NewStringValue("test"),
+			expected:   basetypes.NewStringValue("test"),
+		},
+		"reflection": {
+			resultData: function.NewResultData(basetypes.NewListUnknown(basetypes.StringType{})),
+			value:      []string{"test1", "test2"},
+			expected: basetypes.NewListValueMust(
+				basetypes.StringType{},
+				[]attr.Value{
+					basetypes.NewStringValue("test1"),
+					basetypes.NewStringValue("test2"),
+				},
+			),
+		},
+		"reflection-error": {
+			resultData: function.NewResultData(basetypes.NewBoolUnknown()),
+			value:      "test",
+			expected:   basetypes.NewBoolUnknown(),
+			expectedFuncErr: function.NewFuncError(
+				"Value Conversion Error: An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+					"can't unmarshal tftypes.String into *bool, expected boolean",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			funcErr := testCase.resultData.Set(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.resultData.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
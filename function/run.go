@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// RunRequest represents a request for the Function to call its function
+// logic. An instance of this request struct is supplied as an argument to the
+// Function type Run method.
+type RunRequest struct {
+	// Arguments is the data sent from Terraform which contains all the
+	// function arguments. Use the Get or GetArgument methods to retrieve
+	// the argument data.
+	Arguments ArgumentsData
+}
+
+// RunResponse represents a response to a RunRequest. An instance of this
+// response struct is supplied as an argument to the Function type Run method.
+type RunResponse struct {
+	// Error contains errors related to running the function logic. A nil
+	// value indicates success, with no errors generated. Use the
+	// NewFuncError or NewArgumentFuncError functions to create an error
+	// and the ConcatFuncErrors function to combine multiple errors.
+	Error *FuncError
+
+	// Result is the data to be returned to Terraform for the function call.
+	// Use the Set method to save the data.
+	Result ResultData
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var _ Return = SetReturn{}
+
+// SetReturn represents a function return that is an unordered, unique set of a
+// single element type.
+//
+// When setting the value for this return:
+//
+//   - If CustomType is set, use its associated value type.
+//   - Otherwise, use [types.Set] or a Go []T value type, where T is
+//     compatible with the ElementType field.
+type SetReturn struct {
+	// CustomType enables the use of a custom data type in place of the
+	// default [basetypes.SetType]. When setting data, the
+	// [basetypes.SetValuable] implementation associated with this custom
+	// type must be used in place of [types.Set].
+	CustomType basetypes.SetTypable
+
+	// ElementType is the type for all elements of the set. This field must be
+	// set.
+	ElementType attr.Type
+}
+
+// GetType returns the return data type.
+func (r SetReturn) GetType() attr.Type {
+	if r.CustomType != nil {
+		return r.CustomType
+	}
+
+	return basetypes.SetType{
+		ElemType: r.ElementType,
+	}
+}
+
+// NewResultData returns a new result data based on the type.
+func (r SetReturn) NewResultData(ctx context.Context) (ResultData, *FuncError) {
+	value := basetypes.NewSetUnknown(r.ElementType)
+
+	if r.CustomType == nil {
+		return NewResultData(value), nil
+	}
+
+	valuable, diags := r.CustomType.ValueFromSet(ctx, value)
+
+	return NewResultData(valuable), FuncErrorFromDiags(ctx, diags)
+}
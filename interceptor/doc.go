@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package interceptor contains the interface and types for wrapping framework
+// operations, such as a managed resource read, with provider defined logic.
+//
+// Interceptors are configured on the provider server, such as with the
+// providerserver.ServeOpts type Interceptors field, and are called around
+// every operation the framework performs for Terraform. This enables
+// request-scoped behaviors which apply across all resources, data sources,
+// and functions, such as creating tracing spans, audit logging, or injecting
+// request identifiers into the context for API clients.
+//
+// The main starting point for implementations in this package is the
+// Interceptor type.
+package interceptor
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+)
+
+// Interceptor wraps framework operations with provider defined logic.
+// Multiple interceptors form a chain, where the first configured interceptor
+// is the outermost and each calls the next interceptor, with the framework
+// operation itself at the end of the chain.
+//
+// Interceptors are not called when the framework cannot prepare the
+// operation, such as when the request type name is not implemented by the
+// provider or the request data cannot be converted into framework types. In
+// those cases, the error diagnostics are returned to Terraform directly.
+type Interceptor interface {
+	// Intercept is called for each framework operation. The implementation
+	// must call next to continue the chain, optionally with a modified
+	// context, unless the operation should be skipped. To skip the
+	// operation, add an error diagnostic to the response instead of calling
+	// next, otherwise the framework returns an error diagnostic. After next
+	// returns, the response contains the resulting diagnostics of the
+	// operation.
+	Intercept(ctx context.Context, req Request, resp *Response, next Next)
+}
+
+// Next continues the interceptor chain with the given context, eventually
+// calling the framework operation.
+type Next func(ctx context.Context)
+
+// Func is an adapter to allow the use of ordinary functions as an
+// Interceptor.
+type Func func(ctx context.Context, req Request, resp *Response, next Next)
+
+// Intercept satisfies the Interceptor interface by calling f.
+func (f Func) Intercept(ctx context.Context, req Request, resp *Response, next Next) {
+	f(ctx, req, resp, next)
+}
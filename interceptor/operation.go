@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+// Operation is the kind of framework operation being intercepted. The values
+// generally match the names of the underlying Terraform Plugin Protocol RPCs.
+type Operation string
+
+const (
+	// OperationApplyResourceChange is the managed resource create, update,
+	// or delete operation.
+	OperationApplyResourceChange Operation = "ApplyResourceChange"
+
+	// OperationCallFunction is the function call operation.
+	OperationCallFunction Operation = "CallFunction"
+
+	// OperationCloseEphemeralResource is the ephemeral resource close
+	// operation.
+	OperationCloseEphemeralResource Operation = "CloseEphemeralResource"
+
+	// OperationConfigureProvider is the provider configure operation.
+	OperationConfigureProvider Operation = "ConfigureProvider"
+
+	// OperationGetFunctions is the function definitions operation.
+	OperationGetFunctions Operation = "GetFunctions"
+
+	// OperationGetMetadata is the provider metadata operation.
+	OperationGetMetadata Operation = "GetMetadata"
+
+	// OperationGetProviderSchema is the provider schema operation.
+	OperationGetProviderSchema Operation = "GetProviderSchema"
+
+	// OperationGetResourceIdentitySchemas is the managed resource identity
+	// schemas operation.
+	OperationGetResourceIdentitySchemas Operation = "GetResourceIdentitySchemas"
+
+	// OperationImportResourceState is the managed resource import operation.
+	OperationImportResourceState Operation = "ImportResourceState"
+
+	// OperationListResource is the list resource operation. The interceptor
+	// chain wraps the setup of the results stream, not the iteration of the
+	// results, which occurs after the operation returns.
+	OperationListResource Operation = "ListResource"
+
+	// OperationMoveResourceState is the managed resource move operation.
+	OperationMoveResourceState Operation = "MoveResourceState"
+
+	// OperationOpenEphemeralResource is the ephemeral resource open
+	// operation.
+	OperationOpenEphemeralResource Operation = "OpenEphemeralResource"
+
+	// OperationPlanResourceChange is the managed resource plan operation.
+	OperationPlanResourceChange Operation = "PlanResourceChange"
+
+	// OperationReadDataSource is the data source read operation.
+	OperationReadDataSource Operation = "ReadDataSource"
+
+	// OperationReadResource is the managed resource read operation.
+	OperationReadResource Operation = "ReadResource"
+
+	// OperationRenewEphemeralResource is the ephemeral resource renew
+	// operation.
+	OperationRenewEphemeralResource Operation = "RenewEphemeralResource"
+
+	// OperationUpgradeResourceIdentity is the managed resource identity
+	// upgrade operation.
+	OperationUpgradeResourceIdentity Operation = "UpgradeResourceIdentity"
+
+	// OperationUpgradeResourceState is the managed resource state upgrade
+	// operation.
+	OperationUpgradeResourceState Operation = "UpgradeResourceState"
+
+	// OperationValidateDataSourceConfig is the data source configuration
+	// validation operation.
+	OperationValidateDataSourceConfig Operation = "ValidateDataSourceConfig"
+
+	// OperationValidateEphemeralResourceConfig is the ephemeral resource
+	// configuration validation operation.
+	OperationValidateEphemeralResourceConfig Operation = "ValidateEphemeralResourceConfig"
+
+	// OperationValidateListResourceConfig is the list resource configuration
+	// validation operation.
+	OperationValidateListResourceConfig Operation = "ValidateListResourceConfig"
+
+	// OperationValidateProviderConfig is the provider configuration
+	// validation operation. In protocol version 5, this is the
+	// PrepareProviderConfig RPC.
+	OperationValidateProviderConfig Operation = "ValidateProviderConfig"
+
+	// OperationValidateResourceConfig is the managed resource configuration
+	// validation operation. In protocol version 5, this is the
+	// ValidateResourceTypeConfig RPC.
+	OperationValidateResourceConfig Operation = "ValidateResourceConfig"
+)
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+// Request represents the framework operation being intercepted. An instance
+// of this request struct is supplied as an argument to the Interceptor type
+// Intercept method.
+type Request struct {
+	// Operation is the kind of framework operation, such as
+	// OperationReadResource.
+	Operation Operation
+
+	// TypeName is the type name of the managed resource, data source,
+	// ephemeral resource, or list resource, or the name of the function,
+	// which the operation is for. For MoveResourceState, this is the target
+	// resource type name. It is empty for provider-level operations, such as
+	// GetProviderSchema and ConfigureProvider.
+	TypeName string
+}
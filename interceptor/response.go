@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Response represents the outcome of the framework operation being
+// intercepted. An instance of this response struct is supplied as an
+// argument to the Interceptor type Intercept method.
+type Response struct {
+	// Diagnostics report errors or warnings related to the operation. Before
+	// next is called, this contains any diagnostics from earlier in the chain.
+	// After next returns, this contains the diagnostics of the operation.
+	// Interceptors may append diagnostics, which are returned to Terraform.
+	//
+	// For OperationCallFunction, any diagnostics are converted into the
+	// function error returned to Terraform.
+	Diagnostics diag.Diagnostics
+
+	// FunctionError is the error of the function call, if the operation is
+	// OperationCallFunction, since functions do not return diagnostics.
+	// After next returns, this contains the function error, if any.
+	// Interceptors may modify this error, which is returned to Terraform.
+	FunctionError *function.FuncError
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestArgumentsData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input           []*tfprotov5.DynamicValue
+		definition      function.Definition
+		expected        function.ArgumentsData
+		expectedFuncErr *function.FuncError
+	}{
+		"nil": {
+			input:      nil,
+			definition: function.Definition{},
+			expected:   function.NewArgumentsData(nil),
+		},
+		"empty": {
+			input:      []*tfprotov5.DynamicValue{},
+			definition: function.Definition{},
+			expected:   function.NewArgumentsData(nil),
+		},
+		"mismatched-arguments-too-few-arguments": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, nil)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+					function.BoolParameter{},
+				},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewFuncError(
+				"Unexpected Function Arguments Data: " +
+					"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+					"Expected function arguments: 2\n" +
+					"Given function arguments: 1",
+			),
+		},
+		"mismatched-arguments-too-many-arguments": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, nil)),
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, nil)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+				},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewFuncError(
+				"Unexpected Function Arguments Data: " +
+					"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+					"Expected function arguments: 1\n" +
+					"Given function arguments: 2",
+			),
+		},
+		"mismatched-arguments-type": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "false")),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						Name: "param1",
+					},
+				},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Unable to Convert Function Argument: "+
+					"An unexpected error was encountered when converting the function argument from the protocol type. "+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+					"Parameter Name: param1\n"+
+					"Unable to unmarshal DynamicValue: couldn't decode bool: msgpack: invalid code=a5 decoding bool",
+			),
+		},
+		"parameters-null": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, nil)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						Name: "param1",
+					},
+				},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Function Argument: The \"param1\" parameter does not allow a null value.",
+			),
+		},
+		"parameters-null-allownullvalue": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, nil)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						AllowNullValue: true,
+					},
+				},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolNull(),
+			}),
+		},
+		"parameters-unknown": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						Name: "param1",
+					},
+				},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Function Argument: The \"param1\" parameter does not allow an unknown value. "+
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.",
+			),
+		},
+		"parameters-unknown-allowunknownvalues": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						AllowUnknownValues: true,
+					},
+				},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolUnknown(),
+			}),
+		},
+		"parameters-value": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, true)),
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "test")),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+					function.StringParameter{},
+				},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewStringValue("test"),
+			}),
+		},
+		"parameters-value-customtype": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, true)),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{
+						CustomType: testtypes.BoolTypeWithSemanticEquals{},
+					},
+				},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				testtypes.BoolValueWithSemanticEquals{BoolValue: basetypes.NewBoolValue(true)},
+			}),
+		},
+		"variadicparameter-zero": {
+			input: []*tfprotov5.DynamicValue{},
+			definition: function.Definition{
+				VariadicParameter: function.StringParameter{},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				basetypes.NewListValueMust(basetypes.StringType{}, []attr.Value{}),
+			}),
+		},
+		"variadicparameter-multiple": {
+			input: []*tfprotov5.DynamicValue{
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, true)),
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "varg-arg1")),
+				testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "varg-arg2")),
+			},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+				},
+				VariadicParameter: function.StringParameter{},
+			},
+			expected: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewListValueMust(
+					basetypes.StringType{},
+					[]attr.Value{
+						basetypes.NewStringValue("varg-arg1"),
+						basetypes.NewStringValue("varg-arg2"),
+					},
+				),
+			}),
+		},
+		"variadicparameter-too-few-arguments": {
+			input: []*tfprotov5.DynamicValue{},
+			definition: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+				},
+				VariadicParameter: function.StringParameter{},
+			},
+			expected: function.NewArgumentsData(nil),
+			expectedFuncErr: function.NewFuncError(
+				"Unexpected Function Arguments Data: " +
+					"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+					"Expected minimum function arguments: 1\n" +
+					"Given function arguments: 0",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := fromproto5.ArgumentsData(context.Background(), testCase.input, testCase.definition)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func testNewDynamicValueMust(t *testing.T, value tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	dynamicValue, err := tfprotov5.NewDynamicValue(value.Type(), value)
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	return &dynamicValue
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// CallFunctionRequest returns the *fwserver.CallFunctionRequest
+// equivalent of a *tfprotov5.CallFunctionRequest.
+func CallFunctionRequest(ctx context.Context, proto *tfprotov5.CallFunctionRequest, fn function.Function, functionDefinition function.Definition) (*fwserver.CallFunctionRequest, *function.FuncError) {
+	if proto == nil {
+		return nil, nil
+	}
+
+	fw := &fwserver.CallFunctionRequest{
+		Function:           fn,
+		FunctionDefinition: functionDefinition,
+	}
+
+	arguments, funcErr := ArgumentsData(ctx, proto.Arguments, functionDefinition)
+
+	fw.Arguments = arguments
+
+	return fw, funcErr
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCallFunctionRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input              *tfprotov5.CallFunctionRequest
+		function           function.Function
+		functionDefinition function.Definition
+		expected           *fwserver.CallFunctionRequest
+		expectedFuncError  *function.FuncError
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input: &tfprotov5.CallFunctionRequest{},
+			functionDefinition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			expected: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+		},
+		"arguments": {
+			input: &tfprotov5.CallFunctionRequest{
+				Arguments: []*tfprotov5.DynamicValue{
+					testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "arg0")),
+					testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "arg1")),
+				},
+				Name: "testfunction",
+			},
+			functionDefinition: function.Definition{
+				Parameters: []function.Parameter{
+					function.StringParameter{},
+					function.StringParameter{},
+				},
+				Return: function.StringReturn{},
+			},
+			expected: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					basetypes.NewStringValue("arg0"),
+					basetypes.NewStringValue("arg1"),
+				}),
+				FunctionDefinition: function.Definition{
+					Parameters: []function.Parameter{
+						function.StringParameter{},
+						function.StringParameter{},
+					},
+					Return: function.StringReturn{},
+				},
+			},
+		},
+		"arguments-error": {
+			input: &tfprotov5.CallFunctionRequest{
+				Arguments: []*tfprotov5.DynamicValue{
+					testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "arg0")),
+				},
+				Name: "testfunction",
+			},
+			functionDefinition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			expected: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedFuncError: function.NewFuncError(
+				"Unexpected Function Arguments Data: " +
+					"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+					"Expected function arguments: 0\n" +
+					"Given function arguments: 1",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := fromproto5.CallFunctionRequest(context.Background(), testCase.input, testCase.function, testCase.functionDefinition)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
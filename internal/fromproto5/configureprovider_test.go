@@ -59,6 +59,26 @@ func TestConfigureProviderRequest(t *testing.T) {
 			input:    &tfprotov5.ConfigureProviderRequest{},
 			expected: &provider.ConfigureRequest{},
 		},
+		"client-capabilities": {
+			input: &tfprotov5.ConfigureProviderRequest{
+				ClientCapabilities: &tfprotov5.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			expected: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+		},
+		"client-capabilities-unset": {
+			input: &tfprotov5.ConfigureProviderRequest{},
+			expected: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: false,
+				},
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov5.ConfigureProviderRequest{
 				Config: &testProto5DynamicValue,
@@ -17,7 +17,7 @@ import (
 
 // ImportResourceStateRequest returns the *fwserver.ImportResourceStateRequest
 // equivalent of a *tfprotov5.ImportResourceStateRequest.
-func ImportResourceStateRequest(ctx context.Context, proto5 *tfprotov5.ImportResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema) (*fwserver.ImportResourceStateRequest, diag.Diagnostics) {
+func ImportResourceStateRequest(ctx context.Context, proto5 *tfprotov5.ImportResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema, identitySchema fwschema.Schema) (*fwserver.ImportResourceStateRequest, diag.Diagnostics) {
 	if proto5 == nil {
 		return nil, nil
 	}
@@ -43,10 +43,18 @@ func ImportResourceStateRequest(ctx context.Context, proto5 *tfprotov5.ImportRes
 			Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
 			Schema: resourceSchema,
 		},
-		ID:       proto5.ID,
-		Resource: resource,
-		TypeName: proto5.TypeName,
+		ID:                 proto5.ID,
+		IdentitySchema:     identitySchema,
+		Resource:           resource,
+		TypeName:           proto5.TypeName,
+		ClientCapabilities: ImportStateClientCapabilities(proto5.ClientCapabilities),
 	}
 
+	identity, identityDiags := ResourceIdentity(ctx, proto5.Identity, identitySchema)
+
+	diags.Append(identityDiags...)
+
+	fw.Identity = identity
+
 	return fw, diags
 }
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestListResourceRequest(t *testing.T) {
+	t.Parallel()
+
+	testProto5Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto5Value := tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testProto5DynamicValue, err := tfprotov5.NewDynamicValue(testProto5Type, testProto5Value)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testFwListResourceSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"test_attribute": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testFwResourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testFwIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input               *tfprotov5.ListResourceRequest
+		listResourceSchema  fwschema.Schema
+		listResource        list.ListResource
+		expected            *fwserver.ListResourceRequest
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"missing-schema": {
+			input: &tfprotov5.ListResourceRequest{},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Resource Schema",
+					"An unexpected error was encountered when handling the request. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Missing schema.",
+				),
+			},
+		},
+		"empty": {
+			input:              &tfprotov5.ListResourceRequest{},
+			listResourceSchema: testFwListResourceSchema,
+			expected: &fwserver.ListResourceRequest{
+				ResourceSchema:         testFwResourceSchema,
+				ResourceIdentitySchema: testFwIdentitySchema,
+			},
+		},
+		"config": {
+			input: &tfprotov5.ListResourceRequest{
+				Config: &testProto5DynamicValue,
+			},
+			listResourceSchema: testFwListResourceSchema,
+			expected: &fwserver.ListResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testProto5Value,
+					Schema: testFwListResourceSchema,
+				},
+				ResourceSchema:         testFwResourceSchema,
+				ResourceIdentitySchema: testFwIdentitySchema,
+			},
+		},
+		"includeresource": {
+			input: &tfprotov5.ListResourceRequest{
+				IncludeResource: true,
+			},
+			listResourceSchema: testFwListResourceSchema,
+			expected: &fwserver.ListResourceRequest{
+				IncludeResource:        true,
+				ResourceSchema:         testFwResourceSchema,
+				ResourceIdentitySchema: testFwIdentitySchema,
+			},
+		},
+		"limit": {
+			input: &tfprotov5.ListResourceRequest{
+				Limit: 10,
+			},
+			listResourceSchema: testFwListResourceSchema,
+			expected: &fwserver.ListResourceRequest{
+				Limit:                  10,
+				ResourceSchema:         testFwResourceSchema,
+				ResourceIdentitySchema: testFwIdentitySchema,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fromproto5.ListResourceRequest(context.Background(), testCase.input, testCase.listResource, testCase.listResourceSchema, testFwResourceSchema, testFwIdentitySchema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
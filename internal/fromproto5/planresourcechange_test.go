@@ -57,6 +57,7 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 	testCases := map[string]struct {
 		input               *tfprotov5.PlanResourceChangeRequest
 		resourceSchema      fwschema.Schema
+		identitySchema      fwschema.Schema
 		resource            resource.Resource
 		providerMetaSchema  fwschema.Schema
 		expected            *fwserver.PlanResourceChangeRequest
@@ -79,6 +80,30 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 				),
 			},
 		},
+		"client-capabilities": {
+			input: &tfprotov5.PlanResourceChangeRequest{
+				ClientCapabilities: &tfprotov5.PlanResourceChangeClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
+				ResourceSchema: testFwSchema,
+			},
+		},
+		"client-capabilities-unset": {
+			input:          &tfprotov5.PlanResourceChangeRequest{},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: false,
+				},
+				ResourceSchema: testFwSchema,
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov5.PlanResourceChangeRequest{
 				Config: &testProto5DynamicValue,
@@ -225,7 +250,7 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := fromproto5.PlanResourceChangeRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.providerMetaSchema)
+			got, diags := fromproto5.PlanResourceChangeRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.identitySchema, testCase.providerMetaSchema)
 
 			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
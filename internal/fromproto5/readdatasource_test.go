@@ -71,6 +71,30 @@ func TestReadDataSourceRequest(t *testing.T) {
 				),
 			},
 		},
+		"client-capabilities": {
+			input: &tfprotov5.ReadDataSourceRequest{
+				ClientCapabilities: &tfprotov5.ReadDataSourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			dataSourceSchema: testFwSchema,
+			expected: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				DataSourceSchema: testFwSchema,
+			},
+		},
+		"client-capabilities-unset": {
+			input:            &tfprotov5.ReadDataSourceRequest{},
+			dataSourceSchema: testFwSchema,
+			expected: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: false,
+				},
+				DataSourceSchema: testFwSchema,
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov5.ReadDataSourceRequest{
 				Config: &testProto5DynamicValue,
@@ -135,6 +159,16 @@ func TestReadDataSourceRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ReadDataSourceRequest{
+				TypeName: "test_data_source",
+			},
+			dataSourceSchema: testFwSchema,
+			expected: &fwserver.ReadDataSourceRequest{
+				DataSourceSchema: testFwSchema,
+				TypeName:         "test_data_source",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceIdentity returns the *tfsdk.ResourceIdentity for a
+// *tfprotov5.ResourceIdentityData and fwschema.Schema.
+func ResourceIdentity(ctx context.Context, proto5 *tfprotov5.ResourceIdentityData, identitySchema fwschema.Schema) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
+	if proto5 == nil || proto5.IdentityData == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+
+	// Panic prevention here to simplify the calling implementations.
+	// This should not happen, but just in case.
+	if identitySchema == nil {
+		diags.AddError(
+			"Unable to Convert Resource Identity",
+			"An unexpected error was encountered when converting the resource identity from the protocol type. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				"Missing identity schema.",
+		)
+
+		return nil, diags
+	}
+
+	data, dynamicValueDiags := DynamicValue(ctx, proto5.IdentityData, identitySchema, fwschemadata.DataDescriptionResourceIdentity)
+
+	diags.Append(dynamicValueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fw := &tfsdk.ResourceIdentity{
+		Raw:    data.TerraformValue,
+		Schema: identitySchema,
+	}
+
+	return fw, diags
+}
@@ -26,6 +26,7 @@ func ValidateResourceTypeConfigRequest(ctx context.Context, proto5 *tfprotov5.Va
 
 	fw.Config = config
 	fw.Resource = resource
+	fw.ClientCapabilities = ValidateResourceTypeConfigClientCapabilities(proto5.ClientCapabilities)
 
 	return fw, diags
 }
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ArgumentsData returns the ArgumentsData for a given []*tfprotov6.DynamicValue
+// and function.Definition. The variadic parameter arguments, if any, are
+// combined into a final list argument of the variadic parameter type.
+func ArgumentsData(ctx context.Context, arguments []*tfprotov6.DynamicValue, definition function.Definition) (function.ArgumentsData, *function.FuncError) {
+	if definition.VariadicParameter == nil && len(arguments) != len(definition.Parameters) {
+		return function.NewArgumentsData(nil), function.NewFuncError(
+			"Unexpected Function Arguments Data: " +
+				"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+				"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+				fmt.Sprintf("Expected function arguments: %d\n", len(definition.Parameters)) +
+				fmt.Sprintf("Given function arguments: %d", len(arguments)),
+		)
+	}
+
+	// Expect at least all parameters to have corresponding arguments. Variadic
+	// parameter might have 0 to n arguments, which is why it is not checked.
+	if len(arguments) < len(definition.Parameters) {
+		return function.NewArgumentsData(nil), function.NewFuncError(
+			"Unexpected Function Arguments Data: " +
+				"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+				"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+				fmt.Sprintf("Expected minimum function arguments: %d\n", len(definition.Parameters)) +
+				fmt.Sprintf("Given function arguments: %d", len(arguments)),
+		)
+	}
+
+	if len(arguments) == 0 && definition.VariadicParameter == nil {
+		return function.NewArgumentsData(nil), nil
+	}
+
+	// Variadic values are collected as a separate list to ease developer usage.
+	argumentValues := make([]attr.Value, 0, len(definition.Parameters)+1)
+	variadicValues := make([]attr.Value, 0, len(arguments)-len(definition.Parameters))
+	var funcErr *function.FuncError
+
+	for position, argument := range arguments {
+		parameter, diags := definition.Parameter(ctx, position)
+
+		if diags.HasError() {
+			funcErr = function.ConcatFuncErrors(funcErr, function.FuncErrorFromDiags(ctx, diags))
+
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		parameterType := parameter.GetType()
+
+		if parameterType == nil {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(
+				int64(position),
+				"Unable to Convert Function Argument: "+
+					"An unexpected error was encountered when converting the function argument from the protocol type. "+
+					"Parameter type missing. "+
+					"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Parameter Name: %s", parameter.GetName()),
+			))
+
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		tfValue, err := argument.Unmarshal(parameterType.TerraformType(ctx))
+
+		if err != nil {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(
+				int64(position),
+				"Unable to Convert Function Argument: "+
+					"An unexpected error was encountered when converting the function argument from the protocol type. "+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Parameter Name: %s\n", parameter.GetName())+
+					"Unable to unmarshal DynamicValue: "+err.Error(),
+			))
+
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		if !parameter.GetAllowNullValue() && tfValue.IsNull() {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(
+				int64(position),
+				"Invalid Function Argument: "+
+					fmt.Sprintf("The %q parameter does not allow a null value.", parameter.GetName()),
+			))
+
+			continue
+		}
+
+		if !parameter.GetAllowUnknownValues() && !tfValue.IsFullyKnown() {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(
+				int64(position),
+				"Invalid Function Argument: "+
+					fmt.Sprintf("The %q parameter does not allow an unknown value. ", parameter.GetName())+
+					"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.",
+			))
+
+			continue
+		}
+
+		attrValue, err := parameterType.ValueFromTerraform(ctx, tfValue)
+
+		if err != nil {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(
+				int64(position),
+				"Unable to Convert Function Argument: "+
+					"An unexpected error was encountered when converting the function argument from the protocol type. "+
+					"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Parameter Name: %s\n", parameter.GetName())+
+					"Unable to convert tftypes to framework type: "+err.Error(),
+			))
+
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		if definition.VariadicParameter != nil && position >= len(definition.Parameters) {
+			variadicValues = append(variadicValues, attrValue)
+
+			continue
+		}
+
+		argumentValues = append(argumentValues, attrValue)
+	}
+
+	if funcErr != nil {
+		return function.NewArgumentsData(nil), funcErr
+	}
+
+	if definition.VariadicParameter != nil {
+		variadicValue, variadicValueDiags := basetypes.NewListValue(definition.VariadicParameter.GetType(), variadicValues)
+
+		funcErr = function.ConcatFuncErrors(funcErr, function.FuncErrorFromDiags(ctx, variadicValueDiags))
+
+		if funcErr != nil {
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		argumentValues = append(argumentValues, variadicValue)
+	}
+
+	return function.NewArgumentsData(argumentValues), nil
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestConfigureProviderClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.ConfigureProviderClientCapabilities
+		expected provider.ConfigureProviderClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: provider.ConfigureProviderClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: provider.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ConfigureProviderClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadDataSourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.ReadDataSourceClientCapabilities
+		expected datasource.ReadClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: datasource.ReadClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.ReadDataSourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: datasource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ReadDataSourceClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.ReadResourceClientCapabilities
+		expected resource.ReadClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: resource.ReadClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.ReadResourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ReadResourceClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlanClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.PlanResourceChangeClientCapabilities
+		expected resource.ModifyPlanClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: resource.ModifyPlanClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.PlanResourceChangeClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ModifyPlanClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ModifyPlanClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestImportStateClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.ImportResourceStateClientCapabilities
+		expected resource.ImportStateClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: resource.ImportStateClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.ImportResourceStateClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ImportStateClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ImportStateClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOpenEphemeralResourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.OpenEphemeralResourceClientCapabilities
+		expected ephemeral.OpenClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: ephemeral.OpenClientCapabilities{
+				DeferralAllowed: false,
+			},
+		},
+		"DeferralAllowed": {
+			input: &tfprotov6.OpenEphemeralResourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: ephemeral.OpenClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.OpenEphemeralResourceClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateResourceConfigClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfprotov6.ValidateResourceConfigClientCapabilities
+		expected resource.ValidateConfigClientCapabilities
+	}{
+		"nil": {
+			input: nil,
+			expected: resource.ValidateConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: false,
+			},
+		},
+		"WriteOnlyAttributesAllowed": {
+			input: &tfprotov6.ValidateResourceConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+			expected: resource.ValidateConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ValidateResourceConfigClientCapabilities(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
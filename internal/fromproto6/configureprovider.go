@@ -20,7 +20,8 @@ func ConfigureProviderRequest(ctx context.Context, proto6 *tfprotov6.ConfigurePr
 	}
 
 	fw := &provider.ConfigureRequest{
-		TerraformVersion: proto6.TerraformVersion,
+		TerraformVersion:   proto6.TerraformVersion,
+		ClientCapabilities: ConfigureProviderClientCapabilities(proto6.ClientCapabilities),
 	}
 
 	config, diags := Config(ctx, proto6.Config, providerSchema)
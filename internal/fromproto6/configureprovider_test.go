@@ -59,6 +59,26 @@ func TestConfigureProviderRequest(t *testing.T) {
 			input:    &tfprotov6.ConfigureProviderRequest{},
 			expected: &provider.ConfigureRequest{},
 		},
+		"client-capabilities": {
+			input: &tfprotov6.ConfigureProviderRequest{
+				ClientCapabilities: &tfprotov6.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			expected: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+		},
+		"client-capabilities-unset": {
+			input: &tfprotov6.ConfigureProviderRequest{},
+			expected: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: false,
+				},
+			},
+		},
 		"config-missing-schema": {
 			input: &tfprotov6.ConfigureProviderRequest{
 				Config: &testProto6DynamicValue,
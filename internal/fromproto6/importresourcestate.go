@@ -17,7 +17,7 @@ import (
 
 // ImportResourceStateRequest returns the *fwserver.ImportResourceStateRequest
 // equivalent of a *tfprotov6.ImportResourceStateRequest.
-func ImportResourceStateRequest(ctx context.Context, proto6 *tfprotov6.ImportResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema) (*fwserver.ImportResourceStateRequest, diag.Diagnostics) {
+func ImportResourceStateRequest(ctx context.Context, proto6 *tfprotov6.ImportResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema, identitySchema fwschema.Schema) (*fwserver.ImportResourceStateRequest, diag.Diagnostics) {
 	if proto6 == nil {
 		return nil, nil
 	}
@@ -43,10 +43,18 @@ func ImportResourceStateRequest(ctx context.Context, proto6 *tfprotov6.ImportRes
 			Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
 			Schema: resourceSchema,
 		},
-		ID:       proto6.ID,
-		Resource: resource,
-		TypeName: proto6.TypeName,
+		ID:                 proto6.ID,
+		IdentitySchema:     identitySchema,
+		Resource:           resource,
+		TypeName:           proto6.TypeName,
+		ClientCapabilities: ImportStateClientCapabilities(proto6.ClientCapabilities),
 	}
 
+	identity, identityDiags := ResourceIdentity(ctx, proto6.Identity, identitySchema)
+
+	diags.Append(identityDiags...)
+
+	fw.Identity = identity
+
 	return fw, diags
 }
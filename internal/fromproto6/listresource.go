@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// ListResourceRequest returns the *fwserver.ListResourceRequest equivalent of
+// a *tfprotov6.ListResourceRequest.
+func ListResourceRequest(ctx context.Context, proto6 *tfprotov6.ListResourceRequest, listResource list.ListResource, listResourceSchema fwschema.Schema, resourceSchema fwschema.Schema, identitySchema fwschema.Schema) (*fwserver.ListResourceRequest, diag.Diagnostics) {
+	if proto6 == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+
+	// Panic prevention here to simplify the calling implementations.
+	// This should not happen, but just in case.
+	if listResourceSchema == nil {
+		diags.AddError(
+			"Missing List Resource Schema",
+			"An unexpected error was encountered when handling the request. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				"Missing schema.",
+		)
+
+		return nil, diags
+	}
+
+	fw := &fwserver.ListResourceRequest{
+		ListResource:           listResource,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: identitySchema,
+		IncludeResource:        proto6.IncludeResource,
+		Limit:                  proto6.Limit,
+	}
+
+	config, configDiags := Config(ctx, proto6.Config, listResourceSchema)
+
+	diags.Append(configDiags...)
+
+	fw.Config = config
+
+	return fw, diags
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOpenEphemeralResourceRequest(t *testing.T) {
+	t.Parallel()
+
+	testProto6Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto6Value := tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testProto6DynamicValue, err := tfprotov6.NewDynamicValue(testProto6Type, testProto6Value)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testFwSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input                   *tfprotov6.OpenEphemeralResourceRequest
+		ephemeralResourceSchema fwschema.Schema
+		ephemeralResource       ephemeral.EphemeralResource
+		expected                *fwserver.OpenEphemeralResourceRequest
+		expectedDiagnostics     diag.Diagnostics
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input:    &tfprotov6.OpenEphemeralResourceRequest{},
+			expected: nil,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Ephemeral Resource Schema",
+					"An unexpected error was encountered when handling the request. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Missing schema.",
+				),
+			},
+		},
+		"client-capabilities": {
+			input: &tfprotov6.OpenEphemeralResourceRequest{
+				ClientCapabilities: &tfprotov6.OpenEphemeralResourceClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			ephemeralResourceSchema: testFwSchema,
+			expected: &fwserver.OpenEphemeralResourceRequest{
+				ClientCapabilities: ephemeral.OpenClientCapabilities{
+					DeferralAllowed: true,
+				},
+				EphemeralResourceSchema: testFwSchema,
+			},
+		},
+		"client-capabilities-unset": {
+			input:                   &tfprotov6.OpenEphemeralResourceRequest{},
+			ephemeralResourceSchema: testFwSchema,
+			expected: &fwserver.OpenEphemeralResourceRequest{
+				ClientCapabilities: ephemeral.OpenClientCapabilities{
+					DeferralAllowed: false,
+				},
+				EphemeralResourceSchema: testFwSchema,
+			},
+		},
+		"config-missing-schema": {
+			input: &tfprotov6.OpenEphemeralResourceRequest{
+				Config: &testProto6DynamicValue,
+			},
+			expected: nil,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Ephemeral Resource Schema",
+					"An unexpected error was encountered when handling the request. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Missing schema.",
+				),
+			},
+		},
+		"config": {
+			input: &tfprotov6.OpenEphemeralResourceRequest{
+				Config: &testProto6DynamicValue,
+			},
+			ephemeralResourceSchema: testFwSchema,
+			expected: &fwserver.OpenEphemeralResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testProto6Value,
+					Schema: testFwSchema,
+				},
+				EphemeralResourceSchema: testFwSchema,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fromproto6.OpenEphemeralResourceRequest(context.Background(), testCase.input, testCase.ephemeralResource, testCase.ephemeralResourceSchema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
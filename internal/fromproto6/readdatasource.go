@@ -37,8 +37,10 @@ func ReadDataSourceRequest(ctx context.Context, proto6 *tfprotov6.ReadDataSource
 	}
 
 	fw := &fwserver.ReadDataSourceRequest{
-		DataSourceSchema: dataSourceSchema,
-		DataSource:       dataSource,
+		DataSourceSchema:   dataSourceSchema,
+		DataSource:         dataSource,
+		ClientCapabilities: ReadDataSourceClientCapabilities(proto6.ClientCapabilities),
+		TypeName:           proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, dataSourceSchema)
@@ -26,6 +26,7 @@ func ValidateResourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Valida
 
 	fw.Config = config
 	fw.Resource = resource
+	fw.ClientCapabilities = ValidateResourceConfigClientCapabilities(proto6.ClientCapabilities)
 
 	return fw, diags
 }
@@ -5,11 +5,13 @@ package fromtftypes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -22,6 +24,12 @@ func AttributePath(ctx context.Context, tfType *tftypes.AttributePath, schema fw
 		currentTfTypePath := tftypes.NewAttributePathWithSteps(currentTfTypeSteps)
 		attrType, err := schema.TypeAtTerraformPath(ctx, currentTfTypePath)
 
+		// Elements and attributes of dynamic values have no schema type, so
+		// any set element values are also represented as dynamic values.
+		if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+			attrType, err = types.DynamicType, nil
+		}
+
 		if err != nil {
 			return path.Empty(), diag.Diagnostics{
 				diag.NewErrorDiagnostic(
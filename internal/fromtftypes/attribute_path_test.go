@@ -67,6 +67,31 @@ func TestAttributePath(t *testing.T) {
 				),
 			},
 		},
+		"AttributeName-dynamic-AttributeName-ElementKeyValue": {
+			tfType: tftypes.NewAttributePath().
+				WithAttributeName("test").
+				WithAttributeName("nested").
+				WithElementKeyValue(tftypes.NewValue(tftypes.String, "test-value")),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Type: types.DynamicType,
+					},
+				},
+			},
+			expected: path.Root("test").AtName("nested").AtSetValue(types.DynamicValue(types.StringValue("test-value"))),
+		},
+		"AttributeName-dynamic-ElementKeyInt": {
+			tfType: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Type: types.DynamicType,
+					},
+				},
+			},
+			expected: path.Root("test").AtListIndex(1),
+		},
 		"AttributeName-ElementKeyInt": {
 			tfType: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
 			schema: testschema.Schema{
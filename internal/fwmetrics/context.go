@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetrics
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+// reporterKey is the context key for ContextWithReporter.
+type reporterKey struct{}
+
+// operationKey is the context key for ContextWithOperation.
+type operationKey struct{}
+
+// operation is the framework operation stored by ContextWithOperation.
+type operation struct {
+	operation interceptor.Operation
+	typeName  string
+}
+
+// ContextWithReporter returns a context which carries the given
+// metrics.Reporter. If the reporter is nil, the context is returned
+// unmodified.
+func ContextWithReporter(ctx context.Context, reporter metrics.Reporter) context.Context {
+	if reporter == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, reporterKey{}, reporter)
+}
+
+// Reporter returns the metrics.Reporter of the context, if any.
+func Reporter(ctx context.Context) metrics.Reporter {
+	reporter, ok := ctx.Value(reporterKey{}).(metrics.Reporter)
+
+	if !ok {
+		return nil
+	}
+
+	return reporter
+}
+
+// ContextWithOperation returns a context which carries the framework
+// operation and type name, which are included in method measurements.
+func ContextWithOperation(ctx context.Context, op interceptor.Operation, typeName string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation{
+		operation: op,
+		typeName:  typeName,
+	})
+}
+
+// Operation returns the framework operation and type name of the context,
+// if any.
+func Operation(ctx context.Context) (interceptor.Operation, string) {
+	op, ok := ctx.Value(operationKey{}).(operation)
+
+	if !ok {
+		return "", ""
+	}
+
+	return op.operation, op.typeName
+}
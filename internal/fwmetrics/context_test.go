@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetrics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+
+	testReporter := &metrics.InMemory{}
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected metrics.Reporter
+	}{
+		"unset": {
+			ctx:      context.Background(),
+			expected: nil,
+		},
+		"nil": {
+			ctx:      fwmetrics.ContextWithReporter(context.Background(), nil),
+			expected: nil,
+		},
+		"set": {
+			ctx:      fwmetrics.ContextWithReporter(context.Background(), testReporter),
+			expected: testReporter,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwmetrics.Reporter(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %v, got: %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx               context.Context
+		expectedOperation interceptor.Operation
+		expectedTypeName  string
+	}{
+		"unset": {
+			ctx: context.Background(),
+		},
+		"set": {
+			ctx:               fwmetrics.ContextWithOperation(context.Background(), interceptor.OperationReadResource, "test_resource"),
+			expectedOperation: interceptor.OperationReadResource,
+			expectedTypeName:  "test_resource",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotOperation, gotTypeName := fwmetrics.Operation(testCase.ctx)
+
+			if diff := cmp.Diff(testCase.expectedOperation, gotOperation); diff != "" {
+				t.Errorf("unexpected operation difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedTypeName, gotTypeName); diff != "" {
+				t.Errorf("unexpected type name difference: %s", diff)
+			}
+		})
+	}
+}
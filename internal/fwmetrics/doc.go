@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwmetrics contains the framework handling for reporting metrics of
+// operations and provider defined methods to a provider defined
+// metrics.Reporter, which is carried through the request context.
+package fwmetrics
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ReportOperation reports the measurement of the framework operation of the
+// context, if the context carries a metrics.Reporter.
+func ReportOperation(ctx context.Context, start time.Time, outcome metrics.Outcome) {
+	reporter := Reporter(ctx)
+
+	if reporter == nil {
+		return
+	}
+
+	op, typeName := Operation(ctx)
+
+	reporter.ReportOperation(ctx, metrics.OperationMeasurement{
+		Operation: op,
+		TypeName:  typeName,
+		Duration:  time.Since(start),
+		Outcome:   outcome,
+	})
+}
+
+// ReportMethod reports the measurement of a provider defined method, if the
+// context carries a metrics.Reporter. The implementation is the provider
+// defined value which implements the method.
+func ReportMethod(ctx context.Context, implementation any, method string, attributePath path.Path, start time.Time, outcome metrics.Outcome) {
+	reporter := Reporter(ctx)
+
+	if reporter == nil {
+		return
+	}
+
+	op, typeName := Operation(ctx)
+
+	reporter.ReportMethod(ctx, metrics.MethodMeasurement{
+		Operation:      op,
+		TypeName:       typeName,
+		Implementation: fmt.Sprintf("%T", implementation),
+		Method:         method,
+		AttributePath:  attributePath,
+		Duration:       time.Since(start),
+		Outcome:        outcome,
+	})
+}
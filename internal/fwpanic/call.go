@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwpanic
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Method describes a provider defined method for diagnostics and logging
+// when it panics.
+type Method struct {
+	// Implementation is the provider defined type which implements the
+	// method, such as a resource or validator.
+	Implementation any
+
+	// Name is the name of the method, such as "Read".
+	Name string
+
+	// TypeName is the Terraform type name being operated on, such as
+	// "examplecloud_thing", if known. When empty, the type name of the
+	// framework operation in the context is used, if any.
+	TypeName string
+
+	// AttributePath is the path of the attribute being operated on, if the
+	// method is an attribute validator, plan modifier, or default.
+	AttributePath path.Path
+}
+
+// Call calls the given function, which should call the provider defined
+// method. If the function panics, the panic is recovered, logged with its
+// stack trace, and converted into an error diagnostic appended to diags.
+// Call returns true if a panic was recovered.
+//
+// Panics are not recovered if the context was returned by
+// ContextWithRecoveryDisabled.
+//
+// Since all provider defined methods are called through Call, it also
+// reports the method measurement if the context carries a metrics reporter.
+func Call(ctx context.Context, diags *diag.Diagnostics, method Method, f func()) (recovered bool) {
+	start := time.Now()
+	errorsCount := diags.ErrorsCount()
+
+	if RecoveryDisabled(ctx) {
+		f()
+		reportMethod(ctx, method, start, methodOutcome(*diags, errorsCount, false))
+
+		return false
+	}
+
+	if method.TypeName == "" {
+		_, method.TypeName = fwmetrics.Operation(ctx)
+	}
+
+	defer func() {
+		r := recover()
+
+		if r != nil {
+			recovered = true
+
+			logFields := map[string]interface{}{
+				logging.KeyError:      fmt.Sprint(r),
+				logging.KeyStackTrace: string(debug.Stack()),
+			}
+
+			if len(method.AttributePath.Steps()) > 0 {
+				logFields[logging.KeyAttributePath] = method.AttributePath.String()
+			}
+
+			logging.FrameworkError(ctx, fmt.Sprintf("Recovered panic from provider defined %T %s method", method.Implementation, method.Name), logFields)
+
+			diags.Append(diagnostic(method, r))
+		}
+
+		reportMethod(ctx, method, start, methodOutcome(*diags, errorsCount, recovered))
+	}()
+
+	f()
+
+	return false
+}
+
+// methodOutcome returns the metrics outcome of a provider defined method,
+// based on whether it panicked or added error diagnostics.
+func methodOutcome(diags diag.Diagnostics, previousErrorsCount int, recovered bool) metrics.Outcome {
+	if recovered {
+		return metrics.OutcomePanic
+	}
+
+	if diags.ErrorsCount() > previousErrorsCount {
+		return metrics.OutcomeError
+	}
+
+	return metrics.OutcomeSuccess
+}
+
+// reportMethod reports the measurement of the provider defined method.
+func reportMethod(ctx context.Context, method Method, start time.Time, outcome metrics.Outcome) {
+	fwmetrics.ReportMethod(ctx, method.Implementation, method.Name, method.AttributePath, start, outcome)
+}
+
+// diagnostic returns the error diagnostic for a recovered panic in the given
+// provider defined method.
+func diagnostic(method Method, r any) diag.Diagnostic {
+	summary := "Provider Panic"
+	detail := fmt.Sprintf("The provider panicked while calling the %T %s method", method.Implementation, method.Name)
+
+	if method.TypeName != "" {
+		detail += fmt.Sprintf(" for %s", method.TypeName)
+	}
+
+	detail += ". " +
+		"This is always an issue with the provider and should be reported to the provider developers. " +
+		"The provider logs contain the stack trace of the panic.\n\n" +
+		fmt.Sprintf("Panic: %v", r)
+
+	if len(method.AttributePath.Steps()) > 0 {
+		return diag.NewAttributeErrorDiagnostic(method.AttributePath, summary, detail)
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail)
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwpanic_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx               context.Context
+		method            fwpanic.Method
+		f                 func()
+		expectedRecovered bool
+		expectedDiags     diag.Diagnostics
+	}{
+		"no-panic": {
+			method: fwpanic.Method{
+				Implementation: &testprovider.Resource{},
+				Name:           "Read",
+			},
+			f: func() {},
+		},
+		"panic": {
+			method: fwpanic.Method{
+				Implementation: &testprovider.Resource{},
+				Name:           "Read",
+			},
+			f: func() {
+				panic("test panic")
+			},
+			expectedRecovered: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider Panic",
+					"The provider panicked while calling the *testprovider.Resource Read method. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: test panic",
+				),
+			},
+		},
+		"panic-runtime-error": {
+			method: fwpanic.Method{
+				Implementation: &testprovider.Resource{},
+				Name:           "Read",
+			},
+			f: func() {
+				var m map[string]string
+
+				m["test"] = "test"
+			},
+			expectedRecovered: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider Panic",
+					"The provider panicked while calling the *testprovider.Resource Read method. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: assignment to entry in nil map",
+				),
+			},
+		},
+		"panic-attribute-path": {
+			method: fwpanic.Method{
+				AttributePath:  path.Root("test"),
+				Implementation: &testprovider.Resource{},
+				Name:           "ValidateString",
+			},
+			f: func() {
+				panic("test panic")
+			},
+			expectedRecovered: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Provider Panic",
+					"The provider panicked while calling the *testprovider.Resource ValidateString method. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: test panic",
+				),
+			},
+		},
+		"panic-type-name": {
+			method: fwpanic.Method{
+				Implementation: &testprovider.Resource{},
+				Name:           "Read",
+				TypeName:       "test_resource",
+			},
+			f: func() {
+				panic("test panic")
+			},
+			expectedRecovered: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider Panic",
+					"The provider panicked while calling the *testprovider.Resource Read method for test_resource. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: test panic",
+				),
+			},
+		},
+		"panic-type-name-context": {
+			ctx: fwmetrics.ContextWithOperation(context.Background(), interceptor.OperationValidateResourceConfig, "test_resource"),
+			method: fwpanic.Method{
+				AttributePath:  path.Root("test"),
+				Implementation: &testprovider.Resource{},
+				Name:           "ValidateString",
+			},
+			f: func() {
+				panic("test panic")
+			},
+			expectedRecovered: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Provider Panic",
+					"The provider panicked while calling the *testprovider.Resource ValidateString method for test_resource. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: test panic",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.ctx
+
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			var diags diag.Diagnostics
+
+			got := fwpanic.Call(ctx, &diags, testCase.method, testCase.f)
+
+			if got != testCase.expectedRecovered {
+				t.Errorf("expected recovered %t, got %t", testCase.expectedRecovered, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCall_RecoveryDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwpanic.ContextWithRecoveryDisabled(context.Background())
+
+	defer func() {
+		if r := recover(); r != "test panic" {
+			t.Errorf("expected test panic, got: %v", r)
+		}
+	}()
+
+	var diags diag.Diagnostics
+
+	fwpanic.Call(ctx, &diags, fwpanic.Method{Implementation: &testprovider.Resource{}, Name: "Read"}, func() {
+		panic("test panic")
+	})
+
+	t.Fatal("expected panic")
+}
+
+func TestCall_MetricsReporter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags           diag.Diagnostics
+		f               func(diags *diag.Diagnostics)
+		expectedOutcome metrics.Outcome
+	}{
+		"success": {
+			f:               func(_ *diag.Diagnostics) {},
+			expectedOutcome: metrics.OutcomeSuccess,
+		},
+		"success-existing-error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("existing summary", "existing detail"),
+			},
+			f:               func(_ *diag.Diagnostics) {},
+			expectedOutcome: metrics.OutcomeSuccess,
+		},
+		"error": {
+			f: func(diags *diag.Diagnostics) {
+				diags.AddError("test summary", "test detail")
+			},
+			expectedOutcome: metrics.OutcomeError,
+		},
+		"panic": {
+			f: func(_ *diag.Diagnostics) {
+				panic("test panic")
+			},
+			expectedOutcome: metrics.OutcomePanic,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reporter := &metrics.InMemory{}
+			ctx := fwmetrics.ContextWithReporter(context.Background(), reporter)
+			ctx = fwmetrics.ContextWithOperation(ctx, interceptor.OperationPlanResourceChange, "test_resource")
+
+			diags := testCase.diags
+			method := fwpanic.Method{
+				Implementation: &testprovider.Resource{},
+				Name:           "ModifyPlan",
+				AttributePath:  path.Root("test"),
+			}
+
+			fwpanic.Call(ctx, &diags, method, func() {
+				testCase.f(&diags)
+			})
+
+			summaries := reporter.Summaries()
+
+			if len(summaries) != 1 {
+				t.Fatalf("expected 1 summary, got: %v", summaries)
+			}
+
+			got := summaries[0]
+			expected := metrics.Summary{
+				Operation:      interceptor.OperationPlanResourceChange,
+				TypeName:       "test_resource",
+				Implementation: "*testprovider.Resource",
+				Method:         "ModifyPlan",
+				AttributePath:  "test",
+				Count:          1,
+			}
+
+			switch testCase.expectedOutcome {
+			case metrics.OutcomeError:
+				expected.ErrorCount = 1
+			case metrics.OutcomePanic:
+				expected.PanicCount = 1
+			}
+
+			if diff := cmp.Diff(expected, got, cmpopts.IgnoreFields(metrics.Summary{}, "TotalDuration", "MaxDuration")); diff != "" {
+				t.Errorf("unexpected summary difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwpanic
+
+import (
+	"context"
+)
+
+// recoveryDisabledKey is the context key for ContextWithRecoveryDisabled.
+type recoveryDisabledKey struct{}
+
+// ContextWithRecoveryDisabled returns a context which signals that panics in
+// provider defined methods should not be recovered, which can be useful
+// while debugging a provider.
+func ContextWithRecoveryDisabled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, recoveryDisabledKey{}, true)
+}
+
+// RecoveryDisabled returns true if the context was returned by
+// ContextWithRecoveryDisabled.
+func RecoveryDisabled(ctx context.Context) bool {
+	disabled, ok := ctx.Value(recoveryDisabledKey{}).(bool)
+
+	return ok && disabled
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwpanic contains the framework handling for recovering panics in
+// provider defined methods, which converts them into error diagnostics
+// rather than crashing the provider server.
+package fwpanic
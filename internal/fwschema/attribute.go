@@ -63,6 +63,12 @@ type Attribute interface {
 	// sensitive. This is named differently than Sensitive to prevent a
 	// conflict with the tfsdk.Attribute field name.
 	IsSensitive() bool
+
+	// IsWriteOnly should return true if the attribute configuration value is
+	// write-only. Write-only values are accepted in configuration, but are
+	// never persisted to plan or state. This is named differently than
+	// WriteOnly to prevent a conflict with the tfsdk.Attribute field name.
+	IsWriteOnly() bool
 }
 
 // AttributesEqual is a helper function to perform equality testing on two
@@ -101,5 +107,24 @@ func AttributesEqual(a, b Attribute) bool {
 		return false
 	}
 
+	if a.IsWriteOnly() != b.IsWriteOnly() {
+		return false
+	}
+
+	aIdentity, aOk := a.(IdentityAttribute)
+	bIdentity, bOk := b.(IdentityAttribute)
+
+	if aOk != bOk {
+		return false
+	}
+
+	if aOk && aIdentity.IsRequiredForImport() != bIdentity.IsRequiredForImport() {
+		return false
+	}
+
+	if aOk && aIdentity.IsOptionalForImport() != bIdentity.IsOptionalForImport() {
+		return false
+	}
+
 	return true
 }
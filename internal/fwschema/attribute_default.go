@@ -15,6 +15,14 @@ type AttributeWithBoolDefaultValue interface {
 	BoolDefaultValue() defaults.Bool
 }
 
+// AttributeWithFloat32DefaultValue is an optional interface on Attribute which
+// enables Float32 default value support.
+type AttributeWithFloat32DefaultValue interface {
+	Attribute
+
+	Float32DefaultValue() defaults.Float32
+}
+
 // AttributeWithFloat64DefaultValue is an optional interface on Attribute which
 // enables Float64 default value support.
 type AttributeWithFloat64DefaultValue interface {
@@ -23,6 +31,14 @@ type AttributeWithFloat64DefaultValue interface {
 	Float64DefaultValue() defaults.Float64
 }
 
+// AttributeWithInt32DefaultValue is an optional interface on Attribute which
+// enables Int32 default value support.
+type AttributeWithInt32DefaultValue interface {
+	Attribute
+
+	Int32DefaultValue() defaults.Int32
+}
+
 // AttributeWithInt64DefaultValue is an optional interface on Attribute which
 // enables Int64 default value support.
 type AttributeWithInt64DefaultValue interface {
@@ -86,3 +102,37 @@ type AttributeWithDynamicDefaultValue interface {
 
 	DynamicDefaultValue() defaults.Dynamic
 }
+
+// AttributeHasDefaultValue returns true if the given Attribute implements
+// any of the AttributeWith*DefaultValue interfaces and the default value is
+// defined.
+func AttributeHasDefaultValue(a Attribute) bool {
+	switch a := a.(type) {
+	case AttributeWithBoolDefaultValue:
+		return a.BoolDefaultValue() != nil
+	case AttributeWithDynamicDefaultValue:
+		return a.DynamicDefaultValue() != nil
+	case AttributeWithFloat32DefaultValue:
+		return a.Float32DefaultValue() != nil
+	case AttributeWithFloat64DefaultValue:
+		return a.Float64DefaultValue() != nil
+	case AttributeWithInt32DefaultValue:
+		return a.Int32DefaultValue() != nil
+	case AttributeWithInt64DefaultValue:
+		return a.Int64DefaultValue() != nil
+	case AttributeWithListDefaultValue:
+		return a.ListDefaultValue() != nil
+	case AttributeWithMapDefaultValue:
+		return a.MapDefaultValue() != nil
+	case AttributeWithNumberDefaultValue:
+		return a.NumberDefaultValue() != nil
+	case AttributeWithObjectDefaultValue:
+		return a.ObjectDefaultValue() != nil
+	case AttributeWithSetDefaultValue:
+		return a.SetDefaultValue() != nil
+	case AttributeWithStringDefaultValue:
+		return a.StringDefaultValue() != nil
+	default:
+		return false
+	}
+}
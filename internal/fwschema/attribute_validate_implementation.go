@@ -30,15 +30,28 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks whether a write-only Attribute is also computed or defines a
+//     default value
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
-//     recursively calls this function on nested attributes
+//     checks write-only child attributes are supported by the nesting mode
+//     and recursively calls this function on nested attributes
 func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, req ValidateImplementationRequest) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	if attribute.IsWriteOnly() {
+		if attribute.IsComputed() {
+			diags.Append(AttributeWriteOnlyComputedDiag(req.Path))
+		}
+
+		if AttributeHasDefaultValue(attribute) {
+			diags.Append(AttributeWriteOnlyDefaultDiag(req.Path))
+		}
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -61,7 +74,11 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 
 	nestingMode := nestedAttribute.GetNestingMode()
 
-	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
+	if nestingMode == NestingModeSet && ContainsAnyWriteOnlyChildAttributes(nestedAttribute) {
+		diags.Append(AttributeWriteOnlyInSetDiag(req.Path))
+	}
+
+	for nestedAttributeName, nestedChildAttribute := range nestedObject.GetAttributes() {
 		var nestedAttributePath path.Path
 
 		// TODO: path.Path and path.PathExpression are intended to map onto
@@ -93,7 +110,11 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 			Path: nestedAttributePath,
 		}
 
-		diags.Append(ValidateAttributeImplementation(ctx, nestedAttribute, nestedReq)...)
+		if attribute.IsWriteOnly() && !nestedChildAttribute.IsWriteOnly() {
+			diags.Append(AttributeWriteOnlyNestedChildDiag(nestedAttributePath))
+		}
+
+		diags.Append(ValidateAttributeImplementation(ctx, nestedChildAttribute, nestedReq)...)
 	}
 
 	return diags
@@ -55,6 +55,10 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 
 	nestingMode := block.GetNestingMode()
 
+	if nestingMode == BlockNestingModeSet && BlockContainsAnyWriteOnlyChildAttributes(block) {
+		diags.Append(AttributeWriteOnlyInSetDiag(req.Path))
+	}
+
 	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
 		var nestedAttributePath path.Path
 
@@ -43,3 +43,101 @@ func AttributeMissingElementTypeDiag(attributePath path.Path) diag.Diagnostic {
 			"One of these fields is required to prevent other unexpected errors or panics.",
 	)
 }
+
+// AttributeWriteOnlyComputedDiag returns an error diagnostic to provider
+// developers about a write-only Attribute implementation that is also
+// computed. Write-only values are never persisted to plan or state, so
+// the provider could never set a value.
+func AttributeWriteOnlyComputedDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a WriteOnly Attribute that is also Computed. ", attributePath)+
+			"WriteOnly Attributes must be Optional or Required, as their values are never persisted to plan or state.",
+	)
+}
+
+// AttributeWriteOnlyDefaultDiag returns an error diagnostic to provider
+// developers about a write-only Attribute implementation that defines a
+// Default. Write-only values are never persisted to plan or state, so the
+// default value could never be applied.
+func AttributeWriteOnlyDefaultDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a WriteOnly Attribute that defines a Default. ", attributePath)+
+			"WriteOnly Attributes cannot define a Default, as their values are never persisted to plan or state.",
+	)
+}
+
+// AttributeWriteOnlyNestedChildDiag returns an error diagnostic to provider
+// developers about a write-only nested Attribute implementation that
+// contains child attributes which are not write-only.
+func AttributeWriteOnlyNestedChildDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a child Attribute of a WriteOnly nested Attribute, but is not WriteOnly. ", attributePath)+
+			"All child Attributes of a WriteOnly nested Attribute must also be WriteOnly.",
+	)
+}
+
+// AttributeWriteOnlyInSetDiag returns an error diagnostic to provider
+// developers about a set nested Attribute or Block implementation that
+// contains write-only child attributes, which Terraform does not support.
+func AttributeWriteOnlyInSetDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Schema Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a set nested Attribute or Block that contains WriteOnly child Attributes. ", attributePath)+
+			"WriteOnly Attributes are not supported within sets.",
+	)
+}
+
+// AttributeInvalidImportRequirementDiag returns an error diagnostic to
+// provider developers about a resource identity Attribute implementation that
+// does not set exactly one of RequiredForImport or OptionalForImport.
+func AttributeInvalidImportRequirementDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q must set exactly one of RequiredForImport or OptionalForImport to true.", attributePath),
+	)
+}
+
+// AttributeInvalidIdentityElementTypeDiag returns an error diagnostic to
+// provider developers about a resource identity collection Attribute
+// implementation with an element type Terraform does not support in
+// resource identity data.
+func AttributeInvalidIdentityElementTypeDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is a resource identity list Attribute with an unsupported element type. ", attributePath)+
+			"Resource identity list elements must be a bool, number, or string type.",
+	)
+}
@@ -15,4 +15,10 @@ var (
 	// ErrPathIsBlock is used with AttributeAtPath is called on a path is a
 	// block, not an attribute. Use blockAtPath on the path instead.
 	ErrPathIsBlock = errors.New("path leads to block, not an attribute")
+
+	// ErrPathInsideDynamicAttribute is used with AttributeAtPath is called
+	// on a path that doesn't have a schema associated with it, because it's
+	// an element or attribute of the value of a dynamic attribute, whose type
+	// is only known from the value.
+	ErrPathInsideDynamicAttribute = errors.New("path leads to element or attribute nested in a dynamic attribute")
 )
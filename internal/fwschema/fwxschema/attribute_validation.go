@@ -17,6 +17,15 @@ type AttributeWithBoolValidators interface {
 	BoolValidators() []validator.Bool
 }
 
+// AttributeWithFloat32Validators is an optional interface on Attribute which
+// enables Float32 validation support.
+type AttributeWithFloat32Validators interface {
+	fwschema.Attribute
+
+	// Float32Validators should return a list of Float32 validators.
+	Float32Validators() []validator.Float32
+}
+
 // AttributeWithFloat64Validators is an optional interface on Attribute which
 // enables Float64 validation support.
 type AttributeWithFloat64Validators interface {
@@ -26,6 +35,15 @@ type AttributeWithFloat64Validators interface {
 	Float64Validators() []validator.Float64
 }
 
+// AttributeWithInt32Validators is an optional interface on Attribute which
+// enables Int32 validation support.
+type AttributeWithInt32Validators interface {
+	fwschema.Attribute
+
+	// Int32Validators should return a list of Int32 validators.
+	Int32Validators() []validator.Int32
+}
+
 // AttributeWithInt64Validators is an optional interface on Attribute which
 // enables Int64 validation support.
 type AttributeWithInt64Validators interface {
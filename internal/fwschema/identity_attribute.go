@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// IdentityAttribute defines a resource identity schema attribute, which
+// extends Attribute with information about whether practitioners must or can
+// supply the attribute value when importing a resource by identity.
+type IdentityAttribute interface {
+	Attribute
+
+	// IsRequiredForImport should return true if the attribute value must be
+	// set when importing a resource by identity.
+	IsRequiredForImport() bool
+
+	// IsOptionalForImport should return true if the attribute value can be
+	// set when importing a resource by identity.
+	IsOptionalForImport() bool
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// nestedAttributesAsBlocksKey is the context key for
+// ContextWithNestedAttributesAsBlocks.
+type nestedAttributesAsBlocksKey struct{}
+
+// ContextWithNestedAttributesAsBlocks returns a context which signals that
+// nested attributes are represented as nested blocks in the protocol. This
+// enables protocol version 5, which does not support nested attributes, to
+// serve schemas containing single, list, and set nested attributes.
+func ContextWithNestedAttributesAsBlocks(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nestedAttributesAsBlocksKey{}, true)
+}
+
+// NestedAttributesAsBlocks returns true if the context was returned by
+// ContextWithNestedAttributesAsBlocks.
+func NestedAttributesAsBlocks(ctx context.Context) bool {
+	enabled, ok := ctx.Value(nestedAttributesAsBlocksKey{}).(bool)
+
+	return ok && enabled
+}
+
+// SchemaNestedAttributePathExpressions returns a slice of all path
+// expressions which represent a NestedAttribute according to the Schema,
+// including those underneath blocks and other nested attributes.
+func SchemaNestedAttributePathExpressions(ctx context.Context, s Schema) path.Expressions {
+	result := path.Expressions{}
+
+	for name, attribute := range s.GetAttributes() {
+		result = append(result, attributeNestedAttributePathExpressions(ctx, attribute, path.MatchRoot(name))...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		result = append(result, blockNestedAttributePathExpressions(ctx, block, path.MatchRoot(name))...)
+	}
+
+	return result
+}
+
+// attributeNestedAttributePathExpressions returns the path expressions of the
+// given attribute and any attributes underneath it which are NestedAttribute.
+func attributeNestedAttributePathExpressions(ctx context.Context, attribute Attribute, pathExpression path.Expression) path.Expressions {
+	nestedAttribute, ok := attribute.(NestedAttribute)
+
+	if !ok {
+		return nil
+	}
+
+	result := path.Expressions{pathExpression}
+
+	for name, nestedAttr := range nestedAttribute.GetNestedObject().GetAttributes() {
+		nestingMode := nestedAttribute.GetNestingMode()
+
+		switch nestingMode {
+		case NestingModeList:
+			result = append(result, attributeNestedAttributePathExpressions(ctx, nestedAttr, pathExpression.AtAnyListIndex().AtName(name))...)
+		case NestingModeMap:
+			result = append(result, attributeNestedAttributePathExpressions(ctx, nestedAttr, pathExpression.AtAnyMapKey().AtName(name))...)
+		case NestingModeSet:
+			result = append(result, attributeNestedAttributePathExpressions(ctx, nestedAttr, pathExpression.AtAnySetValue().AtName(name))...)
+		case NestingModeSingle:
+			result = append(result, attributeNestedAttributePathExpressions(ctx, nestedAttr, pathExpression.AtName(name))...)
+		default:
+			panic(fmt.Sprintf("unhandled NestingMode: %T", nestingMode))
+		}
+	}
+
+	return result
+}
+
+// blockNestedAttributePathExpressions returns the path expressions of any
+// attributes underneath the given block which are NestedAttribute.
+func blockNestedAttributePathExpressions(ctx context.Context, block Block, pathExpression path.Expression) path.Expressions {
+	var result path.Expressions
+
+	nestingMode := block.GetNestingMode()
+
+	var objectPathExpression path.Expression
+
+	switch nestingMode {
+	case BlockNestingModeList:
+		objectPathExpression = pathExpression.AtAnyListIndex()
+	case BlockNestingModeSet:
+		objectPathExpression = pathExpression.AtAnySetValue()
+	case BlockNestingModeSingle:
+		objectPathExpression = pathExpression
+	default:
+		panic(fmt.Sprintf("unhandled BlockNestingMode: %T", nestingMode))
+	}
+
+	for name, attribute := range block.GetNestedObject().GetAttributes() {
+		result = append(result, attributeNestedAttributePathExpressions(ctx, attribute, objectPathExpression.AtName(name))...)
+	}
+
+	for name, nestedBlock := range block.GetNestedObject().GetBlocks() {
+		result = append(result, blockNestedAttributePathExpressions(ctx, nestedBlock, objectPathExpression.AtName(name))...)
+	}
+
+	return result
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Schema is the core interface required for data sources, providers, and
@@ -131,6 +132,10 @@ func SchemaAttributeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.At
 	rawType, remaining, err := tftypes.WalkAttributePath(s, p)
 
 	if err != nil {
+		if isDynamic(rawType) {
+			return nil, ErrPathInsideDynamicAttribute
+		}
+
 		return nil, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
 
@@ -200,6 +205,10 @@ func SchemaTypeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.Attribu
 	rawType, remaining, err := tftypes.WalkAttributePath(s, p)
 
 	if err != nil {
+		if isDynamic(rawType) {
+			return nil, ErrPathInsideDynamicAttribute
+		}
+
 		return nil, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
 
@@ -238,3 +247,20 @@ func SchemaType(s Schema) attr.Type {
 
 	return types.ObjectType{AttrTypes: attrTypes}
 }
+
+// isDynamic returns true if the given value from walking a schema is a dynamic
+// attribute or type, which cannot be walked any further.
+func isDynamic(rawType interface{}) bool {
+	switch typ := rawType.(type) {
+	case Attribute:
+		_, ok := typ.GetType().(basetypes.DynamicTypable)
+
+		return ok
+	case attr.Type:
+		_, ok := typ.(basetypes.DynamicTypable)
+
+		return ok
+	default:
+		return false
+	}
+}
@@ -188,3 +188,144 @@ func TestSchemaBlockPathExpressions(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaNestedAttributePathExpressions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   fwschema.Schema
+		expected path.Expressions
+	}{
+		"no-nested-attributes": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_attribute": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expected: path.Expressions{},
+		},
+		"nested-attributes": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"list_nested_attribute": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"set_nested_attribute": testschema.NestedAttribute{
+									NestedObject: testschema.NestedAttributeObject{
+										Attributes: map[string]fwschema.Attribute{
+											"test_attribute": testschema.Attribute{
+												Required: true,
+												Type:     types.StringType,
+											},
+										},
+									},
+									NestingMode: fwschema.NestingModeSet,
+									Required:    true,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Required:    true,
+					},
+					"single_nested_attribute": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_attribute": testschema.Attribute{
+									Required: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSingle,
+						Required:    true,
+					},
+					"test_attribute": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("list_nested_attribute"),
+				path.MatchRoot("list_nested_attribute").AtAnyListIndex().AtName("set_nested_attribute"),
+				path.MatchRoot("single_nested_attribute"),
+			},
+		},
+		"block-nested-attributes": {
+			schema: testschema.Schema{
+				Blocks: map[string]fwschema.Block{
+					"list_block": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"single_nested_attribute": testschema.NestedAttribute{
+									NestedObject: testschema.NestedAttributeObject{
+										Attributes: map[string]fwschema.Attribute{
+											"test_attribute": testschema.Attribute{
+												Required: true,
+												Type:     types.StringType,
+											},
+										},
+									},
+									NestingMode: fwschema.NestingModeSingle,
+									Required:    true,
+								},
+							},
+							Blocks: map[string]fwschema.Block{
+								"single_block": testschema.Block{
+									NestedObject: testschema.NestedBlockObject{
+										Attributes: map[string]fwschema.Attribute{
+											"list_nested_attribute": testschema.NestedAttribute{
+												NestedObject: testschema.NestedAttributeObject{
+													Attributes: map[string]fwschema.Attribute{
+														"test_attribute": testschema.Attribute{
+															Required: true,
+															Type:     types.StringType,
+														},
+													},
+												},
+												NestingMode: fwschema.NestingModeList,
+												Required:    true,
+											},
+										},
+									},
+									NestingMode: fwschema.BlockNestingModeSingle,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("list_block").AtAnyListIndex().AtName("single_block").AtName("list_nested_attribute"),
+				path.MatchRoot("list_block").AtAnyListIndex().AtName("single_nested_attribute"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.SchemaNestedAttributePathExpressions(context.Background(), testCase.schema)
+
+			// Prevent differences due to randomized Go map access during testing.
+			sort.Slice(testCase.expected, func(i, j int) bool {
+				return testCase.expected[i].String() < testCase.expected[j].String()
+			})
+
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].String() < got[j].String()
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// ContainsAnyWriteOnlyChildAttributes returns true if any child attribute of
+// the given NestedAttribute, at any nesting level, is write-only.
+func ContainsAnyWriteOnlyChildAttributes(nestedAttr NestedAttribute) bool {
+	nestedObject := nestedAttr.GetNestedObject()
+
+	if nestedObject == nil {
+		return false
+	}
+
+	for _, childAttr := range nestedObject.GetAttributes() {
+		if childAttr.IsWriteOnly() {
+			return true
+		}
+
+		nestedChildAttr, ok := childAttr.(NestedAttribute)
+
+		if ok && ContainsAnyWriteOnlyChildAttributes(nestedChildAttr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BlockContainsAnyWriteOnlyChildAttributes returns true if any child
+// attribute of the given Block, including within nested blocks at any
+// nesting level, is write-only.
+func BlockContainsAnyWriteOnlyChildAttributes(block Block) bool {
+	nestedObject := block.GetNestedObject()
+
+	if nestedObject == nil {
+		return false
+	}
+
+	for _, childAttr := range nestedObject.GetAttributes() {
+		if childAttr.IsWriteOnly() {
+			return true
+		}
+
+		nestedChildAttr, ok := childAttr.(NestedAttribute)
+
+		if ok && ContainsAnyWriteOnlyChildAttributes(nestedChildAttr) {
+			return true
+		}
+	}
+
+	for _, childBlock := range nestedObject.GetBlocks() {
+		if BlockContainsAnyWriteOnlyChildAttributes(childBlock) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
@@ -30,6 +31,35 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 	// Errors are handled as richer diag.Diagnostics instead.
 	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Skip the root of the data, only applying defaults to attributes.
+		if len(tfTypePath.Steps()) < 1 {
+			return tfTypeValue, nil
+		}
+
+		attrAtPath, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
+				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
+				logging.FrameworkTrace(ctx, "attribute is a non-schema attribute, not setting default")
+				return tfTypeValue, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// ignore attributes/elements inside dynamic values, they have no schema of their own
+				logging.FrameworkTrace(ctx, "attribute is inside a dynamic attribute, not setting default")
+				return tfTypeValue, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathIsBlock) {
+				// ignore blocks, they do not have a computed field
+				logging.FrameworkTrace(ctx, "attribute is a block, not setting default")
+				return tfTypeValue, nil
+			}
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
 		diags.Append(fwPathDiags...)
@@ -54,38 +84,72 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			return tfTypeValue, nil
 		}
 
-		attrAtPath, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+		switch a := attrAtPath.(type) {
+		case fwschema.AttributeWithBoolDefaultValue:
+			defaultValue := a.BoolDefaultValue()
 
-		if err != nil {
-			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
-				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
-				logging.FrameworkTrace(ctx, "attribute is a non-schema attribute, not setting default")
+			if defaultValue == nil {
 				return tfTypeValue, nil
 			}
 
-			if errors.Is(err, fwschema.ErrPathIsBlock) {
-				// ignore blocks, they do not have a computed field
-				logging.FrameworkTrace(ctx, "attribute is a block, not setting default")
+			req := defaults.BoolRequest{
+				Path: fwPath,
+			}
+			resp := defaults.BoolResponse{}
+
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultBool", AttributePath: fwPath}, func() {
+				defaultValue.DefaultBool(ctx, req, &resp)
+			})
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
 				return tfTypeValue, nil
 			}
 
-			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
-		}
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
-		switch a := attrAtPath.(type) {
-		case fwschema.AttributeWithBoolDefaultValue:
-			defaultValue := a.BoolDefaultValue()
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithDynamicDefaultValue:
+			defaultValue := a.DynamicDefaultValue()
 
 			if defaultValue == nil {
 				return tfTypeValue, nil
 			}
 
-			req := defaults.BoolRequest{
+			req := defaults.DynamicRequest{
 				Path: fwPath,
 			}
-			resp := defaults.BoolResponse{}
+			resp := defaults.DynamicResponse{}
 
-			defaultValue.DefaultBool(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultDynamic", AttributePath: fwPath}, func() {
+				defaultValue.DefaultDynamic(ctx, req, &resp)
+			})
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithFloat32DefaultValue:
+			defaultValue := a.Float32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Float32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Float32Response{}
+
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultFloat32", AttributePath: fwPath}, func() {
+				defaultValue.DefaultFloat32(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -108,7 +172,34 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.Float64Response{}
 
-			defaultValue.DefaultFloat64(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultFloat64", AttributePath: fwPath}, func() {
+				defaultValue.DefaultFloat64(ctx, req, &resp)
+			})
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithInt32DefaultValue:
+			defaultValue := a.Int32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Int32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Int32Response{}
+
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultInt32", AttributePath: fwPath}, func() {
+				defaultValue.DefaultInt32(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -131,7 +222,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.Int64Response{}
 
-			defaultValue.DefaultInt64(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultInt64", AttributePath: fwPath}, func() {
+				defaultValue.DefaultInt64(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -155,7 +248,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.ListResponse{}
 
-			defaultValue.DefaultList(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultList", AttributePath: fwPath}, func() {
+				defaultValue.DefaultList(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -183,7 +278,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.MapResponse{}
 
-			defaultValue.DefaultMap(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultMap", AttributePath: fwPath}, func() {
+				defaultValue.DefaultMap(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -212,7 +309,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.NumberResponse{}
 
-			defaultValue.DefaultNumber(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultNumber", AttributePath: fwPath}, func() {
+				defaultValue.DefaultNumber(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -235,7 +334,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.ObjectResponse{}
 
-			defaultValue.DefaultObject(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultObject", AttributePath: fwPath}, func() {
+				defaultValue.DefaultObject(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -258,7 +359,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.SetResponse{}
 
-			defaultValue.DefaultSet(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultSet", AttributePath: fwPath}, func() {
+				defaultValue.DefaultSet(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
@@ -287,7 +390,9 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 			resp := defaults.StringResponse{}
 
-			defaultValue.DefaultString(ctx, req, &resp)
+			fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: defaultValue, Name: "DefaultString", AttributePath: fwPath}, func() {
+				defaultValue.DefaultString(ctx, req, &resp)
+			})
 
 			diags.Append(resp.Diagnostics...)
 
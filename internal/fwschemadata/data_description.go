@@ -15,6 +15,14 @@ const (
 	// DataDescriptionState is used for Data that represents
 	// a state-based value.
 	DataDescriptionState DataDescription = "state"
+
+	// DataDescriptionEphemeralResultData is used for Data that represents
+	// an ephemeral result-based value.
+	DataDescriptionEphemeralResultData DataDescription = "ephemeral result data"
+
+	// DataDescriptionResourceIdentity is used for Data that represents
+	// a resource identity-based value.
+	DataDescriptionResourceIdentity DataDescription = "resource identity"
 )
 
 // DataDescription is a human friendly type for Data. Used in error
@@ -40,6 +48,10 @@ func (d DataDescription) Title() string {
 		return "Plan"
 	case DataDescriptionState:
 		return "State"
+	case DataDescriptionEphemeralResultData:
+		return "Ephemeral Result Data"
+	case DataDescriptionResourceIdentity:
+		return "Resource Identity"
 	default:
 		return "Data"
 	}
@@ -14,12 +14,19 @@ import (
 )
 
 // NullifyCollectionBlocks converts list and set block empty values to null
-// values. The reverse conversion is ReifyNullCollectionBlocks.
+// values. The reverse conversion is ReifyNullCollectionBlocks. List and set
+// nested attributes are included when the context was returned by
+// fwschema.ContextWithNestedAttributesAsBlocks.
 func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
+	// Nested attributes are blocks in the protocol when they are converted.
+	if fwschema.NestedAttributesAsBlocks(ctx) {
+		blockPathExpressions = append(blockPathExpressions, fwschema.SchemaNestedAttributePathExpressions(ctx, d.Schema)...)
+	}
+
 	// Errors are handled as richer diag.Diagnostics instead.
 	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
 		// Do not transform if value is already null or is not fully known.
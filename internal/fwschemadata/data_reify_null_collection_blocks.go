@@ -14,12 +14,19 @@ import (
 )
 
 // ReifyNullCollectionBlocks converts list and set block null values to empty
-// values. This is the reverse conversion of NullifyCollectionBlocks.
+// values. This is the reverse conversion of NullifyCollectionBlocks. List and
+// set nested attributes are included when the context was returned by
+// fwschema.ContextWithNestedAttributesAsBlocks.
 func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
+	// Nested attributes are blocks in the protocol when they are converted.
+	if fwschema.NestedAttributesAsBlocks(ctx) {
+		blockPathExpressions = append(blockPathExpressions, fwschema.SchemaNestedAttributePathExpressions(ctx, d.Schema)...)
+	}
+
 	// Errors are handled as richer diag.Diagnostics instead.
 	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
 		// Only transform null values.
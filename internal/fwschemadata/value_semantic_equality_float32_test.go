@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueSemanticEqualityFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  fwschemadata.ValueSemanticEqualityRequest
+		expected *fwschemadata.ValueSemanticEqualityResponse
+	}{
+		"Float32Value": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path:             path.Root("test"),
+				PriorValue:       types.Float32Value(1.2),
+				ProposedNewValue: types.Float32Value(2.4),
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: types.Float32Value(2.4),
+			},
+		},
+		"Float32ValuableWithSemanticEquals-true": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(1.2),
+					SemanticEquals: true,
+				},
+				ProposedNewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(2.4),
+					SemanticEquals: true,
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(1.2),
+					SemanticEquals: true,
+				},
+			},
+		},
+		"Float32ValuableWithSemanticEquals-false": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(1.2),
+					SemanticEquals: false,
+				},
+				ProposedNewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(2.4),
+					SemanticEquals: false,
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(2.4),
+					SemanticEquals: false,
+				},
+			},
+		},
+		"Float32ValuableWithSemanticEquals-diagnostics": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(1.2),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+				ProposedNewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(2.4),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value:   types.Float32Value(2.4),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+					diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testCase.request.ProposedNewValue,
+			}
+
+			fwschemadata.ValueSemanticEqualityFloat32(context.Background(), testCase.request, got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -107,6 +107,89 @@ func TestValueSemanticEquality(t *testing.T) {
 				},
 			},
 		},
+		"DynamicValue": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path:             path.Root("test"),
+				PriorValue:       types.DynamicValue(types.StringValue("prior")),
+				ProposedNewValue: types.DynamicValue(types.StringValue("new")),
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: types.DynamicValue(types.StringValue("new")),
+			},
+		},
+		"DynamicValuableWithSemanticEquals-true": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("prior")),
+					SemanticEquals: true,
+				},
+				ProposedNewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("new")),
+					SemanticEquals: true,
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("prior")),
+					SemanticEquals: true,
+				},
+			},
+		},
+		"DynamicValuableWithSemanticEquals-false": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("prior")),
+					SemanticEquals: false,
+				},
+				ProposedNewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("new")),
+					SemanticEquals: false,
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("new")),
+					SemanticEquals: false,
+				},
+			},
+		},
+		"DynamicValuableWithSemanticEquals-diagnostics": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("prior")),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+				ProposedNewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("new")),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue:   types.DynamicValue(types.StringValue("new")),
+					SemanticEquals: false,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+					diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+				},
+			},
+		},
 		"Float64Value": {
 			request: fwschemadata.ValueSemanticEqualityRequest{
 				Path:             path.Root("test"),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -92,8 +93,14 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 	switch attributeWithPlanModifiers := a.(type) {
 	case fwxschema.AttributeWithBoolPlanModifiers:
 		AttributePlanModifyBool(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithDynamicPlanModifiers:
+		AttributePlanModifyDynamic(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithFloat32PlanModifiers:
+		AttributePlanModifyFloat32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithFloat64PlanModifiers:
 		AttributePlanModifyFloat64(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithInt32PlanModifiers:
+		AttributePlanModifyInt32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithInt64PlanModifiers:
 		AttributePlanModifyInt64(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithListPlanModifiers:
@@ -693,7 +700,9 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyBool(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyBool", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyBool(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -734,6 +743,330 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	}
 }
 
+// AttributePlanModifyDynamic performs all types.Dynamic plan modification.
+func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.AttributeWithDynamicPlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.DynamicValuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.DynamicValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Dynamic Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Dynamic attribute plan modification. "+
+				"The value type must implement the basetypes.DynamicValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToDynamicValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.DynamicValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Dynamic Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Dynamic attribute plan modification. "+
+				"The value type must implement the basetypes.DynamicValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToDynamicValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.DynamicValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Dynamic Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Dynamic attribute plan modification. "+
+				"The value type must implement the basetypes.DynamicValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToDynamicValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceDynamicTypable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.DynamicRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.DynamicPlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.DynamicResponse{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined planmodifier.Dynamic",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyDynamic", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyDynamic(ctx, planModifyReq, planModifyResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined planmodifier.Dynamic",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromDynamic(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
+// AttributePlanModifyFloat32 performs all types.Float32 plan modification.
+func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceFloat32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Float32PlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Float32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyFloat32", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyFloat32(ctx, planModifyReq, planModifyResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromFloat32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyFloat64 performs all types.Float64 plan modification.
 func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -853,7 +1186,9 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 			},
 		)
 
-		planModifier.PlanModifyFloat64(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyFloat64", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyFloat64(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -894,6 +1229,168 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	}
 }
 
+// AttributePlanModifyInt32 performs all types.Int32 plan modification.
+func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceInt32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Int32PlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Int32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyInt32", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyInt32(ctx, planModifyReq, planModifyResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromInt32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyInt64 performs all types.Int64 plan modification.
 func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -1013,7 +1510,9 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		planModifier.PlanModifyInt64(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyInt64", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyInt64(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1173,7 +1672,9 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyList", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1333,7 +1834,9 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifyMap(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyMap", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyMap(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1493,7 +1996,9 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyNumber(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyNumber", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyNumber(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1653,7 +2158,9 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyObject", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1813,7 +2320,9 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifySet", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -1973,7 +2482,9 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 			},
 		)
 
-		planModifier.PlanModifyString(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyString", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyString(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -2032,7 +2543,9 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 				},
 			)
 
-			objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: objectPlanModifier, Name: "PlanModifyObject", AttributePath: req.Path}, func() {
+				objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			})
 
 			logging.FrameworkDebug(
 				ctx,
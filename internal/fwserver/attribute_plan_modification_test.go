@@ -2606,26 +2606,1934 @@ func TestAttributePlanModifyBool(t *testing.T) {
 	}
 }
 
+func TestAttributePlanModifyDynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithDynamicPlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:           types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:          types.DynamicValue(types.StringValue("testvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.DynamicPseudoType,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.DynamicPseudoType,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.ConfigValue
+							expected := types.DynamicValue(types.StringValue("testvalue"))
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicNull(),
+				AttributeState:  types.DynamicNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicNull(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicNull(),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.DynamicPseudoType,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.DynamicPseudoType,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.PlanValue
+							expected := types.DynamicValue(types.StringValue("testvalue"))
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicNull(),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicNull(),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicNull(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.DynamicPseudoType,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.DynamicPseudoType,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.DynamicPseudoType, "testvalue"),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							got := req.StateValue
+							expected := types.DynamicValue(types.StringValue("testvalue"))
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected DynamicRequest.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicNull(),
+				AttributePlan:   types.DynamicNull(),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicNull(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicNull(),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("testvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.PlanValue = types.DynamicValue(types.StringValue("testvalue"))
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicNull(),
+				AttributePlan:   types.DynamicUnknown(),
+				AttributeState:  types.DynamicNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.PlanValue = types.DynamicValue(types.StringValue("testvalue"))
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue: types.DynamicNull(),
+				},
+				AttributePlan: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue: types.DynamicUnknown(),
+				},
+				AttributeState: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue: types.DynamicNull(),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue: types.DynamicUnknown(),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.DynamicValueWithSemanticEquals{
+					DynamicValue: types.DynamicValue(types.StringValue("testvalue")),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicNull(),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicNull(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("oldtestvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("oldtestvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithDynamicPlanModifiers{
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.DynamicValue(types.StringValue("testvalue")),
+				AttributePlan:   types.DynamicValue(types.StringValue("testvalue")),
+				AttributeState:  types.DynamicValue(types.StringValue("oldtestvalue")),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.DynamicValue(types.StringValue("testvalue")),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyDynamic(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithFloat32PlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.Float32Value(1.2),
+				AttributePlan:           types.Float32Value(1.2),
+				AttributeState:          types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.ConfigValue
+							expected := types.Float32Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Null(),
+				AttributeState:  types.Float32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.PlanValue
+							expected := types.Float32Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.StateValue
+							expected := types.Float32Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Null(),
+				AttributeState:  types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.PlanValue = types.Float32Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Unknown(),
+				AttributeState:  types.Float32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Unknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.PlanValue = types.Float32Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Null(),
+				},
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Unknown(),
+				},
+				AttributeState: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Null(),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Unknown(),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Value(1.2),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyFloat32(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestAttributePlanModifyFloat64(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithFloat64PlanModifiers
+		attribute fwxschema.AttributeWithFloat64PlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.Float64Value(1.2),
+				AttributePlan:           types.Float64Value(1.2),
+				AttributeState:          types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.ConfigValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Null(),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.PlanValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.StateValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Null(),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.PlanValue = types.Float64Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Unknown(),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Unknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.PlanValue = types.Float64Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Null(),
+				},
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Unknown(),
+				},
+				AttributeState: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Null(),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Unknown(),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Value(1.2),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithInt32PlanModifiers
 		request   ModifyAttributePlanRequest
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Path",
+									"Unexpected Int32Request.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2635,28 +4543,28 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.PathExpression",
+									"Unexpected Int32Request.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2667,22 +4575,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			request: ModifyAttributePlanRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.Float64Value(1.2),
-				AttributePlan:           types.Float64Value(1.2),
-				AttributeState:          types.Float64Value(1.2),
+				AttributeConfig:         types.Int32Value(1),
+				AttributePlan:           types.Int32Value(1),
+				AttributeState:          types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
@@ -2699,7 +4607,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Config",
+									"Unexpected Int32Request.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -2709,9 +4617,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2726,23 +4634,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.ConfigValue
-							expected := types.Float64Value(1.2)
+							expected := types.Int32Value(1)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.ConfigValue",
+									"Unexpected Int32Request.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2752,22 +4660,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Null(),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Null(),
+				AttributeState:  types.Int32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 		},
 		"request-plan": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.Plan
 							expected := tfsdk.Plan{
 								Raw: tftypes.NewValue(
@@ -2784,7 +4692,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Plan",
+									"Unexpected Int32Request.Plan",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -2794,9 +4702,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 				Plan: tfsdk.Plan{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2811,23 +4719,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-planvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.PlanValue
-							expected := types.Float64Value(1.2)
+							expected := types.Int32Value(1)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.PlanValue",
+									"Unexpected Int32Request.PlanValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2837,22 +4745,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-private": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got, diags := req.Private.GetKey(ctx, "testkey")
 							expected := []byte(`{"testproperty":true}`)
 
@@ -2860,7 +4768,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if diff := cmp.Diff(got, expected); diff != "" {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Private",
+									"Unexpected Int32Request.Private",
 									diff,
 								)
 							}
@@ -2870,9 +4778,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -2881,7 +4789,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -2890,7 +4798,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -2900,10 +4808,10 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"request-state": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.State
 							expected := tfsdk.State{
 								Raw: tftypes.NewValue(
@@ -2920,7 +4828,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.State",
+									"Unexpected Int32Request.State",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -2930,9 +4838,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2947,23 +4855,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-statevalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.StateValue
-							expected := types.Float64Value(1.2)
+							expected := types.Int32Value(1)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.StateValue",
+									"Unexpected Int32Request.StateValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2973,22 +4881,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Null(),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Null(),
+				AttributeState:  types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -2997,12 +4905,12 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -3017,7 +4925,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -3043,66 +4951,66 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"response-planvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
-							resp.PlanValue = types.Float64Value(1.2)
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							resp.PlanValue = types.Int32Value(1)
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Unknown(),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Unknown(),
+				AttributeState:  types.Int32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Unknown(),
+				AttributePlan: types.Int32Unknown(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"response-planvalue-custom-type": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
-							resp.PlanValue = types.Float64Value(1.2)
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							resp.PlanValue = types.Int32Value(1)
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath: path.Root("test"),
-				AttributeConfig: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Null(),
+				AttributeConfig: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Null(),
 				},
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Unknown(),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Unknown(),
 				},
-				AttributeState: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Null(),
+				AttributeState: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Null(),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Unknown(),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Unknown(),
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Value(1.2),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Value(1),
 				},
 			},
 		},
 		"response-private": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.Diagnostics.Append(
 								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
 							)
@@ -3112,9 +5020,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -3123,7 +5031,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -3132,7 +5040,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -3142,10 +5050,10 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"response-requiresreplace-add": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -3153,25 +5061,25 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"),
 				},
 			},
 		},
 		"response-requiresreplace-false": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = false // same as not being set
 						},
 					},
@@ -3179,28 +5087,28 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains as it should not be removed
 				},
 			},
 		},
 		"response-requiresreplace-update": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -3208,18 +5116,18 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains deduplicated
 				},
@@ -3233,7 +5141,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributePlanModifyFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributePlanModifyInt32(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
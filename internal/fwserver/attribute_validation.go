@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -111,8 +112,14 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithDynamicValidators:
+		AttributeValidateDynamic(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithFloat32Validators:
+		AttributeValidateFloat32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithFloat64Validators:
 		AttributeValidateFloat64(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithInt32Validators:
+		AttributeValidateInt32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithInt64Validators:
 		AttributeValidateInt64(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithListValidators:
@@ -192,7 +199,9 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateBool(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateBool", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateBool(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -206,6 +215,140 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 	}
 }
 
+// AttributeValidateDynamic performs all types.Dynamic validation.
+func AttributeValidateDynamic(ctx context.Context, attribute fwxschema.AttributeWithDynamicValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.DynamicValuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.DynamicValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Dynamic Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Dynamic attribute validation. "+
+				"The value type must implement the basetypes.DynamicValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToDynamicValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.DynamicRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.DynamicValidators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.DynamicResponse{}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined validator.Dynamic",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateDynamic", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateDynamic(ctx, validateReq, validateResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined validator.Dynamic",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
+// AttributeValidateFloat32 performs all types.Float32 validation.
+func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute validation. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Float32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Float32Response{}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateFloat32", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateFloat32(ctx, validateReq, validateResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateFloat64 performs all types.Float64 validation.
 func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -257,7 +400,9 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
-		attributeValidator.ValidateFloat64(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateFloat64", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateFloat64(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -271,6 +416,73 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 	}
 }
 
+// AttributeValidateInt32 performs all types.Int32 validation.
+func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute validation. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Int32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Int32Response{}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateInt32", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateInt32(ctx, validateReq, validateResp)
+		})
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateInt64 performs all types.Int64 validation.
 func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -322,7 +534,9 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
-		attributeValidator.ValidateInt64(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateInt64", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateInt64(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -387,7 +601,9 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
-		attributeValidator.ValidateList(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateList", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateList(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -452,7 +668,9 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateMap(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateMap", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateMap(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -517,7 +735,9 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateNumber(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateNumber", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateNumber(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -582,7 +802,9 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateObject(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateObject", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateObject(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -647,7 +869,9 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
-		attributeValidator.ValidateSet(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateSet", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateSet(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -712,7 +936,9 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
-		attributeValidator.ValidateString(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: attributeValidator, Name: "ValidateString", AttributePath: validateReq.Path}, func() {
+			attributeValidator.ValidateString(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -941,7 +1167,9 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: objectValidator, Name: "ValidateObject", AttributePath: validateReq.Path}, func() {
+				objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			})
 
 			logging.FrameworkDebug(
 				ctx,
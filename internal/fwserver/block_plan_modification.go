@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -497,7 +498,9 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 			},
 		)
 
-		planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyList", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyList(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -657,7 +660,9 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 			},
 		)
 
-		planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifyObject", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifyObject(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -817,7 +822,9 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 			},
 		)
 
-		planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: planModifier, Name: "PlanModifySet", AttributePath: planModifyReq.Path}, func() {
+			planModifier.PlanModifySet(ctx, planModifyReq, planModifyResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -876,7 +883,9 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 				},
 			)
 
-			objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			fwpanic.Call(ctx, &planModifyResp.Diagnostics, fwpanic.Method{Implementation: objectPlanModifier, Name: "PlanModifyObject", AttributePath: req.Path}, func() {
+				objectPlanModifier.PlanModifyObject(ctx, req, planModifyResp)
+			})
 
 			logging.FrameworkDebug(
 				ctx,
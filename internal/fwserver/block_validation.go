@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -222,7 +223,9 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 			},
 		)
 
-		blockValidator.ValidateList(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: blockValidator, Name: "ValidateList", AttributePath: validateReq.Path}, func() {
+			blockValidator.ValidateList(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -287,7 +290,9 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 			},
 		)
 
-		blockValidator.ValidateObject(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: blockValidator, Name: "ValidateObject", AttributePath: validateReq.Path}, func() {
+			blockValidator.ValidateObject(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -352,7 +357,9 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 			},
 		)
 
-		blockValidator.ValidateSet(ctx, validateReq, validateResp)
+		fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: blockValidator, Name: "ValidateSet", AttributePath: validateReq.Path}, func() {
+			blockValidator.ValidateSet(ctx, validateReq, validateResp)
+		})
 
 		logging.FrameworkDebug(
 			ctx,
@@ -414,7 +421,9 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 				},
 			)
 
-			objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			fwpanic.Call(ctx, &validateResp.Diagnostics, fwpanic.Method{Implementation: objectValidator, Name: "ValidateObject", AttributePath: validateReq.Path}, func() {
+				objectValidator.ValidateObject(ctx, validateReq, validateResp)
+			})
 
 			logging.FrameworkDebug(
 				ctx,
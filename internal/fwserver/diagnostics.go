@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -43,3 +44,24 @@ func schemaDataWalkError(schemaPath path.Path, value attr.Value) diag.Diagnostic
 			fmt.Sprintf("unknown attribute value type (%T) at path: %s", value, schemaPath),
 	)
 }
+
+// timeoutExceededDiagnostics returns an error diagnostic if any operation
+// deadline from the resource/timeouts package was exceeded while calling
+// the provider defined method. It should only be called when the provider
+// defined method returned an error, to explain that error.
+func timeoutExceededDiagnostics(tracker *fwtimeouts.Tracker) diag.Diagnostics {
+	operation, timeout, ok := tracker.Exceeded()
+
+	if !ok {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Operation Timeout Exceeded",
+			fmt.Sprintf("The resource %s operation did not complete within the timeout of %s. ", operation, timeout)+
+				"The remote system may still be processing the operation. "+
+				"Increase the "+operation+" timeout in the resource timeouts configuration if the operation needs more time.",
+		),
+	}
+}
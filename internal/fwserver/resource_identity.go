@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// resourceIdentity returns a copy of the given resource identity for passing
+// to provider-defined logic. If the identity is missing, a null identity is
+// returned when the resource has an identity schema, otherwise nil.
+func resourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, identitySchema fwschema.Schema) *tfsdk.ResourceIdentity {
+	if identity != nil {
+		return &tfsdk.ResourceIdentity{
+			Schema: identity.Schema,
+			Raw:    identity.Raw.Copy(),
+		}
+	}
+
+	if identitySchema == nil {
+		return nil
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// resourceIdentityIsNull returns true if the given resource identity is
+// missing or null.
+func resourceIdentityIsNull(identity *tfsdk.ResourceIdentity) bool {
+	return identity == nil || identity.Raw.Type() == nil || identity.Raw.IsNull()
+}
+
+// validateResourceIdentityUnchanged returns an error diagnostic if a prior
+// resource identity was known and the new resource identity differs from it.
+// Resource identity must not change once the resource has an identity.
+func validateResourceIdentityUnchanged(operation string, priorIdentity, newIdentity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if resourceIdentityIsNull(priorIdentity) {
+		return diags
+	}
+
+	if newIdentity != nil && priorIdentity.Raw.Equal(newIdentity.Raw) {
+		return diags
+	}
+
+	newIdentityString := "<missing>"
+
+	if newIdentity != nil {
+		newIdentityString = newIdentity.Raw.String()
+	}
+
+	diags.AddError(
+		"Unexpected Identity Change",
+		fmt.Sprintf("During the %s operation, the Terraform Provider unexpectedly returned a different identity than the previously stored one.\n\n", operation)+
+			"This is always a problem with the provider and should be reported to the provider developer.\n\n"+
+			fmt.Sprintf("Current Identity: %s\n\n", priorIdentity.Raw.String())+
+			fmt.Sprintf("New Identity: %s", newIdentityString),
+	)
+
+	return diags
+}
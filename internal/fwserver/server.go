@@ -10,10 +10,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/mutexkv"
 )
 
 // Server implements the framework provider server. Protocol specific
@@ -22,25 +30,67 @@ import (
 type Server struct {
 	Provider provider.Provider
 
+	// Interceptors are the provider defined interceptors which wrap each
+	// framework operation, in order, when called via the Intercept and
+	// InterceptFunction methods.
+	Interceptors []interceptor.Interceptor
+
+	// MetricsReporter is the provider defined reporter of operation and
+	// provider defined method measurements. The protocol specific
+	// implementations carry it through the request context.
+	MetricsReporter metrics.Reporter
+
+	// ResourceConcurrencyLimit is the default maximum number of concurrent
+	// Create, Read, Update, and Delete calls per managed resource type, for
+	// resource types which do not implement
+	// [resource.ResourceWithConcurrencyLimit]. Zero means unlimited.
+	ResourceConcurrencyLimit int
+
 	// DataSourceConfigureData is the
 	// [provider.ConfigureResponse.DataSourceData] field value which is passed
 	// to [datasource.ConfigureRequest.ProviderData].
 	DataSourceConfigureData any
 
+	// EphemeralResourceConfigureData is the
+	// [provider.ConfigureResponse.EphemeralResourceData] field value which is
+	// passed to [ephemeral.ConfigureRequest.ProviderData].
+	EphemeralResourceConfigureData any
+
 	// ResourceConfigureData is the
 	// [provider.ConfigureResponse.ResourceData] field value which is passed
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// ProviderDeferred is the [provider.ConfigureResponse.Deferred] field
+	// value. When set, all subsequent resource and data source RPCs
+	// automatically return a deferred response without calling provider
+	// defined logic.
+	ProviderDeferred *provider.Deferred
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
 	dataSourceSchemas map[string]fwschema.Schema
 
+	// dataSourceSchemasDiags is the cached Diagnostics obtained while validating
+	// the implementation of each dataSourceSchemas. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching dataSourceSchemas.
+	dataSourceSchemasDiags map[string]diag.Diagnostics
+
 	// dataSourceSchemasMutex is a mutex to protect concurrent dataSourceSchemas
 	// access from race conditions.
 	dataSourceSchemasMutex sync.RWMutex
 
+	// dataSourcePrivateData is the provider-defined private data saved by the
+	// most recent successful ReadDataSource RPC for each data source type
+	// name. Terraform does not store private data for data sources, so the
+	// data only lasts for the lifetime of the provider process.
+	dataSourcePrivateData map[string]*privatestate.ProviderData
+
+	// dataSourcePrivateDataMutex is a mutex to protect concurrent
+	// dataSourcePrivateData access from race conditions.
+	dataSourcePrivateDataMutex sync.Mutex
+
 	// dataSourceFuncs is the cached DataSource functions for RPCs that need to
 	// access data sources. If not found, it will be fetched from the
 	// Provider.DataSources() method.
@@ -55,6 +105,95 @@ type Server struct {
 	// access from race conditions.
 	dataSourceTypesMutex sync.Mutex
 
+	// ephemeralResourceSchemas is the cached EphemeralResource Schemas for
+	// RPCs that need to convert configuration data from the protocol. If not
+	// found, it will be fetched from the EphemeralResource.Schema() method.
+	ephemeralResourceSchemas map[string]fwschema.Schema
+
+	// ephemeralResourceSchemasDiags is the cached Diagnostics obtained while validating
+	// the implementation of each ephemeralResourceSchemas. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching ephemeralResourceSchemas.
+	ephemeralResourceSchemasDiags map[string]diag.Diagnostics
+
+	// ephemeralResourceSchemasMutex is a mutex to protect concurrent
+	// ephemeralResourceSchemas access from race conditions.
+	ephemeralResourceSchemasMutex sync.RWMutex
+
+	// ephemeralResourceFuncs is the cached EphemeralResource functions for
+	// RPCs that need to access ephemeral resources. If not found, it will be
+	// fetched from the Provider.EphemeralResources() method.
+	ephemeralResourceFuncs map[string]func() ephemeral.EphemeralResource
+
+	// ephemeralResourceTypesDiags is the cached Diagnostics obtained while
+	// populating ephemeralResourceFuncs. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching
+	// ephemeralResourceFuncs.
+	ephemeralResourceTypesDiags diag.Diagnostics
+
+	// ephemeralResourceTypesMutex is a mutex to protect concurrent
+	// ephemeralResourceFuncs access from race conditions.
+	ephemeralResourceTypesMutex sync.Mutex
+
+	// functionDefinitions is the cached Function Definitions for RPCs that need
+	// to convert data from the protocol. If not found, it will be fetched from
+	// the Function.Definition() method.
+	functionDefinitions map[string]function.Definition
+
+	// functionDefinitionsMutex is a mutex to protect concurrent
+	// functionDefinitions access from race conditions.
+	functionDefinitionsMutex sync.RWMutex
+
+	// functionFuncs is the cached Function functions for RPCs that need to
+	// access functions. If not found, it will be fetched from the
+	// Provider.Functions() method.
+	functionFuncs map[string]func() function.Function
+
+	// functionFuncsDiags is the cached Diagnostics obtained while populating
+	// functionFuncs. This is to ensure any warnings or errors are also
+	// returned appropriately when fetching functionFuncs.
+	functionFuncsDiags diag.Diagnostics
+
+	// functionFuncsMutex is a mutex to protect concurrent functionFuncs
+	// access from race conditions.
+	functionFuncsMutex sync.Mutex
+
+	// listResourceSchemas is the cached ListResource config Schemas for RPCs
+	// that need to convert configuration data from the protocol. If not
+	// found, it will be fetched from the ListResource.ListResourceConfigSchema()
+	// method.
+	listResourceSchemas map[string]fwschema.Schema
+
+	// listResourceSchemasDiags is the cached Diagnostics obtained while validating
+	// the implementation of each listResourceSchemas. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching listResourceSchemas.
+	listResourceSchemasDiags map[string]diag.Diagnostics
+
+	// listResourceSchemasMutex is a mutex to protect concurrent
+	// listResourceSchemas access from race conditions.
+	listResourceSchemasMutex sync.RWMutex
+
+	// listResourceFuncs is the cached ListResource functions for RPCs that
+	// need to access list resources. If not found, it will be fetched from
+	// the Provider.ListResources() method.
+	listResourceFuncs map[string]func() list.ListResource
+
+	// listResourceTypesDiags is the cached Diagnostics obtained while
+	// populating listResourceFuncs. This is to ensure any warnings or errors
+	// are also returned appropriately when fetching listResourceFuncs.
+	listResourceTypesDiags diag.Diagnostics
+
+	// listResourceTypesMutex is a mutex to protect concurrent
+	// listResourceFuncs access from race conditions.
+	listResourceTypesMutex sync.Mutex
+
+	// mutexKV is the keyed mutex of the provider server, which is created on
+	// first use.
+	mutexKV *mutexkv.MutexKV
+
+	// mutexKVMutex is a mutex to protect concurrent mutexKV access from race
+	// conditions.
+	mutexKVMutex sync.Mutex
+
 	// providerSchema is the cached Provider Schema for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the Provider.GetSchema() method.
@@ -84,14 +223,48 @@ type Server struct {
 	providerMetaSchemaMutex sync.Mutex
 
 	// providerTypeName is the type name of the provider, if the provider
-	// implemented the Metadata method.
+	// implemented the Metadata method. If not found, it will be fetched from
+	// the Provider.Metadata() method.
 	providerTypeName string
 
+	// providerTypeNameMutex is a mutex to protect concurrent providerTypeName
+	// access from race conditions.
+	providerTypeNameMutex sync.Mutex
+
+	// resourceConcurrencySemaphores is the cached concurrency limiting
+	// semaphore of each managed resource type, where the channel capacity is
+	// the limit. A nil channel means the resource type is not limited.
+	resourceConcurrencySemaphores map[string]chan struct{}
+
+	// resourceConcurrencySemaphoresMutex is a mutex to protect concurrent
+	// resourceConcurrencySemaphores access from race conditions.
+	resourceConcurrencySemaphoresMutex sync.Mutex
+
+	// resourceIdentitySchemas is the cached Resource Identity Schemas for RPCs
+	// that need to convert resource identity data from the protocol. If not
+	// found, it will be fetched from the ResourceWithIdentity.IdentitySchema()
+	// method.
+	resourceIdentitySchemas map[string]fwschema.Schema
+
+	// resourceIdentitySchemasDiags is the cached Diagnostics obtained while validating
+	// the implementation of each resourceIdentitySchemas. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching resourceIdentitySchemas.
+	resourceIdentitySchemasDiags map[string]diag.Diagnostics
+
+	// resourceIdentitySchemasMutex is a mutex to protect concurrent
+	// resourceIdentitySchemas access from race conditions.
+	resourceIdentitySchemasMutex sync.RWMutex
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
 	resourceSchemas map[string]fwschema.Schema
 
+	// resourceSchemasDiags is the cached Diagnostics obtained while validating
+	// the implementation of each resourceSchemas. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching resourceSchemas.
+	resourceSchemasDiags map[string]diag.Diagnostics
+
 	// resourceSchemasMutex is a mutex to protect concurrent resourceSchemas
 	// access from race conditions.
 	resourceSchemasMutex sync.RWMutex
@@ -126,7 +299,9 @@ func (s *Server) DataSource(ctx context.Context, typeName string) (datasource.Da
 		return nil, diags
 	}
 
-	return dataSourceFunc(), diags
+	dataSource, _ := callProviderFunc(ctx, &diags, s.Provider, "DataSources", typeName, dataSourceFunc)
+
+	return dataSource, diags
 }
 
 // DataSourceFuncs returns a map of DataSource functions. The results are cached
@@ -142,19 +317,31 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 
 	s.dataSourceFuncs = make(map[string]func() datasource.DataSource)
 
+	var dataSourceFuncsSlice []func() datasource.DataSource
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider DataSources")
-	dataSourceFuncsSlice := s.Provider.DataSources(ctx)
+	fwpanic.Call(ctx, &s.dataSourceTypesDiags, fwpanic.Method{Implementation: s.Provider, Name: "DataSources"}, func() {
+		dataSourceFuncsSlice = s.Provider.DataSources(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider DataSources")
 
 	for _, dataSourceFunc := range dataSourceFuncsSlice {
-		dataSource := dataSourceFunc()
+		dataSource, ok := callProviderFunc(ctx, &s.dataSourceTypesDiags, s.Provider, "DataSources", "", dataSourceFunc)
+
+		if !ok {
+			continue
+		}
 
 		dataSourceTypeNameReq := datasource.MetadataRequest{
-			ProviderTypeName: s.providerTypeName,
+			ProviderTypeName: s.ProviderTypeName(ctx),
 		}
 		dataSourceTypeNameResp := datasource.MetadataResponse{}
 
-		dataSource.Metadata(ctx, dataSourceTypeNameReq, &dataSourceTypeNameResp)
+		if fwpanic.Call(ctx, &s.dataSourceTypesDiags, fwpanic.Method{Implementation: dataSource, Name: "Metadata"}, func() {
+			dataSource.Metadata(ctx, dataSourceTypeNameReq, &dataSourceTypeNameResp)
+		}) {
+			continue
+		}
 
 		if dataSourceTypeNameResp.TypeName == "" {
 			s.dataSourceTypesDiags.AddError(
@@ -184,14 +371,16 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 }
 
 // DataSourceSchema returns the DataSource Schema for the given type name and
-// caches the result for later DataSource operations.
+// caches the result for later DataSource operations. The schema
+// implementation is also validated.
 func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
 	s.dataSourceSchemasMutex.RLock()
 	dataSourceSchema, ok := s.dataSourceSchemas[typeName]
+	dataSourceSchemaDiags := s.dataSourceSchemasDiags[typeName]
 	s.dataSourceSchemasMutex.RUnlock()
 
 	if ok {
-		return dataSourceSchema, nil
+		return dataSourceSchema, dataSourceSchemaDiags
 	}
 
 	var diags diag.Diagnostics
@@ -208,7 +397,9 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 	schemaResp := datasource.SchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSource Schema method", map[string]interface{}{logging.KeyDataSourceType: typeName})
-	dataSource.Schema(ctx, schemaReq, &schemaResp)
+	fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: dataSource, Name: "Schema", TypeName: typeName}, func() {
+		dataSource.Schema(ctx, schemaReq, &schemaResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined DataSource Schema method", map[string]interface{}{logging.KeyDataSourceType: typeName})
 
 	diags.Append(schemaResp.Diagnostics...)
@@ -217,13 +408,22 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 		return schemaResp.Schema, diags
 	}
 
+	validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+	diags.Append(validateDiags...)
+
 	s.dataSourceSchemasMutex.Lock()
 
 	if s.dataSourceSchemas == nil {
 		s.dataSourceSchemas = make(map[string]fwschema.Schema)
 	}
 
+	if s.dataSourceSchemasDiags == nil {
+		s.dataSourceSchemasDiags = make(map[string]diag.Diagnostics)
+	}
+
 	s.dataSourceSchemas[typeName] = schemaResp.Schema
+	s.dataSourceSchemasDiags[typeName] = validateDiags
 
 	s.dataSourceSchemasMutex.Unlock()
 
@@ -240,13 +440,19 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 	dataSourceFuncs, diags := s.DataSourceFuncs(ctx)
 
 	for typeName, dataSourceFunc := range dataSourceFuncs {
-		dataSource := dataSourceFunc()
+		dataSource, ok := callProviderFunc(ctx, &diags, s.Provider, "DataSources", typeName, dataSourceFunc)
+
+		if !ok {
+			continue
+		}
 
 		schemaReq := datasource.SchemaRequest{}
 		schemaResp := datasource.SchemaResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Schema", map[string]interface{}{logging.KeyDataSourceType: typeName})
-		dataSource.Schema(ctx, schemaReq, &schemaResp)
+		fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: dataSource, Name: "Schema", TypeName: typeName}, func() {
+			dataSource.Schema(ctx, schemaReq, &schemaResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Schema", map[string]interface{}{logging.KeyDataSourceType: typeName})
 
 		diags.Append(schemaResp.Diagnostics...)
@@ -269,6 +475,660 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 	return dataSourceSchemas, diags
 }
 
+// EphemeralResource returns the EphemeralResource for a given type name.
+func (s *Server) EphemeralResource(ctx context.Context, typeName string) (ephemeral.EphemeralResource, diag.Diagnostics) {
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	ephemeralResourceFunc, ok := ephemeralResourceFuncs[typeName]
+
+	if !ok {
+		diags.AddError(
+			"Ephemeral Resource Type Not Found",
+			fmt.Sprintf("No ephemeral resource type named %q was found in the provider.", typeName),
+		)
+
+		return nil, diags
+	}
+
+	ephemeralResource, _ := callProviderFunc(ctx, &diags, s.Provider, "EphemeralResources", typeName, ephemeralResourceFunc)
+
+	return ephemeralResource, diags
+}
+
+// EphemeralResourceFuncs returns a map of EphemeralResource functions. The
+// results are cached on first use.
+func (s *Server) EphemeralResourceFuncs(ctx context.Context) (map[string]func() ephemeral.EphemeralResource, diag.Diagnostics) {
+	providerWithEphemeralResources, ok := s.Provider.(provider.ProviderWithEphemeralResources)
+
+	if !ok {
+		// Only ephemeral resource specific RPCs should return diagnostics about
+		// the provider not implementing ephemeral resources or missing
+		// ephemeral resources.
+		return nil, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithEphemeralResources")
+	logging.FrameworkTrace(ctx, "Checking EphemeralResourceTypes lock")
+	s.ephemeralResourceTypesMutex.Lock()
+	defer s.ephemeralResourceTypesMutex.Unlock()
+
+	if s.ephemeralResourceFuncs != nil {
+		return s.ephemeralResourceFuncs, s.ephemeralResourceTypesDiags
+	}
+
+	s.ephemeralResourceFuncs = make(map[string]func() ephemeral.EphemeralResource)
+
+	var ephemeralResourceFuncsSlice []func() ephemeral.EphemeralResource
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider EphemeralResources")
+	fwpanic.Call(ctx, &s.ephemeralResourceTypesDiags, fwpanic.Method{Implementation: providerWithEphemeralResources, Name: "EphemeralResources"}, func() {
+		ephemeralResourceFuncsSlice = providerWithEphemeralResources.EphemeralResources(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Provider EphemeralResources")
+
+	for _, ephemeralResourceFunc := range ephemeralResourceFuncsSlice {
+		ephemeralResource, ok := callProviderFunc(ctx, &s.ephemeralResourceTypesDiags, s.Provider, "EphemeralResources", "", ephemeralResourceFunc)
+
+		if !ok {
+			continue
+		}
+
+		ephemeralResourceTypeNameReq := ephemeral.MetadataRequest{
+			ProviderTypeName: s.ProviderTypeName(ctx),
+		}
+		ephemeralResourceTypeNameResp := ephemeral.MetadataResponse{}
+
+		if fwpanic.Call(ctx, &s.ephemeralResourceTypesDiags, fwpanic.Method{Implementation: ephemeralResource, Name: "Metadata"}, func() {
+			ephemeralResource.Metadata(ctx, ephemeralResourceTypeNameReq, &ephemeralResourceTypeNameResp)
+		}) {
+			continue
+		}
+
+		if ephemeralResourceTypeNameResp.TypeName == "" {
+			s.ephemeralResourceTypesDiags.AddError(
+				"Ephemeral Resource Type Name Missing",
+				fmt.Sprintf("The %T EphemeralResource returned an empty string from the Metadata method. ", ephemeralResource)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found ephemeral resource type", map[string]interface{}{logging.KeyEphemeralResourceType: ephemeralResourceTypeNameResp.TypeName})
+
+		if _, ok := s.ephemeralResourceFuncs[ephemeralResourceTypeNameResp.TypeName]; ok {
+			s.ephemeralResourceTypesDiags.AddError(
+				"Duplicate Ephemeral Resource Type Defined",
+				fmt.Sprintf("The %s ephemeral resource type name was returned for multiple ephemeral resources. ", ephemeralResourceTypeNameResp.TypeName)+
+					"Ephemeral resource type names must be unique. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		s.ephemeralResourceFuncs[ephemeralResourceTypeNameResp.TypeName] = ephemeralResourceFunc
+	}
+
+	return s.ephemeralResourceFuncs, s.ephemeralResourceTypesDiags
+}
+
+// EphemeralResourceSchema returns the EphemeralResource Schema for the given
+// type name and caches the result for later EphemeralResource operations.
+// The schema implementation is also validated.
+func (s *Server) EphemeralResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.ephemeralResourceSchemasMutex.RLock()
+	ephemeralResourceSchema, ok := s.ephemeralResourceSchemas[typeName]
+	ephemeralResourceSchemaDiags := s.ephemeralResourceSchemasDiags[typeName]
+	s.ephemeralResourceSchemasMutex.RUnlock()
+
+	if ok {
+		return ephemeralResourceSchema, ephemeralResourceSchemaDiags
+	}
+
+	var diags diag.Diagnostics
+
+	ephemeralResource, ephemeralResourceDiags := s.EphemeralResource(ctx, typeName)
+
+	diags.Append(ephemeralResourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaReq := ephemeral.SchemaRequest{}
+	schemaResp := ephemeral.SchemaResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Schema method", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+	fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResource, Name: "Schema", TypeName: typeName}, func() {
+		ephemeralResource.Schema(ctx, schemaReq, &schemaResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Schema method", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return schemaResp.Schema, diags
+	}
+
+	validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+	diags.Append(validateDiags...)
+
+	s.ephemeralResourceSchemasMutex.Lock()
+
+	if s.ephemeralResourceSchemas == nil {
+		s.ephemeralResourceSchemas = make(map[string]fwschema.Schema)
+	}
+
+	if s.ephemeralResourceSchemasDiags == nil {
+		s.ephemeralResourceSchemasDiags = make(map[string]diag.Diagnostics)
+	}
+
+	s.ephemeralResourceSchemas[typeName] = schemaResp.Schema
+	s.ephemeralResourceSchemasDiags[typeName] = validateDiags
+
+	s.ephemeralResourceSchemasMutex.Unlock()
+
+	return schemaResp.Schema, diags
+}
+
+// EphemeralResourceSchemas returns a map of EphemeralResource Schemas for the
+// GetProviderSchema RPC without caching since not all schemas are guaranteed to
+// be necessary for later provider operations. The schema implementations are
+// also validated.
+func (s *Server) EphemeralResourceSchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
+	ephemeralResourceSchemas := make(map[string]fwschema.Schema)
+
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	for typeName, ephemeralResourceFunc := range ephemeralResourceFuncs {
+		ephemeralResource, ok := callProviderFunc(ctx, &diags, s.Provider, "EphemeralResources", typeName, ephemeralResourceFunc)
+
+		if !ok {
+			continue
+		}
+
+		schemaReq := ephemeral.SchemaRequest{}
+		schemaResp := ephemeral.SchemaResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Schema", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+		fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResource, Name: "Schema", TypeName: typeName}, func() {
+			ephemeralResource.Schema(ctx, schemaReq, &schemaResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Schema", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+
+		diags.Append(schemaResp.Diagnostics...)
+
+		if schemaResp.Diagnostics.HasError() {
+			continue
+		}
+
+		validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+		diags.Append(validateDiags...)
+
+		if validateDiags.HasError() {
+			continue
+		}
+
+		ephemeralResourceSchemas[typeName] = schemaResp.Schema
+	}
+
+	return ephemeralResourceSchemas, diags
+}
+
+// Function returns the Function for a given name.
+func (s *Server) Function(ctx context.Context, name string) (function.Function, diag.Diagnostics) {
+	functionFuncs, diags := s.FunctionFuncs(ctx)
+
+	functionFunc, ok := functionFuncs[name]
+
+	if !ok {
+		diags.AddError(
+			"Function Not Found",
+			fmt.Sprintf("No function named %q was found in the provider.", name),
+		)
+
+		return nil, diags
+	}
+
+	functionImpl, _ := callProviderFunc(ctx, &diags, s.Provider, "Functions", name, functionFunc)
+
+	return functionImpl, diags
+}
+
+// FunctionDefinition returns the Function Definition for the given name and
+// caches the result for later Function operations.
+func (s *Server) FunctionDefinition(ctx context.Context, name string) (function.Definition, diag.Diagnostics) {
+	s.functionDefinitionsMutex.RLock()
+	functionDefinition, ok := s.functionDefinitions[name]
+	s.functionDefinitionsMutex.RUnlock()
+
+	if ok {
+		return functionDefinition, nil
+	}
+
+	var diags diag.Diagnostics
+
+	functionImpl, functionDiags := s.Function(ctx, name)
+
+	diags.Append(functionDiags...)
+
+	if diags.HasError() {
+		return function.Definition{}, diags
+	}
+
+	definitionReq := function.DefinitionRequest{}
+	definitionResp := function.DefinitionResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Function Definition method", map[string]interface{}{logging.KeyFunctionName: name})
+	fwpanic.Call(ctx, &definitionResp.Diagnostics, fwpanic.Method{Implementation: functionImpl, Name: "Definition", TypeName: name}, func() {
+		functionImpl.Definition(ctx, definitionReq, &definitionResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Function Definition method", map[string]interface{}{logging.KeyFunctionName: name})
+
+	diags.Append(definitionResp.Diagnostics...)
+
+	if diags.HasError() {
+		return definitionResp.Definition, diags
+	}
+
+	s.functionDefinitionsMutex.Lock()
+
+	if s.functionDefinitions == nil {
+		s.functionDefinitions = make(map[string]function.Definition)
+	}
+
+	s.functionDefinitions[name] = definitionResp.Definition
+
+	s.functionDefinitionsMutex.Unlock()
+
+	return definitionResp.Definition, diags
+}
+
+// FunctionDefinitions returns a map of Function Definitions for the
+// GetProviderSchema and GetFunctions RPCs without caching since not all
+// definitions are guaranteed to be necessary for later provider operations.
+// The definition implementations are also validated.
+func (s *Server) FunctionDefinitions(ctx context.Context) (map[string]function.Definition, diag.Diagnostics) {
+	functionDefinitions := make(map[string]function.Definition)
+
+	functionFuncs, diags := s.FunctionFuncs(ctx)
+
+	for name, functionFunc := range functionFuncs {
+		functionImpl, ok := callProviderFunc(ctx, &diags, s.Provider, "Functions", name, functionFunc)
+
+		if !ok {
+			continue
+		}
+
+		definitionReq := function.DefinitionRequest{}
+		definitionResp := function.DefinitionResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Function Definition method", map[string]interface{}{logging.KeyFunctionName: name})
+		fwpanic.Call(ctx, &definitionResp.Diagnostics, fwpanic.Method{Implementation: functionImpl, Name: "Definition", TypeName: name}, func() {
+			functionImpl.Definition(ctx, definitionReq, &definitionResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Function Definition method", map[string]interface{}{logging.KeyFunctionName: name})
+
+		diags.Append(definitionResp.Diagnostics...)
+
+		if definitionResp.Diagnostics.HasError() {
+			continue
+		}
+
+		validateReq := function.DefinitionValidateRequest{
+			FuncName: name,
+		}
+		validateResp := function.DefinitionValidateResponse{}
+
+		definitionResp.Definition.ValidateImplementation(ctx, validateReq, &validateResp)
+
+		diags.Append(validateResp.Diagnostics...)
+
+		if validateResp.Diagnostics.HasError() {
+			continue
+		}
+
+		functionDefinitions[name] = definitionResp.Definition
+	}
+
+	return functionDefinitions, diags
+}
+
+// FunctionFuncs returns a map of Function functions. The results are cached
+// on first use.
+func (s *Server) FunctionFuncs(ctx context.Context) (map[string]func() function.Function, diag.Diagnostics) {
+	providerWithFunctions, ok := s.Provider.(provider.ProviderWithFunctions)
+
+	if !ok {
+		// Only function-specific RPCs should return diagnostics about the
+		// provider not implementing functions or missing functions.
+		return nil, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithFunctions")
+	logging.FrameworkTrace(ctx, "Checking FunctionFuncs lock")
+	s.functionFuncsMutex.Lock()
+	defer s.functionFuncsMutex.Unlock()
+
+	if s.functionFuncs != nil {
+		return s.functionFuncs, s.functionFuncsDiags
+	}
+
+	s.functionFuncs = make(map[string]func() function.Function)
+
+	var functionFuncsSlice []func() function.Function
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Functions")
+	fwpanic.Call(ctx, &s.functionFuncsDiags, fwpanic.Method{Implementation: providerWithFunctions, Name: "Functions"}, func() {
+		functionFuncsSlice = providerWithFunctions.Functions(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Functions")
+
+	for _, functionFunc := range functionFuncsSlice {
+		functionImpl, ok := callProviderFunc(ctx, &s.functionFuncsDiags, s.Provider, "Functions", "", functionFunc)
+
+		if !ok {
+			continue
+		}
+
+		metadataReq := function.MetadataRequest{}
+		metadataResp := function.MetadataResponse{}
+
+		if fwpanic.Call(ctx, &s.functionFuncsDiags, fwpanic.Method{Implementation: functionImpl, Name: "Metadata"}, func() {
+			functionImpl.Metadata(ctx, metadataReq, &metadataResp)
+		}) {
+			continue
+		}
+
+		if metadataResp.Name == "" {
+			s.functionFuncsDiags.AddError(
+				"Function Name Missing",
+				fmt.Sprintf("The %T Function returned an empty string from the Metadata method. ", functionImpl)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found function", map[string]interface{}{logging.KeyFunctionName: metadataResp.Name})
+
+		if _, ok := s.functionFuncs[metadataResp.Name]; ok {
+			s.functionFuncsDiags.AddError(
+				"Duplicate Function Name Defined",
+				fmt.Sprintf("The %s function name was returned for multiple functions. ", metadataResp.Name)+
+					"Function names must be unique. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		s.functionFuncs[metadataResp.Name] = functionFunc
+	}
+
+	return s.functionFuncs, s.functionFuncsDiags
+}
+
+// ListResourceType returns the ListResource for a given type name. This is
+// named separately from the ListResource RPC method.
+func (s *Server) ListResourceType(ctx context.Context, typeName string) (list.ListResource, diag.Diagnostics) {
+	listResourceFuncs, diags := s.ListResourceFuncs(ctx)
+
+	listResourceFunc, ok := listResourceFuncs[typeName]
+
+	if !ok {
+		diags.AddError(
+			"List Resource Type Not Found",
+			fmt.Sprintf("No list resource type named %q was found in the provider.", typeName),
+		)
+
+		return nil, diags
+	}
+
+	listResource, _ := callProviderFunc(ctx, &diags, s.Provider, "ListResources", typeName, listResourceFunc)
+
+	return listResource, diags
+}
+
+// ListResourceFuncs returns a map of ListResource functions. The results are
+// cached on first use.
+func (s *Server) ListResourceFuncs(ctx context.Context) (map[string]func() list.ListResource, diag.Diagnostics) {
+	providerWithListResources, ok := s.Provider.(provider.ProviderWithListResources)
+
+	if !ok {
+		// Only list resource specific RPCs should return diagnostics about
+		// the provider not implementing list resources or missing list
+		// resources.
+		return nil, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithListResources")
+	logging.FrameworkTrace(ctx, "Checking ListResourceTypes lock")
+	s.listResourceTypesMutex.Lock()
+	defer s.listResourceTypesMutex.Unlock()
+
+	if s.listResourceFuncs != nil {
+		return s.listResourceFuncs, s.listResourceTypesDiags
+	}
+
+	s.listResourceFuncs = make(map[string]func() list.ListResource)
+
+	// Diagnostics from fetching managed resources are returned by the
+	// resource specific RPCs, so they are not duplicated here.
+	resourceFuncs, _ := s.ResourceFuncs(ctx)
+
+	var listResourceFuncsSlice []func() list.ListResource
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider ListResources")
+	fwpanic.Call(ctx, &s.listResourceTypesDiags, fwpanic.Method{Implementation: providerWithListResources, Name: "ListResources"}, func() {
+		listResourceFuncsSlice = providerWithListResources.ListResources(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Provider ListResources")
+
+	for _, listResourceFunc := range listResourceFuncsSlice {
+		listResource, ok := callProviderFunc(ctx, &s.listResourceTypesDiags, s.Provider, "ListResources", "", listResourceFunc)
+
+		if !ok {
+			continue
+		}
+
+		listResourceTypeNameReq := resource.MetadataRequest{
+			ProviderTypeName: s.ProviderTypeName(ctx),
+		}
+		listResourceTypeNameResp := resource.MetadataResponse{}
+
+		if fwpanic.Call(ctx, &s.listResourceTypesDiags, fwpanic.Method{Implementation: listResource, Name: "Metadata"}, func() {
+			listResource.Metadata(ctx, listResourceTypeNameReq, &listResourceTypeNameResp)
+		}) {
+			continue
+		}
+
+		if listResourceTypeNameResp.TypeName == "" {
+			s.listResourceTypesDiags.AddError(
+				"List Resource Type Name Missing",
+				fmt.Sprintf("The %T ListResource returned an empty string from the Metadata method. ", listResource)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found list resource type", map[string]interface{}{logging.KeyListResourceType: listResourceTypeNameResp.TypeName})
+
+		if _, ok := s.listResourceFuncs[listResourceTypeNameResp.TypeName]; ok {
+			s.listResourceTypesDiags.AddError(
+				"Duplicate List Resource Type Defined",
+				fmt.Sprintf("The %s list resource type name was returned for multiple list resources. ", listResourceTypeNameResp.TypeName)+
+					"List resource type names must be unique. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		if _, ok := resourceFuncs[listResourceTypeNameResp.TypeName]; !ok {
+			s.listResourceTypesDiags.AddError(
+				"Missing Resource Type for List Resource",
+				fmt.Sprintf("The %s list resource type name does not match any managed resource type in the provider. ", listResourceTypeNameResp.TypeName)+
+					"List resources must use the type name of an existing managed resource. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		s.listResourceFuncs[listResourceTypeNameResp.TypeName] = listResourceFunc
+	}
+
+	return s.listResourceFuncs, s.listResourceTypesDiags
+}
+
+// ListResourceSchema returns the ListResource config Schema for the given
+// type name and caches the result for later ListResource operations. The
+// schema implementation is also validated.
+func (s *Server) ListResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.listResourceSchemasMutex.RLock()
+	listResourceSchema, ok := s.listResourceSchemas[typeName]
+	listResourceSchemaDiags := s.listResourceSchemasDiags[typeName]
+	s.listResourceSchemasMutex.RUnlock()
+
+	if ok {
+		return listResourceSchema, listResourceSchemaDiags
+	}
+
+	var diags diag.Diagnostics
+
+	listResource, listResourceDiags := s.ListResourceType(ctx, typeName)
+
+	diags.Append(listResourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaReq := list.ListResourceSchemaRequest{}
+	schemaResp := list.ListResourceSchemaResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined ListResource ListResourceConfigSchema method", map[string]interface{}{logging.KeyListResourceType: typeName})
+	fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: listResource, Name: "ListResourceConfigSchema", TypeName: typeName}, func() {
+		listResource.ListResourceConfigSchema(ctx, schemaReq, &schemaResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined ListResource ListResourceConfigSchema method", map[string]interface{}{logging.KeyListResourceType: typeName})
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return schemaResp.Schema, diags
+	}
+
+	validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+	diags.Append(validateDiags...)
+
+	s.listResourceSchemasMutex.Lock()
+
+	if s.listResourceSchemas == nil {
+		s.listResourceSchemas = make(map[string]fwschema.Schema)
+	}
+
+	if s.listResourceSchemasDiags == nil {
+		s.listResourceSchemasDiags = make(map[string]diag.Diagnostics)
+	}
+
+	s.listResourceSchemas[typeName] = schemaResp.Schema
+	s.listResourceSchemasDiags[typeName] = validateDiags
+
+	s.listResourceSchemasMutex.Unlock()
+
+	return schemaResp.Schema, diags
+}
+
+// ListResourceSchemas returns a map of ListResource config Schemas for the
+// GetProviderSchema RPC without caching since not all schemas are guaranteed
+// to be necessary for later provider operations. The schema implementations
+// are also validated.
+func (s *Server) ListResourceSchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
+	listResourceSchemas := make(map[string]fwschema.Schema)
+
+	listResourceFuncs, diags := s.ListResourceFuncs(ctx)
+
+	for typeName, listResourceFunc := range listResourceFuncs {
+		listResource, ok := callProviderFunc(ctx, &diags, s.Provider, "ListResources", typeName, listResourceFunc)
+
+		if !ok {
+			continue
+		}
+
+		schemaReq := list.ListResourceSchemaRequest{}
+		schemaResp := list.ListResourceSchemaResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined ListResource ListResourceConfigSchema", map[string]interface{}{logging.KeyListResourceType: typeName})
+		fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: listResource, Name: "ListResourceConfigSchema", TypeName: typeName}, func() {
+			listResource.ListResourceConfigSchema(ctx, schemaReq, &schemaResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined ListResource ListResourceConfigSchema", map[string]interface{}{logging.KeyListResourceType: typeName})
+
+		diags.Append(schemaResp.Diagnostics...)
+
+		if schemaResp.Diagnostics.HasError() {
+			continue
+		}
+
+		validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+		diags.Append(validateDiags...)
+
+		if validateDiags.HasError() {
+			continue
+		}
+
+		listResourceSchemas[typeName] = schemaResp.Schema
+	}
+
+	return listResourceSchemas, diags
+}
+
+// MutexKV returns the keyed mutex of the provider server, which the protocol
+// specific implementations carry through the request context, so provider
+// defined methods can serialize operations on shared remote objects. The
+// MutexKV is created on first use.
+func (s *Server) MutexKV() *mutexkv.MutexKV {
+	s.mutexKVMutex.Lock()
+	defer s.mutexKVMutex.Unlock()
+
+	if s.mutexKV == nil {
+		s.mutexKV = mutexkv.New()
+	}
+
+	return s.mutexKV
+}
+
+// ProviderTypeName returns the TypeName associated with the Provider. The
+// TypeName is cached on first use, so it is available to type specific RPCs
+// even when Terraform does not call the GetProviderSchema RPC first.
+func (s *Server) ProviderTypeName(ctx context.Context) string {
+	logging.FrameworkTrace(ctx, "Checking ProviderTypeName lock")
+	s.providerTypeNameMutex.Lock()
+	defer s.providerTypeNameMutex.Unlock()
+
+	if s.providerTypeName != "" {
+		return s.providerTypeName
+	}
+
+	metadataReq := provider.MetadataRequest{}
+	metadataResp := provider.MetadataResponse{}
+
+	// The response does not contain diagnostics, so a recovered panic is only
+	// logged and the type name remains empty.
+	var diags diag.Diagnostics
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
+	fwpanic.Call(ctx, &diags, fwpanic.Method{Implementation: s.Provider, Name: "Metadata"}, func() {
+		s.Provider.Metadata(ctx, metadataReq, &metadataResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
+
+	s.providerTypeName = metadataResp.TypeName
+
+	return s.providerTypeName
+}
+
 // ProviderSchema returns the Schema associated with the Provider. The Schema
 // and Diagnostics are cached on first use.
 func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diagnostics) {
@@ -284,7 +1144,9 @@ func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diag
 	schemaResp := provider.SchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Schema")
-	s.Provider.Schema(ctx, schemaReq, &schemaResp)
+	fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: s.Provider, Name: "Schema"}, func() {
+		s.Provider.Schema(ctx, schemaReq, &schemaResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Schema")
 
 	s.providerSchema = schemaResp.Schema
@@ -318,7 +1180,9 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (fwschema.Schema, diag.
 	resp := &provider.MetaSchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider MetaSchema")
-	providerWithMetaSchema.MetaSchema(ctx, req, resp)
+	fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: providerWithMetaSchema, Name: "MetaSchema"}, func() {
+		providerWithMetaSchema.MetaSchema(ctx, req, resp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider MetaSchema")
 
 	s.providerMetaSchema = resp.Schema
@@ -344,7 +1208,9 @@ func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resour
 		return nil, diags
 	}
 
-	return resourceFunc(), diags
+	res, _ := callProviderFunc(ctx, &diags, s.Provider, "Resources", typeName, resourceFunc)
+
+	return res, diags
 }
 
 // ResourceFuncs returns a map of Resource functions. The results are cached
@@ -360,19 +1226,31 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 
 	s.resourceFuncs = make(map[string]func() resource.Resource)
 
+	var resourceFuncsSlice []func() resource.Resource
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Resources")
-	resourceFuncsSlice := s.Provider.Resources(ctx)
+	fwpanic.Call(ctx, &s.resourceTypesDiags, fwpanic.Method{Implementation: s.Provider, Name: "Resources"}, func() {
+		resourceFuncsSlice = s.Provider.Resources(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Resources")
 
 	for _, resourceFunc := range resourceFuncsSlice {
-		res := resourceFunc()
+		res, ok := callProviderFunc(ctx, &s.resourceTypesDiags, s.Provider, "Resources", "", resourceFunc)
+
+		if !ok {
+			continue
+		}
 
 		resourceTypeNameReq := resource.MetadataRequest{
-			ProviderTypeName: s.providerTypeName,
+			ProviderTypeName: s.ProviderTypeName(ctx),
 		}
 		resourceTypeNameResp := resource.MetadataResponse{}
 
-		res.Metadata(ctx, resourceTypeNameReq, &resourceTypeNameResp)
+		if fwpanic.Call(ctx, &s.resourceTypesDiags, fwpanic.Method{Implementation: res, Name: "Metadata"}, func() {
+			res.Metadata(ctx, resourceTypeNameReq, &resourceTypeNameResp)
+		}) {
+			continue
+		}
 
 		if resourceTypeNameResp.TypeName == "" {
 			s.resourceTypesDiags.AddError(
@@ -401,15 +1279,136 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 	return s.resourceFuncs, s.resourceTypesDiags
 }
 
+// ResourceIdentitySchema returns the Resource Identity Schema for the given
+// type name and caches the result for later Resource operations. A nil schema
+// is returned if the Resource does not implement ResourceWithIdentity. The
+// schema implementation is also validated.
+func (s *Server) ResourceIdentitySchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.resourceIdentitySchemasMutex.RLock()
+	resourceIdentitySchema, ok := s.resourceIdentitySchemas[typeName]
+	resourceIdentitySchemaDiags := s.resourceIdentitySchemasDiags[typeName]
+	s.resourceIdentitySchemasMutex.RUnlock()
+
+	if ok {
+		return resourceIdentitySchema, resourceIdentitySchemaDiags
+	}
+
+	var diags diag.Diagnostics
+
+	r, resourceDiags := s.Resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceWithIdentity, ok := r.(resource.ResourceWithIdentity)
+
+	if !ok {
+		return nil, diags
+	}
+
+	identitySchemaReq := resource.IdentitySchemaRequest{}
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+	fwpanic.Call(ctx, &identitySchemaResp.Diagnostics, fwpanic.Method{Implementation: resourceWithIdentity, Name: "IdentitySchema", TypeName: typeName}, func() {
+		resourceWithIdentity.IdentitySchema(ctx, identitySchemaReq, &identitySchemaResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+
+	diags.Append(identitySchemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return identitySchemaResp.IdentitySchema, diags
+	}
+
+	validateDiags := identitySchemaResp.IdentitySchema.ValidateImplementation(ctx)
+
+	diags.Append(validateDiags...)
+
+	s.resourceIdentitySchemasMutex.Lock()
+
+	if s.resourceIdentitySchemas == nil {
+		s.resourceIdentitySchemas = make(map[string]fwschema.Schema)
+	}
+
+	if s.resourceIdentitySchemasDiags == nil {
+		s.resourceIdentitySchemasDiags = make(map[string]diag.Diagnostics)
+	}
+
+	s.resourceIdentitySchemas[typeName] = identitySchemaResp.IdentitySchema
+	s.resourceIdentitySchemasDiags[typeName] = validateDiags
+
+	s.resourceIdentitySchemasMutex.Unlock()
+
+	return identitySchemaResp.IdentitySchema, diags
+}
+
+// ResourceIdentitySchemas returns a map of Resource Identity Schemas for the
+// GetResourceIdentitySchemas RPC without caching since not all schemas are
+// guaranteed to be necessary for later provider operations. Resources which
+// do not implement ResourceWithIdentity are omitted. The schema
+// implementations are also validated.
+func (s *Server) ResourceIdentitySchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
+	resourceIdentitySchemas := make(map[string]fwschema.Schema)
+
+	resourceFuncs, diags := s.ResourceFuncs(ctx)
+
+	for typeName, resourceFunc := range resourceFuncs {
+		r, ok := callProviderFunc(ctx, &diags, s.Provider, "Resources", typeName, resourceFunc)
+
+		if !ok {
+			continue
+		}
+
+		resourceWithIdentity, ok := r.(resource.ResourceWithIdentity)
+
+		if !ok {
+			continue
+		}
+
+		identitySchemaReq := resource.IdentitySchemaRequest{}
+		identitySchemaResp := resource.IdentitySchemaResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+		fwpanic.Call(ctx, &identitySchemaResp.Diagnostics, fwpanic.Method{Implementation: resourceWithIdentity, Name: "IdentitySchema", TypeName: typeName}, func() {
+			resourceWithIdentity.IdentitySchema(ctx, identitySchemaReq, &identitySchemaResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+
+		diags.Append(identitySchemaResp.Diagnostics...)
+
+		if identitySchemaResp.Diagnostics.HasError() {
+			continue
+		}
+
+		validateDiags := identitySchemaResp.IdentitySchema.ValidateImplementation(ctx)
+
+		diags.Append(validateDiags...)
+
+		if validateDiags.HasError() {
+			continue
+		}
+
+		resourceIdentitySchemas[typeName] = identitySchemaResp.IdentitySchema
+	}
+
+	return resourceIdentitySchemas, diags
+}
+
 // ResourceSchema returns the Resource Schema for the given type name and
-// caches the result for later Resource operations.
+// caches the result for later Resource operations. The schema implementation
+// is also validated.
 func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
 	s.resourceSchemasMutex.RLock()
 	resourceSchema, ok := s.resourceSchemas[typeName]
+	resourceSchemaDiags := s.resourceSchemasDiags[typeName]
 	s.resourceSchemasMutex.RUnlock()
 
 	if ok {
-		return resourceSchema, nil
+		return resourceSchema, resourceSchemaDiags
 	}
 
 	var diags diag.Diagnostics
@@ -426,7 +1425,9 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 	schemaResp := resource.SchemaResponse{}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Schema method", map[string]interface{}{logging.KeyResourceType: typeName})
-	r.Schema(ctx, schemaReq, &schemaResp)
+	fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: r, Name: "Schema", TypeName: typeName}, func() {
+		r.Schema(ctx, schemaReq, &schemaResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Schema method", map[string]interface{}{logging.KeyResourceType: typeName})
 
 	diags.Append(schemaResp.Diagnostics...)
@@ -435,13 +1436,22 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 		return schemaResp.Schema, diags
 	}
 
+	validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+	diags.Append(validateDiags...)
+
 	s.resourceSchemasMutex.Lock()
 
 	if s.resourceSchemas == nil {
 		s.resourceSchemas = make(map[string]fwschema.Schema)
 	}
 
+	if s.resourceSchemasDiags == nil {
+		s.resourceSchemasDiags = make(map[string]diag.Diagnostics)
+	}
+
 	s.resourceSchemas[typeName] = schemaResp.Schema
+	s.resourceSchemasDiags[typeName] = validateDiags
 
 	s.resourceSchemasMutex.Unlock()
 
@@ -458,13 +1468,19 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 	resourceFuncs, diags := s.ResourceFuncs(ctx)
 
 	for typeName, resourceFunc := range resourceFuncs {
-		r := resourceFunc()
+		r, ok := callProviderFunc(ctx, &diags, s.Provider, "Resources", typeName, resourceFunc)
+
+		if !ok {
+			continue
+		}
 
 		schemaReq := resource.SchemaRequest{}
 		schemaResp := resource.SchemaResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Schema method", map[string]interface{}{logging.KeyResourceType: typeName})
-		r.Schema(ctx, schemaReq, &schemaResp)
+		fwpanic.Call(ctx, &schemaResp.Diagnostics, fwpanic.Method{Implementation: r, Name: "Schema", TypeName: typeName}, func() {
+			r.Schema(ctx, schemaReq, &schemaResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Schema method", map[string]interface{}{logging.KeyResourceType: typeName})
 
 		diags.Append(schemaResp.Diagnostics...)
@@ -486,3 +1502,17 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 
 	return resourceSchemas, diags
 }
+
+// callProviderFunc calls a function returned by the given provider defined
+// method, such as Resources, to create a new implementation. It returns false
+// if the function panicked, in which case an error diagnostic is appended to
+// diags.
+func callProviderFunc[T any](ctx context.Context, diags *diag.Diagnostics, p provider.Provider, methodName string, typeName string, f func() T) (T, bool) {
+	var impl T
+
+	recovered := fwpanic.Call(ctx, diags, fwpanic.Method{Implementation: p, Name: methodName, TypeName: typeName}, func() {
+		impl = f()
+	})
+
+	return impl, !recovered
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// CallFunctionRequest is the framework server request for the
+// CallFunction RPC.
+type CallFunctionRequest struct {
+	Arguments          function.ArgumentsData
+	Function           function.Function
+	FunctionDefinition function.Definition
+}
+
+// CallFunctionResponse is the framework server response for the
+// CallFunction RPC.
+type CallFunctionResponse struct {
+	Error  *function.FuncError
+	Result function.ResultData
+}
+
+// CallFunction implements the framework server CallFunction RPC.
+func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, resp *CallFunctionResponse) {
+	if req == nil {
+		return
+	}
+
+	if req.FunctionDefinition.Return == nil {
+		resp.Error = function.NewFuncError(
+			"Invalid Function Definition: When calling the function, the function definition did not contain a return. " +
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	resultData, funcErr := req.FunctionDefinition.Return.NewResultData(ctx)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+
+	if resp.Error != nil {
+		return
+	}
+
+	runReq := function.RunRequest{
+		Arguments: req.Arguments,
+	}
+	runResp := function.RunResponse{
+		Result: resultData,
+	}
+
+	// Function errors are not diagnostics, so a recovered panic is converted.
+	var runDiags diag.Diagnostics
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Function Run")
+	fwpanic.Call(ctx, &runDiags, fwpanic.Method{Implementation: req.Function, Name: "Run"}, func() {
+		req.Function.Run(ctx, runReq, &runResp)
+	})
+	logging.FrameworkTrace(ctx, "Called provider defined Function Run")
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, runResp.Error, function.FuncErrorFromDiags(ctx, runDiags))
+
+	resp.Result = runResp.Result
+}
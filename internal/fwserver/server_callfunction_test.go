@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestServerCallFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.CallFunctionRequest
+		expectedResponse *fwserver.CallFunctionResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{},
+		},
+		"request-arguments-get": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					basetypes.NewStringValue("arg0"),
+					basetypes.NewBoolValue(true),
+				}),
+				Function: &testprovider.Function{
+					RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+						var arg0 basetypes.StringValue
+						var arg1 basetypes.BoolValue
+
+						resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0, &arg1))
+
+						expected := "arg0"
+
+						if arg0.ValueString() != expected {
+							resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("unexpected argument 0 value"))
+						}
+
+						if !arg1.ValueBool() {
+							resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("unexpected argument 1 value"))
+						}
+
+						resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringValue("result")))
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Parameters: []function.Parameter{
+						function.StringParameter{},
+						function.BoolParameter{},
+					},
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Result: function.NewResultData(basetypes.NewStringValue("result")),
+			},
+		},
+		"request-arguments-get-variadic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					basetypes.NewStringValue("arg0"),
+					basetypes.NewListValueMust(
+						basetypes.StringType{},
+						[]attr.Value{
+							basetypes.NewStringValue("varg-arg1"),
+							basetypes.NewStringValue("varg-arg2"),
+						},
+					),
+				}),
+				Function: &testprovider.Function{
+					RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+						var arg0 basetypes.StringValue
+						var arg1 []string
+
+						resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0, &arg1))
+
+						if len(arg1) != 2 {
+							resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("unexpected variadic argument length"))
+						}
+
+						resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, arg0.ValueString()+"-"+arg1[0]+"-"+arg1[1]))
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Parameters: []function.Parameter{
+						function.StringParameter{},
+					},
+					VariadicParameter: function.StringParameter{},
+					Return:            function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Result: function.NewResultData(basetypes.NewStringValue("arg0-varg-arg1-varg-arg2")),
+			},
+		},
+		"response-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: &testprovider.Function{
+					RunMethod: func(_ context.Context, _ function.RunRequest, resp *function.RunResponse) {
+						resp.Error = function.NewArgumentFuncError(0, "test error")
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error:  function.NewArgumentFuncError(0, "test error"),
+				Result: function.NewResultData(basetypes.NewStringUnknown()),
+			},
+		},
+		"response-result": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: &testprovider.Function{
+					RunMethod: func(ctx context.Context, _ function.RunRequest, resp *function.RunResponse) {
+						resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "result"))
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Result: function.NewResultData(basetypes.NewStringValue("result")),
+			},
+		},
+		"response-result-missing-return": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments:          function.NewArgumentsData(nil),
+				Function:           &testprovider.Function{},
+				FunctionDefinition: function.Definition{},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error: function.NewFuncError(
+					"Invalid Function Definition: When calling the function, the function definition did not contain a return. " +
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.CallFunctionResponse{}
+			testCase.server.CallFunction(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,22 @@ package fwserver
 // the toproto5 conversion logic will handle the appropriate filtering and the
 // proto5server/fwserver logic will need to account for missing features.
 type ServerCapabilities struct {
+	// GetProviderSchemaOptional signals that the provider does not require
+	// the GetProviderSchema RPC before other RPCs, as schemas are loaded on
+	// demand for each type. Terraform can then use the GetMetadata RPC to
+	// discover type names without loading every schema.
+	//
+	// This should always be enabled in framework providers and requires
+	// Terraform 1.6 or later.
+	GetProviderSchemaOptional bool
+
+	// MoveResourceState signals that the provider is ready for the
+	// MoveResourceState RPC.
+	//
+	// This should always be enabled in framework providers and requires
+	// Terraform 1.8 or later.
+	MoveResourceState bool
+
 	// PlanDestroy signals that the provider is ready for the
 	// PlanResourceChange RPC on resource destruction.
 	//
@@ -15,3 +31,13 @@ type ServerCapabilities struct {
 	// Terraform 1.3 or later.
 	PlanDestroy bool
 }
+
+// ServerCapabilities returns the server capabilities supported by the
+// framework, which are the same for every provider.
+func (s *Server) ServerCapabilities() *ServerCapabilities {
+	return &ServerCapabilities{
+		GetProviderSchemaOptional: true,
+		MoveResourceState:         true,
+		PlanDestroy:               true,
+	}
+}
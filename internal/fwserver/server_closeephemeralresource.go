@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// CloseEphemeralResourceRequest is the framework server request for the
+// CloseEphemeralResource RPC.
+type CloseEphemeralResourceRequest struct {
+	Private           *privatestate.Data
+	EphemeralResource ephemeral.EphemeralResource
+}
+
+// CloseEphemeralResourceResponse is the framework server response for the
+// CloseEphemeralResource RPC.
+type CloseEphemeralResourceResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+// CloseEphemeralResource implements the framework server CloseEphemeralResource RPC.
+func (s *Server) CloseEphemeralResource(ctx context.Context, req *CloseEphemeralResourceRequest, resp *CloseEphemeralResourceResponse) {
+	if req == nil {
+		return
+	}
+
+	ephemeralResourceWithClose, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithClose)
+
+	if !ok {
+		// Close is optional, so there is nothing to clean up.
+		logging.FrameworkTrace(ctx, "EphemeralResource does not implement EphemeralResourceWithClose")
+
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithClose")
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithConfigure, Name: "Configure"}, func() {
+			ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	closeReq := ephemeral.CloseRequest{
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+	closeResp := ephemeral.CloseResponse{}
+
+	if req.Private != nil && req.Private.Provider != nil {
+		closeReq.Private = req.Private.Provider
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Close")
+	fwpanic.Call(ctx, &closeResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithClose, Name: "Close"}, func() {
+		ephemeralResourceWithClose.Close(ctx, closeReq, &closeResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Close")
+
+	resp.Diagnostics.Append(closeResp.Diagnostics...)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerCloseEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKey": "pValue"}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.CloseEphemeralResourceRequest
+		expectedResponse *fwserver.CloseEphemeralResourceResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.CloseEphemeralResourceResponse{},
+		},
+		"close-not-implemented": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CloseEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResource{},
+			},
+			expectedResponse: &fwserver.CloseEphemeralResourceResponse{},
+		},
+		"request-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CloseEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithClose{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					CloseMethod: func(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+						expected := `{"pKey": "pValue"}`
+
+						key := "providerKeyOne"
+
+						got, diags := req.Private.GetKey(ctx, key)
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+			expectedResponse: &fwserver.CloseEphemeralResourceResponse{},
+		},
+		"request-private-nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CloseEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithClose{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					CloseMethod: func(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+						got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if got != nil {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.CloseEphemeralResourceResponse{},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CloseEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithClose{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					CloseMethod: func(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CloseEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.CloseEphemeralResourceResponse{}
+			testCase.server.CloseEphemeralResource(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
@@ -14,14 +15,43 @@ import (
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
 
-	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+	if req == nil {
+		req = &provider.ConfigureRequest{}
 	}
 
+	recovered := fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: s.Provider, Name: "Configure"}, func() {
+		s.Provider.Configure(ctx, *req, resp)
+	})
+
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
 
+	// Any data or deferral set before the panic may be incomplete, so it is
+	// not saved for other RPCs.
+	if recovered {
+		return
+	}
+
+	if resp.Deferred != nil {
+		if !req.ClientCapabilities.DeferralAllowed {
+			resp.Diagnostics.AddError(
+				"Invalid Deferred Provider Response",
+				"Provider configured a deferred response for all resources and data sources but the Terraform request "+
+					"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+			)
+
+			return
+		}
+
+		logging.FrameworkDebug(ctx,
+			"Provider has configured a deferred response, all associated resources and data sources will automatically return a deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: resp.Deferred.Reason.String(),
+			},
+		)
+	}
+
 	s.DataSourceConfigureData = resp.DataSourceData
+	s.EphemeralResourceConfigureData = resp.EphemeralResourceData
 	s.ResourceConfigureData = resp.ResourceData
+	s.ProviderDeferred = resp.Deferred
 }
@@ -46,9 +46,10 @@ func TestServerConfigureProvider(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		server           *fwserver.Server
-		request          *provider.ConfigureRequest
-		expectedResponse *provider.ConfigureResponse
+		server                   *fwserver.Server
+		request                  *provider.ConfigureRequest
+		expectedResponse         *provider.ConfigureResponse
+		expectedProviderDeferred *provider.Deferred
 	}{
 		"empty-provider": {
 			server: &fwserver.Server{
@@ -56,6 +57,24 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Incorrect req.ClientCapabilities", "expected DeferralAllowed to be true")
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
 		"request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -112,6 +131,56 @@ func TestServerConfigureProvider(t *testing.T) {
 				DataSourceData: "test-provider-configure-value",
 			},
 		},
+		"response-deferred": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.Deferred = &provider.Deferred{
+							Reason: provider.DeferredReasonProviderConfigUnknown,
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Deferred: &provider.Deferred{
+					Reason: provider.DeferredReasonProviderConfigUnknown,
+				},
+			},
+			expectedProviderDeferred: &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			},
+		},
+		"response-deferred-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.Deferred = &provider.Deferred{
+							Reason: provider.DeferredReasonProviderConfigUnknown,
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				Deferred: &provider.Deferred{
+					Reason: provider.DeferredReasonProviderConfigUnknown,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Provider Response",
+						"Provider configured a deferred response for all resources and data sources but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -136,6 +205,41 @@ func TestServerConfigureProvider(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.Deferred = &provider.Deferred{
+							Reason: provider.DeferredReasonProviderConfigUnknown,
+						}
+
+						panic("test panic")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Deferred: &provider.Deferred{
+					Reason: provider.DeferredReasonProviderConfigUnknown,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.Provider Configure method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+			// Intentionally nil, the deferral is not saved after a panic.
+			expectedProviderDeferred: nil,
+		},
 		"response-resourcedata": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -172,6 +276,10 @@ func TestServerConfigureProvider(t *testing.T) {
 			if diff := cmp.Diff(testCase.server.ResourceConfigureData, testCase.expectedResponse.ResourceData); diff != "" {
 				t.Errorf("unexpected server.ResourceConfigureData difference: %s", diff)
 			}
+
+			if diff := cmp.Diff(testCase.server.ProviderDeferred, testCase.expectedProviderDeferred); diff != "" {
+				t.Errorf("unexpected server.ProviderDeferred difference: %s", diff)
+			}
 		})
 	}
 }
@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,20 @@ import (
 // with the ApplyResourceChange RPC.
 type CreateResourceRequest struct {
 	Config         *tfsdk.Config
+	IdentitySchema fwschema.Schema
 	PlannedPrivate *privatestate.Data
 	PlannedState   *tfsdk.Plan
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+	TypeName       string
 }
 
 // CreateResourceResponse is the framework server response for a create request
 // with the ApplyResourceChange RPC.
 type CreateResourceResponse struct {
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -52,7 +57,9 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -82,7 +89,8 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    nullSchemaData,
 		},
-		Private: privateProviderData,
+		Identity: resourceIdentity(ctx, nil, req.IdentitySchema),
+		Private:  privateProviderData,
 	}
 
 	if req.Config != nil {
@@ -97,12 +105,35 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	releaseConcurrency, diags := s.acquireResourceConcurrency(ctx, req.TypeName, req.Resource)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer releaseConcurrency()
+
+	// Track deadlines from the resource/timeouts package for diagnostics.
+	ctx, timeoutsTracker := fwtimeouts.ContextWithTracker(ctx)
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Create")
-	req.Resource.Create(ctx, createReq, &createResp)
+	fwpanic.Call(ctx, &createResp.Diagnostics, fwpanic.Method{Implementation: req.Resource, Name: "Create", TypeName: req.TypeName}, func() {
+		req.Resource.Create(ctx, createReq, &createResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Create")
 
 	resp.Diagnostics = createResp.Diagnostics
+
+	// Only explain errors, since a successful response after the deadline
+	// still means the remote object was changed and must be saved.
+	if createResp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(timeoutExceededDiagnostics(timeoutsTracker)...)
+	}
+
 	resp.NewState = &createResp.State
+	resp.NewIdentity = createResp.Identity
 
 	if !resp.Diagnostics.HasError() && createResp.State.Raw.Equal(nullSchemaData) {
 		detail := "The Terraform Provider unexpectedly returned no resource state after having no errors in the resource creation. " +
@@ -120,6 +151,29 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		)
 	}
 
+	if !resp.Diagnostics.HasError() && req.IdentitySchema != nil && resourceIdentityIsNull(resp.NewIdentity) {
+		resp.Diagnostics.AddError(
+			"Missing Resource Identity After Create",
+			"The Terraform Provider unexpectedly returned no resource identity after having no errors in the resource creation. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+	}
+
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedState, err := tftypes.Transform(resp.NewState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State",
+			"There was an unexpected error nullifying write-only attribute values in the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewState.Raw = nullifiedState
+
 	if createResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
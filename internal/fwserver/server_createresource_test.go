@@ -7,18 +7,23 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -130,6 +135,40 @@ func TestServerCreateResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testSchemaTypeWriteOnly := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required":   tftypes.String,
+			"test_write_only": tftypes.String,
+		},
+	}
+
+	testSchemaWriteOnly := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+			"test_write_only": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+
+	type testSchemaDataWriteOnly struct {
+		TestRequired  types.String `tfsdk:"test_required"`
+		TestWriteOnly types.String `tfsdk:"test_write_only"`
+	}
+
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentityType := testIdentitySchema.Type().TerraformType(context.Background())
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.CreateResourceRequest
@@ -181,6 +220,52 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"request-config-write-only": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeWriteOnly, map[string]tftypes.Value{
+						"test_required":   tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_write_only": tftypes.NewValue(tftypes.String, "test-write-only-value"),
+					}),
+					Schema: testSchemaWriteOnly,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeWriteOnly, map[string]tftypes.Value{
+						"test_required":   tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_write_only": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWriteOnly,
+				},
+				ResourceSchema: testSchemaWriteOnly,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaDataWriteOnly
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						if data.TestWriteOnly.ValueString() != "test-write-only-value" {
+							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestWriteOnly.ValueString())
+						}
+
+						// Write-only values set in state must be nullified
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeWriteOnly, map[string]tftypes.Value{
+						"test_required":   tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_write_only": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWriteOnly,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"request-plannedstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -352,6 +437,105 @@ func TestServerCreateResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-diagnostics-timeout-exceeded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						testTimeouts := timeouts.Value{
+							Object: types.ObjectValueMust(
+								map[string]attr.Type{
+									"create": types.StringType,
+								},
+								map[string]attr.Value{
+									"create": types.StringValue("1ns"),
+								},
+							),
+						}
+
+						ctx, cancel, diags := testTimeouts.CreateContext(ctx, 20*time.Minute)
+						defer cancel()
+
+						resp.Diagnostics.Append(diags...)
+
+						<-ctx.Done()
+
+						resp.Diagnostics.AddError("error summary", ctx.Err().Error())
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"context deadline exceeded",
+					),
+					diag.NewErrorDiagnostic(
+						"Resource Operation Timeout Exceeded",
+						"The resource create operation did not complete within the timeout of 1ns. "+
+							"The remote system may still be processing the operation. "+
+							"Increase the create timeout in the resource timeouts configuration if the operation needs more time.",
+					),
+				},
+				// Intentionally empty, Create implementation does not call resp.State.Set()
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-timeout-exceeded-success": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						testTimeouts := timeouts.Value{
+							Object: types.ObjectValueMust(
+								map[string]attr.Type{
+									"create": types.StringType,
+								},
+								map[string]attr.Value{
+									"create": types.StringValue("1ns"),
+								},
+							),
+						}
+
+						ctx, cancel, diags := testTimeouts.CreateContext(ctx, 20*time.Minute)
+						defer cancel()
+
+						resp.Diagnostics.Append(diags...)
+
+						<-ctx.Done()
+
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-diagnostics-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -400,6 +584,97 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newidentity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				IdentitySchema: testIdentitySchema,
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithIdentity{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							var data testSchemaData
+
+							resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+							resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("test_id"), types.StringValue("id-123"))...)
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "id-123"),
+					}),
+					Schema: testIdentitySchema,
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newidentity-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				IdentitySchema: testIdentitySchema,
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithIdentity{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							var data testSchemaData
+
+							resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+							// Intentionally missing resp.Identity.Set()
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource Identity After Create",
+						"The Terraform Provider unexpectedly returned no resource identity after having no errors in the resource creation. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+					),
+				},
+				NewIdentity: &tfsdk.ResourceIdentity{
+					Raw:    tftypes.NewValue(testIdentityType, nil),
+					Schema: testIdentitySchema,
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
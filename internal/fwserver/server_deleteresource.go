@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,11 +21,14 @@ import (
 // DeleteResourceRequest is the framework server request for a delete request
 // with the ApplyResourceChange RPC.
 type DeleteResourceRequest struct {
-	PlannedPrivate *privatestate.Data
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	IdentitySchema  fwschema.Schema
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PriorState      *tfsdk.State
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
+	TypeName        string
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -50,7 +55,9 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -65,6 +72,7 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
 		},
+		Identity: resourceIdentity(ctx, req.PlannedIdentity, req.IdentitySchema),
 	}
 	deleteResp := resource.DeleteResponse{
 		State: tfsdk.State{
@@ -86,10 +94,31 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		deleteReq.Private = req.PlannedPrivate.Provider
 	}
 
+	releaseConcurrency, diags := s.acquireResourceConcurrency(ctx, req.TypeName, req.Resource)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer releaseConcurrency()
+
+	// Track deadlines from the resource/timeouts package for diagnostics.
+	ctx, timeoutsTracker := fwtimeouts.ContextWithTracker(ctx)
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Delete")
-	req.Resource.Delete(ctx, deleteReq, &deleteResp)
+	fwpanic.Call(ctx, &deleteResp.Diagnostics, fwpanic.Method{Implementation: req.Resource, Name: "Delete", TypeName: req.TypeName}, func() {
+		req.Resource.Delete(ctx, deleteReq, &deleteResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Delete")
 
+	// Only explain errors, since a successful response after the deadline
+	// still means the remote object was removed.
+	if deleteResp.Diagnostics.HasError() {
+		deleteResp.Diagnostics.Append(timeoutExceededDiagnostics(timeoutsTracker)...)
+	}
+
 	if !deleteResp.Diagnostics.HasError() {
 		logging.FrameworkTrace(ctx, "No provider defined Delete errors detected, ensuring State is cleared")
 		deleteResp.State.RemoveResource(ctx)
@@ -8,10 +8,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -247,6 +250,104 @@ func TestServerDeleteResource(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-timeout-exceeded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.DeleteResourceRequest{
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					DeleteMethod: func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+						testTimeouts := timeouts.Value{
+							Object: types.ObjectValueMust(
+								map[string]attr.Type{
+									"delete": types.StringType,
+								},
+								map[string]attr.Value{
+									"delete": types.StringValue("1ns"),
+								},
+							),
+						}
+
+						ctx, cancel, diags := testTimeouts.DeleteContext(ctx, 20*time.Minute)
+						defer cancel()
+
+						resp.Diagnostics.Append(diags...)
+
+						<-ctx.Done()
+
+						resp.Diagnostics.AddError("error summary", ctx.Err().Error())
+					},
+				},
+			},
+			expectedResponse: &fwserver.DeleteResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"context deadline exceeded",
+					),
+					diag.NewErrorDiagnostic(
+						"Resource Operation Timeout Exceeded",
+						"The resource delete operation did not complete within the timeout of 1ns. "+
+							"The remote system may still be processing the operation. "+
+							"Increase the delete timeout in the resource timeouts configuration if the operation needs more time.",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"response-timeout-exceeded-success": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.DeleteResourceRequest{
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					DeleteMethod: func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+						testTimeouts := timeouts.Value{
+							Object: types.ObjectValueMust(
+								map[string]attr.Type{
+									"delete": types.StringType,
+								},
+								map[string]attr.Value{
+									"delete": types.StringValue("1ns"),
+								},
+							),
+						}
+
+						ctx, cancel, diags := testTimeouts.DeleteContext(ctx, 20*time.Minute)
+						defer cancel()
+
+						resp.Diagnostics.Append(diags...)
+
+						<-ctx.Done()
+					},
+				},
+			},
+			expectedResponse: &fwserver.DeleteResourceResponse{
+				NewState: testEmptyState,
+			},
+		},
 		"resource-configure-data": {
 			server: &fwserver.Server{
 				Provider:              &testprovider.Provider{},
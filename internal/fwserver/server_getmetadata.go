@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// GetMetadataRequest is the framework server request for the
+// GetMetadata RPC.
+type GetMetadataRequest struct{}
+
+// GetMetadataResponse is the framework server response for the
+// GetMetadata RPC.
+type GetMetadataResponse struct {
+	DataSources        []DataSourceMetadata
+	Diagnostics        diag.Diagnostics
+	EphemeralResources []EphemeralResourceMetadata
+	Functions          []FunctionMetadata
+	ListResources      []ListResourceMetadata
+	Resources          []ResourceMetadata
+	ServerCapabilities *ServerCapabilities
+}
+
+// DataSourceMetadata is the framework server equivalent of the
+// tfprotov5.DataSourceMetadata and tfprotov6.DataSourceMetadata types.
+type DataSourceMetadata struct {
+	// TypeName is the name of the data source.
+	TypeName string
+}
+
+// EphemeralResourceMetadata is the framework server equivalent of the
+// tfprotov5.EphemeralResourceMetadata and tfprotov6.EphemeralResourceMetadata
+// types.
+type EphemeralResourceMetadata struct {
+	// TypeName is the name of the ephemeral resource.
+	TypeName string
+}
+
+// FunctionMetadata is the framework server equivalent of the
+// tfprotov5.FunctionMetadata and tfprotov6.FunctionMetadata types.
+type FunctionMetadata struct {
+	// Name is the name of the function.
+	Name string
+}
+
+// ListResourceMetadata is the framework server equivalent of the
+// tfprotov5.ListResourceMetadata and tfprotov6.ListResourceMetadata types.
+type ListResourceMetadata struct {
+	// TypeName is the name of the list resource.
+	TypeName string
+}
+
+// ResourceMetadata is the framework server equivalent of the
+// tfprotov5.ResourceMetadata and tfprotov6.ResourceMetadata types.
+type ResourceMetadata struct {
+	// TypeName is the name of the managed resource.
+	TypeName string
+}
+
+// GetMetadata implements the framework server GetMetadata RPC.
+//
+// Only the type names are returned, which are determined by the Metadata
+// method of each type. No Schema or Definition methods are called, so
+// schemas are only loaded later for the types Terraform actually uses.
+func (s *Server) GetMetadata(ctx context.Context, req *GetMetadataRequest, resp *GetMetadataResponse) {
+	resp.DataSources = []DataSourceMetadata{}
+	resp.EphemeralResources = []EphemeralResourceMetadata{}
+	resp.Functions = []FunctionMetadata{}
+	resp.ListResources = []ListResourceMetadata{}
+	resp.Resources = []ResourceMetadata{}
+	resp.ServerCapabilities = s.ServerCapabilities()
+
+	dataSourceFuncs, diags := s.DataSourceFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	functionFuncs, diags := s.FunctionFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	listResourceFuncs, diags := s.ListResourceFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	resourceFuncs, diags := s.ResourceFuncs(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort names so responses are consistent across calls.
+	for _, typeName := range slices.Sorted(maps.Keys(dataSourceFuncs)) {
+		resp.DataSources = append(resp.DataSources, DataSourceMetadata{TypeName: typeName})
+	}
+
+	for _, typeName := range slices.Sorted(maps.Keys(ephemeralResourceFuncs)) {
+		resp.EphemeralResources = append(resp.EphemeralResources, EphemeralResourceMetadata{TypeName: typeName})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(functionFuncs)) {
+		resp.Functions = append(resp.Functions, FunctionMetadata{Name: name})
+	}
+
+	for _, typeName := range slices.Sorted(maps.Keys(listResourceFuncs)) {
+		resp.ListResources = append(resp.ListResources, ListResourceMetadata{TypeName: typeName})
+	}
+
+	for _, typeName := range slices.Sorted(maps.Keys(resourceFuncs)) {
+		resp.Resources = append(resp.Resources, ResourceMetadata{TypeName: typeName})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestServerGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.GetMetadataRequest
+		expectedResponse *fwserver.GetMetadataResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				ListResources:      []fwserver.ListResourceMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"datasources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source2"
+									},
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, _ *datasource.SchemaResponse) {
+										panic("unexpected DataSource Schema call")
+									},
+								}
+							},
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source1"
+									},
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, _ *datasource.SchemaResponse) {
+										panic("unexpected DataSource Schema call")
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources: []fwserver.DataSourceMetadata{
+					{
+						TypeName: "test_data_source1",
+					},
+					{
+						TypeName: "test_data_source2",
+					},
+				},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				ListResources:      []fwserver.ListResourceMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"datasources-duplicate-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+									},
+								}
+							},
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources: []fwserver.DataSourceMetadata{},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Data Source Type Defined",
+						"The test_data_source data source type name was returned for multiple data sources. "+
+							"Data source type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				ListResources:      []fwserver.ListResourceMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource"
+									},
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, _ *ephemeral.SchemaResponse) {
+										panic("unexpected EphemeralResource Schema call")
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources: []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{
+					{
+						TypeName: "test_ephemeral_resource",
+					},
+				},
+				Functions:     []fwserver.FunctionMetadata{},
+				ListResources: []fwserver.ListResourceMetadata{},
+				Resources:     []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"functions": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{
+					FunctionsMethod: func(_ context.Context) []func() function.Function {
+						return []func() function.Function{
+							func() function.Function {
+								return &testprovider.Function{
+									DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, _ *function.DefinitionResponse) {
+										panic("unexpected Function Definition call")
+									},
+									MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+										resp.Name = "function1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions: []fwserver.FunctionMetadata{
+					{
+						Name: "function1",
+					},
+				},
+				ListResources: []fwserver.ListResourceMetadata{},
+				Resources:     []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"listresources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithListResources{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+											panic("unexpected Resource Schema call")
+										},
+									}
+								},
+							}
+						},
+					},
+					ListResourcesMethod: func(_ context.Context) []func() list.ListResource {
+						return []func() list.ListResource{
+							func() list.ListResource {
+								return &testprovider.ListResource{
+									ListResourceConfigSchemaMethod: func(_ context.Context, _ list.ListResourceSchemaRequest, _ *list.ListResourceSchemaResponse) {
+										panic("unexpected ListResource ListResourceConfigSchema call")
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				ListResources: []fwserver.ListResourceMetadata{
+					{
+						TypeName: "test_resource",
+					},
+				},
+				Resources: []fwserver.ResourceMetadata{
+					{
+						TypeName: "test_resource",
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"resources-provider-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovider"
+					},
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_resource"
+									},
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+										panic("unexpected Resource Schema call")
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				ListResources:      []fwserver.ListResourceMetadata{},
+				Resources: []fwserver.ResourceMetadata{
+					{
+						TypeName: "testprovider_resource",
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.GetMetadataResponse{}
+			testCase.server.GetMetadata(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,9 +7,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
-	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
-	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // GetProviderSchemaRequest is the framework server request for the
@@ -19,28 +18,20 @@ type GetProviderSchemaRequest struct{}
 // GetProviderSchemaResponse is the framework server response for the
 // GetProviderSchema RPC.
 type GetProviderSchemaResponse struct {
-	ServerCapabilities *ServerCapabilities
-	Provider           fwschema.Schema
-	ProviderMeta       fwschema.Schema
-	ResourceSchemas    map[string]fwschema.Schema
-	DataSourceSchemas  map[string]fwschema.Schema
-	Diagnostics        diag.Diagnostics
+	ServerCapabilities       *ServerCapabilities
+	Provider                 fwschema.Schema
+	ProviderMeta             fwschema.Schema
+	ResourceSchemas          map[string]fwschema.Schema
+	DataSourceSchemas        map[string]fwschema.Schema
+	EphemeralResourceSchemas map[string]fwschema.Schema
+	ListResourceSchemas      map[string]fwschema.Schema
+	FunctionDefinitions      map[string]function.Definition
+	Diagnostics              diag.Diagnostics
 }
 
 // GetProviderSchema implements the framework server GetProviderSchema RPC.
 func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest, resp *GetProviderSchemaResponse) {
-	resp.ServerCapabilities = &ServerCapabilities{
-		PlanDestroy: true,
-	}
-
-	metadataReq := provider.MetadataRequest{}
-	metadataResp := provider.MetadataResponse{}
-
-	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
-	s.Provider.Metadata(ctx, metadataReq, &metadataResp)
-	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
-
-	s.providerTypeName = metadataResp.TypeName
+	resp.ServerCapabilities = s.ServerCapabilities()
 
 	providerSchema, diags := s.ProviderSchema(ctx)
 
@@ -81,4 +72,34 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 	}
 
 	resp.DataSourceSchemas = dataSourceSchemas
+
+	ephemeralResourceSchemas, diags := s.EphemeralResourceSchemas(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.EphemeralResourceSchemas = ephemeralResourceSchemas
+
+	listResourceSchemas, diags := s.ListResourceSchemas(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.ListResourceSchemas = listResourceSchemas
+
+	functionDefinitions, diags := s.FunctionDefinitions(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.FunctionDefinitions = functionDefinitions
 }
@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
@@ -35,11 +38,16 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider: &testprovider.Provider{},
 			},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
-				Provider:          providerschema.Schema{},
-				ResourceSchemas:   map[string]fwschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -102,10 +110,15 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
-				Provider:        providerschema.Schema{},
-				ResourceSchemas: map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -155,7 +168,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 				Diagnostics: diag.Diagnostics{
 					diag.NewErrorDiagnostic(
@@ -223,7 +238,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -256,7 +273,41 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"datasourceschemas-func-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								panic("test panic")
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.Provider DataSources method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -299,10 +350,281 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresourceschemas": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					Provider: &testprovider.Provider{},
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test1": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource1"
+									},
+								}
+							},
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test2": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{
+					"test_ephemeral_resource1": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test1": ephemeralschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					"test_ephemeral_resource2": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test2": ephemeralschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				ListResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions: map[string]function.Definition{},
+				Provider:            providerschema.Schema{},
+				ResourceSchemas:     map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresourceschemas-duplicate-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					Provider: &testprovider.Provider{},
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test1": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource"
+									},
+								}
+							},
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test2": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Ephemeral Resource Type Defined",
+						"The test_ephemeral_resource ephemeral resource type name was returned for multiple ephemeral resources. "+
+							"Ephemeral resource type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresourceschemas-empty-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					Provider: &testprovider.Provider{},
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = ""
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Ephemeral Resource Type Name Missing",
+						"The *testprovider.EphemeralResource EphemeralResource returned an empty string from the Metadata method. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"functiondefinitions": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{
+					FunctionsMethod: func(_ context.Context) []func() function.Function {
+						return []func() function.Function{
+							func() function.Function {
+								return &testprovider.Function{
+									DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+										resp.Definition = function.Definition{
+											Return: function.StringReturn{},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+										resp.Name = "function1"
+									},
+								}
+							},
+							func() function.Function {
+								return &testprovider.Function{
+									DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+										resp.Definition = function.Definition{
+											Return: function.StringReturn{},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+										resp.Name = "function2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions: map[string]function.Definition{
+					"function1": {
+						Return: function.StringReturn{},
+					},
+					"function2": {
+						Return: function.StringReturn{},
+					},
+				},
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"functiondefinitions-invalid-definition": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{
+					FunctionsMethod: func(_ context.Context) []func() function.Function {
+						return []func() function.Function{
+							func() function.Function {
+								return &testprovider.Function{
+									DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+										resp.Definition = function.Definition{}
+									},
+									MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+										resp.Name = "function1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Function Definition",
+						"When validating the function definition, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Function \"function1\" - Definition Return field is undefined",
+					),
+				},
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -322,7 +644,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
 				Provider: providerschema.Schema{
 					Attributes: map[string]providerschema.Attribute{
 						"test": providerschema.StringAttribute{
@@ -332,7 +657,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -353,7 +680,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 				Diagnostics: diag.Diagnostics{
 					diag.NewErrorDiagnostic(
@@ -383,8 +712,11 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
-				Provider:          providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ProviderMeta: metaschema.Schema{
 					Attributes: map[string]metaschema.Attribute{
 						"test": metaschema.StringAttribute{
@@ -394,7 +726,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 				ResourceSchemas: map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -417,7 +751,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 				Diagnostics: diag.Diagnostics{
 					diag.NewErrorDiagnostic(
@@ -473,8 +809,11 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
-				Provider:          providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{
 					"test_resource1": resourceschema.Schema{
 						Attributes: map[string]resourceschema.Attribute{
@@ -492,7 +831,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 					},
 				},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -541,7 +882,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 				Diagnostics: diag.Diagnostics{
 					diag.NewErrorDiagnostic(
@@ -609,7 +952,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: nil,
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -642,7 +987,40 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider:        providerschema.Schema{},
 				ResourceSchemas: nil,
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"resourceschemas-func-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								panic("test panic")
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.Provider Resources method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+				Provider: providerschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -676,8 +1054,11 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
-				Provider:          providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				ListResourceSchemas:      map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{
 					"testprovidertype_resource": resourceschema.Schema{
 						Attributes: map[string]resourceschema.Attribute{
@@ -688,7 +1069,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 					},
 				},
 				ServerCapabilities: &fwserver.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
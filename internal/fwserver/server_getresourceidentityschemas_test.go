@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+func TestServerGetResourceIdentitySchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.GetResourceIdentitySchemasRequest
+		expectedResponse *fwserver.GetResourceIdentitySchemasResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{},
+			},
+		},
+		"identityschemas": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource1"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"test1": identityschema.StringAttribute{
+													RequiredForImport: true,
+												},
+											},
+										}
+									},
+								}
+							},
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource2"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"test2": identityschema.Int64Attribute{
+													OptionalForImport: true,
+												},
+											},
+											Version: 1,
+										}
+									},
+								}
+							},
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource3"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetResourceIdentitySchemasRequest{},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{
+					"test_resource1": identityschema.Schema{
+						Attributes: map[string]identityschema.Attribute{
+							"test1": identityschema.StringAttribute{
+								RequiredForImport: true,
+							},
+						},
+					},
+					"test_resource2": identityschema.Schema{
+						Attributes: map[string]identityschema.Attribute{
+							"test2": identityschema.Int64Attribute{
+								OptionalForImport: true,
+							},
+						},
+						Version: 1,
+					},
+				},
+			},
+		},
+		"identityschemas-invalid-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"test": identityschema.StringAttribute{
+													RequiredForImport: true,
+													OptionalForImport: true,
+												},
+											},
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetResourceIdentitySchemasRequest{},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test\" must set exactly one of RequiredForImport or OptionalForImport to true.",
+					),
+				},
+			},
+		},
+		"identityschemas-duplicate-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+								}
+							},
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetResourceIdentitySchemasRequest{},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Resource Type Defined",
+						"The test_resource resource type name was returned for multiple resources. "+
+							"Resource type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.GetResourceIdentitySchemasResponse{}
+			testCase.server.GetResourceIdentitySchemas(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,8 +5,13 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +20,7 @@ import (
 
 // ImportedResource represents a resource that was imported.
 type ImportedResource struct {
+	Identity *tfsdk.ResourceIdentity
 	Private  *privatestate.Data
 	State    tfsdk.State
 	TypeName string
@@ -23,8 +29,17 @@ type ImportedResource struct {
 // ImportResourceStateRequest is the framework server request for the
 // ImportResourceState RPC.
 type ImportResourceStateRequest struct {
-	ID       string
-	Resource resource.Resource
+	ClientCapabilities resource.ImportStateClientCapabilities
+	ID                 string
+	Resource           resource.Resource
+
+	// Identity is the resource identity to import by, which is mutually
+	// exclusive with ID.
+	Identity *tfsdk.ResourceIdentity
+
+	// IdentitySchema is the resource identity schema, which is nil if the
+	// resource does not support identity.
+	IdentitySchema fwschema.Schema
 
 	// EmptyState is an empty State for the resource schema. This is used to
 	// initialize the ImportedResource State of the ImportResourceStateResponse
@@ -40,6 +55,7 @@ type ImportResourceStateRequest struct {
 // ImportResourceStateResponse is the framework server response for the
 // ImportResourceState RPC.
 type ImportResourceStateResponse struct {
+	Deferred          *resource.Deferred
 	Diagnostics       diag.Diagnostics
 	ImportedResources []ImportedResource
 }
@@ -50,6 +66,33 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	if s.ProviderDeferred != nil {
+		logging.FrameworkDebug(ctx,
+			"Provider has deferred response configured, automatically returning deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: s.ProviderDeferred.Reason.String(),
+			},
+		)
+
+		// The imported resource state is entirely unknown, as no provider
+		// defined logic has been called to determine any values.
+		resp.ImportedResources = []ImportedResource{
+			{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(req.EmptyState.Schema.Type().TerraformType(ctx), tftypes.UnknownValue),
+					Schema: req.EmptyState.Schema,
+				},
+				Identity: resourceIdentity(ctx, req.Identity, req.IdentitySchema),
+				TypeName: req.TypeName,
+			},
+		}
+		resp.Deferred = &resource.Deferred{
+			Reason: resource.DeferredReason(s.ProviderDeferred.Reason),
+		}
+
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -59,7 +102,9 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -90,7 +135,26 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	}
 
 	importReq := resource.ImportStateRequest{
-		ID: req.ID,
+		ClientCapabilities:  req.ClientCapabilities,
+		ID:                  req.ID,
+		NewImportedResource: s.newImportedResource,
+	}
+
+	// Import by identity is only possible if the resource has an identity
+	// schema, otherwise the existing ID path is used.
+	if req.ID == "" && req.Identity != nil {
+		if req.IdentitySchema == nil {
+			resp.Diagnostics.AddError(
+				"Resource Identity Not Supported",
+				"An unexpected error was encountered when importing the resource. "+
+					"Terraform requested an import by identity, however this resource does not support identity. "+
+					"Import the resource by ID instead or contact the provider developer for additional information.",
+			)
+
+			return
+		}
+
+		importReq.Identity = resourceIdentity(ctx, req.Identity, req.IdentitySchema)
 	}
 
 	privateProviderData := privatestate.EmptyProviderData(ctx)
@@ -100,11 +164,14 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 			Raw:    req.EmptyState.Raw.Copy(),
 			Schema: req.EmptyState.Schema,
 		},
-		Private: privateProviderData,
+		Identity: resourceIdentity(ctx, importReq.Identity, req.IdentitySchema),
+		Private:  privateProviderData,
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource ImportState")
-	resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	fwpanic.Call(ctx, &importResp.Diagnostics, fwpanic.Method{Implementation: resourceWithImportState, Name: "ImportState", TypeName: req.TypeName}, func() {
+		resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource ImportState")
 
 	resp.Diagnostics.Append(importResp.Diagnostics...)
@@ -113,7 +180,21 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
-	if importResp.State.Raw.Equal(req.EmptyState.Raw) {
+	if importResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Resource Response",
+			"Resource configured a deferred response for the ImportResourceState operation but the Terraform request "+
+				"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	resp.Deferred = importResp.Deferred
+
+	// A deferred import is not required to return state, as the resource
+	// values are not yet known.
+	if importResp.Deferred == nil && importResp.State.Raw.Equal(req.EmptyState.Raw) {
 		resp.Diagnostics.AddError(
 			"Missing Resource Import State",
 			"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
@@ -122,6 +203,21 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedState, err := tftypes.Transform(importResp.State.Raw, NullifyWriteOnlyAttributes(ctx, importResp.State.Schema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State",
+			"There was an unexpected error nullifying write-only attribute values in the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	importResp.State.Raw = nullifiedState
+
 	private := &privatestate.Data{}
 
 	if importResp.Private != nil {
@@ -130,9 +226,146 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 
 	resp.ImportedResources = []ImportedResource{
 		{
+			Identity: importResp.Identity,
 			State:    importResp.State,
 			TypeName: req.TypeName,
 			Private:  private,
 		},
 	}
+
+	for _, additionalResource := range importResp.AdditionalResources {
+		importedResource, diags := s.additionalImportedResource(ctx, additionalResource)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		resp.ImportedResources = append(resp.ImportedResources, importedResource)
+	}
+
+	if resp.Diagnostics.HasError() {
+		resp.ImportedResources = nil
+	}
+}
+
+// newImportedResource returns a resource.ImportedResource with a null State
+// and Identity matching the schemas of the given resource type name.
+func (s *Server) newImportedResource(ctx context.Context, typeName string) (resource.ImportedResource, diag.Diagnostics) {
+	importedResource := resource.ImportedResource{
+		Private:  privatestate.EmptyProviderData(ctx),
+		TypeName: typeName,
+	}
+
+	resourceSchema, diags := s.ResourceSchema(ctx, typeName)
+
+	if diags.HasError() {
+		return importedResource, diags
+	}
+
+	identitySchema, identityDiags := s.ResourceIdentitySchema(ctx, typeName)
+
+	diags.Append(identityDiags...)
+
+	if diags.HasError() {
+		return importedResource, diags
+	}
+
+	importedResource.State = tfsdk.State{
+		Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+		Schema: resourceSchema,
+	}
+	importedResource.Identity = resourceIdentity(ctx, nil, identitySchema)
+
+	return importedResource, diags
+}
+
+// additionalImportedResource validates a resource.ImportedResource returned
+// in the ImportStateResponse type AdditionalResources field against the
+// schemas of its resource type and returns the ImportedResource equivalent.
+func (s *Server) additionalImportedResource(ctx context.Context, additionalResource resource.ImportedResource) (ImportedResource, diag.Diagnostics) {
+	resourceSchema, diags := s.ResourceSchema(ctx, additionalResource.TypeName)
+
+	if diags.HasError() {
+		return ImportedResource{}, diags
+	}
+
+	identitySchema, identityDiags := s.ResourceIdentitySchema(ctx, additionalResource.TypeName)
+
+	diags.Append(identityDiags...)
+
+	if diags.HasError() {
+		return ImportedResource{}, diags
+	}
+
+	stateRaw := additionalResource.State.Raw
+
+	if stateRaw.Type() == nil || !stateRaw.Type().Equal(resourceSchema.Type().TerraformType(ctx)) {
+		diags.AddError(
+			"Invalid Additional Imported Resource",
+			"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+				fmt.Sprintf("Resource ImportState method returned an additional %q resource with State which does not match its resource schema. ", additionalResource.TypeName)+
+				"Use the ImportStateRequest type NewImportedResource function to create additional resources.",
+		)
+
+		return ImportedResource{}, diags
+	}
+
+	if stateRaw.IsNull() {
+		diags.AddError(
+			"Missing Resource Import State",
+			"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+				fmt.Sprintf("Resource ImportState method returned an additional %q resource with no State.", additionalResource.TypeName),
+		)
+
+		return ImportedResource{}, diags
+	}
+
+	if !resourceIdentityIsNull(additionalResource.Identity) {
+		if identitySchema == nil || !additionalResource.Identity.Raw.Type().Equal(identitySchema.Type().TerraformType(ctx)) {
+			diags.AddError(
+				"Invalid Additional Imported Resource",
+				"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+					fmt.Sprintf("Resource ImportState method returned an additional %q resource with Identity which does not match its resource identity schema. ", additionalResource.TypeName)+
+					"Use the ImportStateRequest type NewImportedResource function to create additional resources.",
+			)
+
+			return ImportedResource{}, diags
+		}
+	}
+
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedState, err := tftypes.Transform(stateRaw, NullifyWriteOnlyAttributes(ctx, resourceSchema))
+
+	if err != nil {
+		diags.AddError(
+			"Error Modifying State",
+			"There was an unexpected error nullifying write-only attribute values in the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return ImportedResource{}, diags
+	}
+
+	private := &privatestate.Data{}
+
+	if additionalResource.Private != nil {
+		private.Provider = additionalResource.Private
+	}
+
+	importedResource := ImportedResource{
+		Private: private,
+		State: tfsdk.State{
+			Raw:    nullifiedState,
+			Schema: resourceSchema,
+		},
+		TypeName: additionalResource.TypeName,
+	}
+
+	if identitySchema != nil {
+		importedResource.Identity = additionalResource.Identity
+	}
+
+	return importedResource, diags
 }
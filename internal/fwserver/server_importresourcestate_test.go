@@ -16,9 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestServerImportResourceState(t *testing.T) {
@@ -84,6 +87,56 @@ func TestServerImportResourceState(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentity := &tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testIdentitySchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_id": tftypes.NewValue(tftypes.String, "test-id"),
+		}),
+		Schema: testIdentitySchema,
+	}
+
+	testOtherStateValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "test-other-id"),
+		"optional": tftypes.NewValue(tftypes.String, nil),
+		"required": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testOtherIdentity := &tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testIdentitySchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_id": tftypes.NewValue(tftypes.String, "test-other-id"),
+		}),
+		Schema: testIdentitySchema,
+	}
+
+	testProviderWithOtherResource := &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.ResourceWithIdentity{
+						Resource: &testprovider.Resource{
+							SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+								resp.Schema = testSchema
+							},
+							MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = "test_other"
+							},
+						},
+						IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+							resp.IdentitySchema = testIdentitySchema
+						},
+					}
+				},
+			}
+		},
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ImportResourceStateRequest
@@ -95,6 +148,38 @@ func TestServerImportResourceState(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ImportResourceStateResponse{},
 		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				ClientCapabilities: resource.ImportStateClientCapabilities{
+					DeferralAllowed: true,
+				},
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed to be true")
+						}
+
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    *testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
 		"request-id": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -124,6 +209,96 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"request-id-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState:     *testEmptyState,
+				ID:             "test-id",
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						if req.Identity != nil {
+							resp.Diagnostics.AddError("unexpected req.Identity value", req.Identity.Raw.String())
+						}
+
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("test_id"), types.StringValue(req.ID))...)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						Identity: testIdentity,
+						State:    *testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"request-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState:     *testEmptyState,
+				Identity:       testIdentity,
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						if req.ID != "" {
+							resp.Diagnostics.AddError("unexpected req.ID value: %s", req.ID)
+						}
+
+						resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("test_id"), req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						Identity: testIdentity,
+						State:    *testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"request-identity-not-supported": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				Identity:   testIdentity,
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resp.Diagnostics.AddError("unexpected ImportState call", "ImportState should not be called")
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Identity Not Supported",
+						"An unexpected error was encountered when importing the resource. "+
+							"Terraform requested an import by identity, however this resource does not support identity. "+
+							"Import the resource by ID instead or contact the provider developer for additional information.",
+					),
+				},
+			},
+		},
 		"request-resourcetype-importstate-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -192,6 +367,92 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-deferral-automatic": {
+			server: &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				ProviderDeferred: &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				ClientCapabilities: resource.ImportStateClientCapabilities{
+					DeferralAllowed: true,
+				},
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resp.Diagnostics.AddError("Test assertion failed: ", "import shouldn't be called")
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw:    tftypes.NewValue(testType, tftypes.UnknownValue),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+					},
+				},
+				Deferred: &resource.Deferred{Reason: resource.DeferredReasonProviderConfigUnknown},
+			},
+		},
+		"response-deferral-manual": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				ClientCapabilities: resource.ImportStateClientCapabilities{
+					DeferralAllowed: true,
+				},
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    *testEmptyState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+				Deferred: &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq},
+			},
+		},
+		"response-deferral-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Resource Response",
+						"Resource configured a deferred response for the ImportResourceState operation but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -274,6 +535,142 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-importedresources-additional": {
+			server: &fwserver.Server{
+				Provider: testProviderWithOtherResource,
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						other, diags := req.NewImportedResource(ctx, "test_other")
+
+						resp.Diagnostics.Append(diags...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						resp.Diagnostics.Append(other.State.SetAttribute(ctx, path.Root("id"), "test-other-id")...)
+						resp.Diagnostics.Append(other.Identity.SetAttribute(ctx, path.Root("test_id"), "test-other-id")...)
+
+						resp.AdditionalResources = append(resp.AdditionalResources, other)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    *testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+					{
+						Identity: testOtherIdentity,
+						State: tfsdk.State{
+							Raw:    testOtherStateValue,
+							Schema: testSchema,
+						},
+						TypeName: "test_other",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"response-importedresources-additional-resourcetype-not-found": {
+			server: &fwserver.Server{
+				Provider: testProviderWithOtherResource,
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.AdditionalResources = append(resp.AdditionalResources, resource.ImportedResource{
+							TypeName: "test_missing",
+						})
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Type Not Found",
+						"No resource type named \"test_missing\" was found in the provider.",
+					),
+				},
+			},
+		},
+		"response-importedresources-additional-state-invalid": {
+			server: &fwserver.Server{
+				Provider: testProviderWithOtherResource,
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.AdditionalResources = append(resp.AdditionalResources, resource.ImportedResource{
+							TypeName: "test_other",
+						})
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Additional Imported Resource",
+						"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+							"Resource ImportState method returned an additional \"test_other\" resource with State which does not match its resource schema. "+
+							"Use the ImportStateRequest type NewImportedResource function to create additional resources.",
+					),
+				},
+			},
+		},
+		"response-importedresources-additional-state-missing": {
+			server: &fwserver.Server{
+				Provider: testProviderWithOtherResource,
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						other, diags := req.NewImportedResource(ctx, "test_other")
+
+						resp.Diagnostics.Append(diags...)
+
+						resp.AdditionalResources = append(resp.AdditionalResources, other)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource Import State",
+						"An unexpected error was encountered when importing the resource. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+
+							"Resource ImportState method returned an additional \"test_other\" resource with no State.",
+					),
+				},
+			},
+		},
 		"response-importedresources-empty-state": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -316,3 +713,220 @@ func TestServerImportResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestServerImportResourceState_CompositeID(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"number":  tftypes.Number,
+			"project": tftypes.String,
+			"region":  tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"number": schema.Int64Attribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testEmptyState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, nil),
+			"number":  tftypes.NewValue(tftypes.Number, nil),
+			"project": tftypes.NewValue(tftypes.String, nil),
+			"region":  tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, true),
+			"number":  tftypes.NewValue(tftypes.Number, 5),
+			"project": tftypes.NewValue(tftypes.String, "test-project"),
+			"region":  tftypes.NewValue(tftypes.String, "test-region"),
+		}),
+		Schema: testSchema,
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testSeparatedIDPaths := []path.Path{
+		path.Root("project"),
+		path.Root("region"),
+		path.Root("number"),
+		path.Root("enabled"),
+	}
+
+	const testTemplate = "projects/{project}/regions/{region}/{number}:{enabled}"
+
+	testCases := map[string]struct {
+		id                string
+		importStateMethod func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+		expectedResponse  *fwserver.ImportResourceStateResponse
+	}{
+		"separated-id": {
+			id: "test-project/test-region/5/true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateSeparatedID(ctx, "/", testSeparatedIDPaths, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"separated-id-parts-mismatch": {
+			id: "test-project/test-region",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateSeparatedID(ctx, "/", testSeparatedIDPaths, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier with format: project/region/number/enabled. Got: "test-project/test-region"`,
+					),
+				},
+			},
+		},
+		"separated-id-part-empty": {
+			id: "test-project//5/true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateSeparatedID(ctx, "/", testSeparatedIDPaths, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier with format: project/region/number/enabled. Got: "test-project//5/true"`,
+					),
+				},
+			},
+		},
+		"separated-id-bool-invalid": {
+			id: "test-project/test-region/5/yes",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateSeparatedID(ctx, "/", testSeparatedIDPaths, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("enabled"),
+						"Invalid Import Identifier",
+						`The import identifier part "yes" for enabled could not be converted to the attribute type. The value is not a boolean, such as true or false.`+"\n\n"+
+							"Expected import identifier with format: project/region/number/enabled",
+					),
+				},
+			},
+		},
+		"template-id": {
+			id: "projects/test-project/regions/test-region/5:true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateTemplateID(ctx, testTemplate, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State:    testState,
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"template-id-mismatch": {
+			id: "test-project/test-region/5:true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateTemplateID(ctx, testTemplate, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier with format: projects/{project}/regions/{region}/{number}:{enabled}. Got: "test-project/test-region/5:true"`,
+					),
+				},
+			},
+		},
+		"template-id-number-invalid": {
+			id: "projects/test-project/regions/test-region/five:true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateTemplateID(ctx, testTemplate, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("number"),
+						"Invalid Import Identifier",
+						`The import identifier part "five" for number could not be converted to the attribute type. The value is not a number.`+"\n\n"+
+							"Expected import identifier with format: projects/{project}/regions/{region}/{number}:{enabled}",
+					),
+				},
+			},
+		},
+		"template-invalid": {
+			id: "test-project",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateTemplateID(ctx, "{project}{region}", req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Import Template ID Invalid Template",
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							`Resource ImportState method call to ImportStateTemplateID has an invalid template "{project}{region}": placeholder "region" must be separated from the preceding placeholder`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.ImportResourceStateRequest{
+				EmptyState: testEmptyState,
+				ID:         testCase.id,
+				Resource: &testprovider.ResourceWithImportState{
+					Resource:          &testprovider.Resource{},
+					ImportStateMethod: testCase.importStateMethod,
+				},
+				TypeName: "test_resource",
+			}
+			response := &fwserver.ImportResourceStateResponse{}
+
+			server.ImportResourceState(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
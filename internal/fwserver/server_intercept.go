@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+// Intercept calls the given framework operation through the chain of
+// Interceptors. The diagnostics are the response diagnostics of the
+// operation, which are available to the interceptors before and after the
+// operation is called and are updated with any interceptor diagnostics. The
+// operation measurement is reported if the context carries a metrics
+// reporter.
+func (s *Server) Intercept(ctx context.Context, operation interceptor.Operation, typeName string, diags *diag.Diagnostics, f func(context.Context)) {
+	ctx = fwmetrics.ContextWithOperation(ctx, operation, typeName)
+	start := time.Now()
+
+	defer func() {
+		fwmetrics.ReportOperation(ctx, start, operationOutcome(diags.HasError()))
+	}()
+
+	if len(s.Interceptors) == 0 {
+		f(ctx)
+		return
+	}
+
+	req := interceptor.Request{
+		Operation: operation,
+		TypeName:  typeName,
+	}
+	resp := &interceptor.Response{
+		Diagnostics: *diags,
+	}
+
+	var called bool
+
+	s.intercept(ctx, 0, req, resp, func(ctx context.Context) {
+		called = true
+
+		// Operations may replace rather than append to their response
+		// diagnostics, so the existing and interceptor diagnostics are kept
+		// separately and the operation diagnostics are appended afterwards.
+		interceptorDiags := resp.Diagnostics
+
+		*diags = nil
+
+		f(ctx)
+
+		resp.Diagnostics = append(interceptorDiags, *diags...)
+	})
+
+	if !called && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(interceptorDidNotCallNextDiag(operation))
+	}
+
+	*diags = resp.Diagnostics
+}
+
+// InterceptFunction calls the given function call operation through the
+// chain of Interceptors. Since functions do not return diagnostics, the
+// function error is available to the interceptors via the response
+// FunctionError field and any interceptor diagnostics are converted into a
+// function error. The operation measurement is reported if the context
+// carries a metrics reporter.
+func (s *Server) InterceptFunction(ctx context.Context, name string, funcErr **function.FuncError, f func(context.Context)) {
+	ctx = fwmetrics.ContextWithOperation(ctx, interceptor.OperationCallFunction, name)
+	start := time.Now()
+
+	defer func() {
+		fwmetrics.ReportOperation(ctx, start, operationOutcome(*funcErr != nil))
+	}()
+
+	if len(s.Interceptors) == 0 {
+		f(ctx)
+		return
+	}
+
+	req := interceptor.Request{
+		Operation: interceptor.OperationCallFunction,
+		TypeName:  name,
+	}
+	resp := &interceptor.Response{
+		FunctionError: *funcErr,
+	}
+
+	var called bool
+
+	s.intercept(ctx, 0, req, resp, func(ctx context.Context) {
+		called = true
+
+		*funcErr = resp.FunctionError
+
+		f(ctx)
+
+		resp.FunctionError = *funcErr
+	})
+
+	if !called && resp.FunctionError == nil && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(interceptorDidNotCallNextDiag(interceptor.OperationCallFunction))
+	}
+
+	*funcErr = function.ConcatFuncErrors(resp.FunctionError, function.FuncErrorFromDiags(ctx, resp.Diagnostics))
+}
+
+// interceptorDidNotCallNextDiag returns an error diagnostic for when the
+// interceptor chain returned without calling the framework operation or
+// adding an error. Otherwise, the empty operation response would be sent to
+// Terraform, such as a null state which removes a resource.
+func interceptorDidNotCallNextDiag(operation interceptor.Operation) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Interceptor Did Not Call Next",
+		fmt.Sprintf("An interceptor returned without calling next or adding an error diagnostic, so the %s operation was not performed. ", operation)+
+			"Interceptors must call next to continue the operation or add an error diagnostic to skip it. "+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+// operationOutcome returns the metrics outcome of a framework operation.
+func operationOutcome(hasError bool) metrics.Outcome {
+	if hasError {
+		return metrics.OutcomeError
+	}
+
+	return metrics.OutcomeSuccess
+}
+
+// intercept calls the interceptor at the given index, with the next function
+// calling the following interceptor or, at the end of the chain, the
+// framework operation.
+func (s *Server) intercept(ctx context.Context, index int, req interceptor.Request, resp *interceptor.Response, operation func(context.Context)) {
+	if index >= len(s.Interceptors) {
+		operation(ctx)
+		return
+	}
+
+	i := s.Interceptors[index]
+
+	next := func(ctx context.Context) {
+		s.intercept(ctx, index+1, req, resp, operation)
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Interceptor")
+	fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: i, Name: "Intercept"}, func() {
+		i.Intercept(ctx, req, resp, next)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Interceptor")
+}
+
+// InterceptListResource calls the given list resource operation through the
+// chain of Interceptors. The chain only wraps the setup of the results
+// stream, since results are iterated after the operation returns. Any
+// interceptor diagnostics are emitted as an additional result before the
+// stream results, which are skipped if the diagnostics contain an error.
+func (s *Server) InterceptListResource(ctx context.Context, typeName string, stream *ListResultsStream, f func(context.Context)) {
+	var diags diag.Diagnostics
+
+	s.Intercept(ctx, interceptor.OperationListResource, typeName, &diags, f)
+
+	if len(diags) == 0 {
+		return
+	}
+
+	results := stream.Results
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		if !push(list.ListResult{Diagnostics: diags}) || diags.HasError() {
+			return
+		}
+
+		results(push)
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+type testInterceptorContextKey struct{}
+
+func TestServerIntercept(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors    []interceptor.Interceptor
+		diags           diag.Diagnostics
+		operation       func(ctx context.Context, diags *diag.Diagnostics)
+		expectedCalls   []string
+		expectedDiags   diag.Diagnostics
+		expectedSkipped bool
+	}{
+		"no-interceptors": {
+			operation: func(_ context.Context, diags *diag.Diagnostics) {
+				diags.AddWarning("operation summary", "operation detail")
+			},
+			expectedCalls: []string{"operation"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("operation summary", "operation detail"),
+			},
+		},
+		"order": {
+			interceptors: []interceptor.Interceptor{
+				testRecordingInterceptor("first"),
+				testRecordingInterceptor("second"),
+			},
+			operation:     func(_ context.Context, _ *diag.Diagnostics) {},
+			expectedCalls: []string{"first:before", "second:before", "operation", "second:after", "first:after"},
+		},
+		"request": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, req interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					if req.Operation != interceptor.OperationReadResource || req.TypeName != "test_resource" {
+						resp.Diagnostics.AddError("unexpected request", req.TypeName)
+					}
+
+					next(ctx)
+				}),
+			},
+			operation:     func(_ context.Context, _ *diag.Diagnostics) {},
+			expectedCalls: []string{"operation"},
+		},
+		"context": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, _ interceptor.Request, _ *interceptor.Response, next interceptor.Next) {
+					next(context.WithValue(ctx, testInterceptorContextKey{}, "test-value"))
+				}),
+			},
+			operation: func(ctx context.Context, diags *diag.Diagnostics) {
+				if ctx.Value(testInterceptorContextKey{}) != "test-value" {
+					diags.AddError("missing context value", "")
+				}
+			},
+			expectedCalls: []string{"operation"},
+		},
+		"diagnostics": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, _ interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					resp.Diagnostics.AddWarning("before summary", "before detail")
+
+					next(ctx)
+
+					if !resp.Diagnostics.HasError() {
+						return
+					}
+
+					resp.Diagnostics.AddWarning("after summary", "after detail")
+				}),
+			},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("existing summary", "existing detail"),
+			},
+			operation: func(_ context.Context, diags *diag.Diagnostics) {
+				diags.AddError("operation summary", "operation detail")
+			},
+			expectedCalls: []string{"operation"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("existing summary", "existing detail"),
+				diag.NewWarningDiagnostic("before summary", "before detail"),
+				diag.NewErrorDiagnostic("operation summary", "operation detail"),
+				diag.NewWarningDiagnostic("after summary", "after detail"),
+			},
+		},
+		"diagnostics-operation-replaced": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, _ interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					resp.Diagnostics.AddWarning("before summary", "before detail")
+
+					next(ctx)
+
+					resp.Diagnostics.AddWarning("after summary", "after detail")
+				}),
+			},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("existing summary", "existing detail"),
+			},
+			operation: func(_ context.Context, diags *diag.Diagnostics) {
+				// Framework operations, such as ReadResource, replace the
+				// response diagnostics with the provider defined method
+				// response diagnostics.
+				*diags = diag.Diagnostics{
+					diag.NewErrorDiagnostic("operation summary", "operation detail"),
+				}
+			},
+			expectedCalls: []string{"operation"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("existing summary", "existing detail"),
+				diag.NewWarningDiagnostic("before summary", "before detail"),
+				diag.NewErrorDiagnostic("operation summary", "operation detail"),
+				diag.NewWarningDiagnostic("after summary", "after detail"),
+			},
+		},
+		"skip": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, resp *interceptor.Response, _ interceptor.Next) {
+					resp.Diagnostics.AddError("skip summary", "skip detail")
+				}),
+			},
+			operation: func(_ context.Context, _ *diag.Diagnostics) {},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("skip summary", "skip detail"),
+			},
+		},
+		"skip-without-error": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, resp *interceptor.Response, _ interceptor.Next) {
+					resp.Diagnostics.AddWarning("skip summary", "skip detail")
+				}),
+			},
+			operation: func(_ context.Context, _ *diag.Diagnostics) {},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("skip summary", "skip detail"),
+				diag.NewErrorDiagnostic(
+					"Interceptor Did Not Call Next",
+					"An interceptor returned without calling next or adding an error diagnostic, so the ReadResource operation was not performed. "+
+						"Interceptors must call next to continue the operation or add an error diagnostic to skip it. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"panic": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, _ *interceptor.Response, _ interceptor.Next) {
+					panic("test panic")
+				}),
+			},
+			operation: func(_ context.Context, _ *diag.Diagnostics) {},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider Panic",
+					"The provider panicked while calling the interceptor.Func Intercept method for test_resource. "+
+						"This is always an issue with the provider and should be reported to the provider developers. "+
+						"The provider logs contain the stack trace of the panic.\n\n"+
+						"Panic: test panic",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+
+			ctx := context.WithValue(context.Background(), testInterceptorCallsKey{}, &calls)
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors,
+			}
+
+			diags := slices.Clone(testCase.diags)
+
+			server.Intercept(ctx, interceptor.OperationReadResource, "test_resource", &diags, func(ctx context.Context) {
+				calls = append(calls, "operation")
+
+				testCase.operation(ctx, &diags)
+			})
+
+			if diff := cmp.Diff(testCase.expectedCalls, calls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerInterceptFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors    []interceptor.Interceptor
+		operation       func() *function.FuncError
+		expectedFuncErr *function.FuncError
+	}{
+		"no-interceptors": {
+			operation: func() *function.FuncError {
+				return function.NewFuncError("operation error")
+			},
+			expectedFuncErr: function.NewFuncError("operation error"),
+		},
+		"request": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, req interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					if req.Operation != interceptor.OperationCallFunction || req.TypeName != "test_function" {
+						resp.FunctionError = function.NewFuncError("unexpected request")
+					}
+
+					next(ctx)
+				}),
+			},
+			operation: func() *function.FuncError {
+				return nil
+			},
+		},
+		"function-error": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, _ interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					next(ctx)
+
+					if resp.FunctionError == nil {
+						return
+					}
+
+					resp.FunctionError = function.NewFuncError("intercepted: " + resp.FunctionError.Text)
+				}),
+			},
+			operation: func() *function.FuncError {
+				return function.NewFuncError("operation error")
+			},
+			expectedFuncErr: function.NewFuncError("intercepted: operation error"),
+		},
+		"diagnostics": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, resp *interceptor.Response, _ interceptor.Next) {
+					resp.Diagnostics.AddError("skip summary", "skip detail")
+				}),
+			},
+			operation: func() *function.FuncError {
+				return function.NewFuncError("operation error")
+			},
+			expectedFuncErr: function.NewFuncError("skip summary: skip detail"),
+		},
+		"skip-without-error": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, _ *interceptor.Response, _ interceptor.Next) {}),
+			},
+			operation: func() *function.FuncError {
+				return nil
+			},
+			expectedFuncErr: function.NewFuncError(
+				"Interceptor Did Not Call Next: " +
+					"An interceptor returned without calling next or adding an error diagnostic, so the CallFunction operation was not performed. " +
+					"Interceptors must call next to continue the operation or add an error diagnostic to skip it. " +
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors,
+			}
+
+			var funcErr *function.FuncError
+
+			server.InterceptFunction(context.Background(), "test_function", &funcErr, func(_ context.Context) {
+				funcErr = function.ConcatFuncErrors(funcErr, testCase.operation())
+			})
+
+			if diff := cmp.Diff(testCase.expectedFuncErr, funcErr); diff != "" {
+				t.Errorf("unexpected function error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerInterceptListResource(t *testing.T) {
+	t.Parallel()
+
+	testResults := func(push func(list.ListResult) bool) {
+		push(list.ListResult{DisplayName: "test"})
+	}
+
+	testCases := map[string]struct {
+		interceptors    []interceptor.Interceptor
+		expectedResults []list.ListResult
+	}{
+		"no-interceptors": {
+			expectedResults: []list.ListResult{
+				{DisplayName: "test"},
+			},
+		},
+		"warning": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(ctx context.Context, _ interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+					next(ctx)
+
+					resp.Diagnostics.AddWarning("warning summary", "warning detail")
+				}),
+			},
+			expectedResults: []list.ListResult{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					},
+				},
+				{DisplayName: "test"},
+			},
+		},
+		"error": {
+			interceptors: []interceptor.Interceptor{
+				interceptor.Func(func(_ context.Context, _ interceptor.Request, resp *interceptor.Response, _ interceptor.Next) {
+					resp.Diagnostics.AddError("error summary", "error detail")
+				}),
+			},
+			expectedResults: []list.ListResult{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors,
+			}
+
+			stream := &fwserver.ListResultsStream{
+				Results: list.NoListResults,
+			}
+
+			server.InterceptListResource(context.Background(), "test_resource", stream, func(_ context.Context) {
+				stream.Results = testResults
+			})
+
+			if diff := cmp.Diff(testCase.expectedResults, slices.Collect(stream.Results)); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+type testInterceptorCallsKey struct{}
+
+// testRecordingInterceptor returns an interceptor which records calls before
+// and after the next interceptor into the calls slice of the context.
+func testRecordingInterceptor(name string) interceptor.Interceptor {
+	return interceptor.Func(func(ctx context.Context, _ interceptor.Request, _ *interceptor.Response, next interceptor.Next) {
+		calls := ctx.Value(testInterceptorCallsKey{}).(*[]string)
+
+		*calls = append(*calls, name+":before")
+
+		next(ctx)
+
+		*calls = append(*calls, name+":after")
+	})
+}
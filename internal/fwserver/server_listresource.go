@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ListResourceRequest is the framework server request for the ListResource
+// RPC.
+type ListResourceRequest struct {
+	Config                 *tfsdk.Config
+	ListResource           list.ListResource
+	ResourceSchema         fwschema.Schema
+	ResourceIdentitySchema fwschema.Schema
+	IncludeResource        bool
+	Limit                  int64
+}
+
+// ListResultsStream is the framework server stream for the ListResource RPC.
+type ListResultsStream struct {
+	// Results is the stream of validated list results. It is always set
+	// after calling ListResource, even when errors prevent listing, in which
+	// case it emits a single result containing the error diagnostics.
+	Results iter.Seq[list.ListResult]
+}
+
+// ListResource implements the framework server ListResource RPC.
+//
+// Results are streamed lazily as the protocol server consumes them. The
+// stream stops when the request limit is reached or the context is
+// cancelled, such as when Terraform calls the StopProvider RPC.
+func (s *Server) ListResource(ctx context.Context, req *ListResourceRequest, stream *ListResultsStream) {
+	stream.Results = list.NoListResults
+
+	if req == nil {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	if req.ResourceIdentitySchema == nil {
+		diags.AddError(
+			"Resource Identity Not Supported",
+			fmt.Sprintf("The %T ListResource is tied to a managed resource type which does not define an identity schema. ", req.ListResource)+
+				"List results require a resource identity. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	if listResourceWithConfigure, ok := req.ListResource.(list.ListResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "ListResource implements ListResourceWithConfigure")
+
+		configureReq := resource.ConfigureRequest{
+			ProviderData: s.ResourceConfigureData,
+		}
+		configureResp := resource.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined ListResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: listResourceWithConfigure, Name: "Configure"}, func() {
+			listResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined ListResource Configure")
+
+		diags.Append(configureResp.Diagnostics...)
+
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+			return
+		}
+	}
+
+	listReq := list.ListRequest{
+		IncludeResource:        req.IncludeResource,
+		Limit:                  req.Limit,
+		ResourceSchema:         req.ResourceSchema,
+		ResourceIdentitySchema: req.ResourceIdentitySchema,
+	}
+
+	if req.Config != nil {
+		listReq.Config = *req.Config
+	}
+
+	listStream := list.ListResultsStream{}
+
+	var listDiags diag.Diagnostics
+
+	logging.FrameworkDebug(ctx, "Calling provider defined ListResource List")
+	recovered := fwpanic.Call(ctx, &listDiags, fwpanic.Method{Implementation: req.ListResource, Name: "List"}, func() {
+		req.ListResource.List(ctx, listReq, &listStream)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined ListResource List")
+
+	if recovered {
+		stream.Results = list.ListResultsStreamDiagnostics(listDiags)
+		return
+	}
+
+	if listStream.Results == nil {
+		// Prevent a panic for implementations which do not set the results.
+		listStream.Results = list.NoListResults
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		// The results are provider defined, so a panic while iterating them
+		// is returned as a final result with an error diagnostic.
+		var resultsDiags diag.Diagnostics
+
+		recovered := fwpanic.Call(ctx, &resultsDiags, fwpanic.Method{Implementation: req.ListResource, Name: "List results"}, func() {
+			for result := range listStream.Results {
+				if ctx.Err() != nil {
+					logging.FrameworkDebug(ctx, "Context cancelled, stopping ListResource results stream")
+
+					return
+				}
+
+				result = validateListResult(ctx, req, result)
+
+				if !push(result) {
+					return
+				}
+
+				count++
+
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+		})
+
+		if recovered {
+			push(list.ListResult{
+				Diagnostics: resultsDiags,
+			})
+		}
+	}
+}
+
+// validateListResult ensures the given result has the data expected by
+// Terraform, adding error diagnostics to the result otherwise. Any resource
+// data is removed when the request did not include the resource.
+func validateListResult(ctx context.Context, req *ListResourceRequest, result list.ListResult) list.ListResult {
+	if !req.IncludeResource && result.Resource != nil {
+		logging.FrameworkDebug(ctx, "Removing resource from list result as it was not requested")
+
+		result.Resource = nil
+	}
+
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	if resourceIdentityIsNull(result.Identity) {
+		result.Diagnostics.AddError(
+			"Missing Resource Identity in List Result",
+			"The Terraform Provider unexpectedly returned a list result without a resource identity. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerListResource(t *testing.T) {
+	t.Parallel()
+
+	testConfigSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"filter": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"filter": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"filter": tftypes.NewValue(tftypes.String, "test-filter"),
+		}),
+		Schema: testConfigSchema,
+	}
+
+	testResourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentity := func(id string) *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, id),
+			}),
+			Schema: testIdentitySchema,
+		}
+	}
+
+	testResource := func(id string) *tfsdk.State {
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, id),
+				"name": tftypes.NewValue(tftypes.String, "name-"+id),
+			}),
+			Schema: testResourceSchema,
+		}
+	}
+
+	// testListMethod emits a result for each ID, setting the identity and,
+	// when requested, the resource state.
+	testListMethod := func(ids ...string) func(context.Context, list.ListRequest, *list.ListResultsStream) {
+		return func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+			var filter types.String
+
+			diags := req.Config.GetAttribute(ctx, path.Root("filter"), &filter)
+
+			if diags.HasError() {
+				stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+				return
+			}
+
+			if filter.ValueString() != "test-filter" {
+				stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+					diag.NewErrorDiagnostic("Incorrect req.Config", "expected test-filter, got "+filter.ValueString()),
+				})
+
+				return
+			}
+
+			stream.Results = func(push func(list.ListResult) bool) {
+				for _, id := range ids {
+					result := req.NewListResult(ctx)
+					result.DisplayName = "name-" + id
+
+					result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), id)...)
+
+					if result.Resource != nil {
+						result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+						result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("name"), "name-"+id)...)
+					}
+
+					if !push(result) {
+						return
+					}
+				}
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		server          *fwserver.Server
+		ctx             context.Context
+		request         *fwserver.ListResourceRequest
+		expectedResults []list.ListResult
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResults: nil,
+		},
+		"request-missing-identity-schema": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: testListMethod("one"),
+				},
+				ResourceSchema: testResourceSchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic(
+							"Resource Identity Not Supported",
+							"The *testprovider.ListResource ListResource is tied to a managed resource type which does not define an identity schema. "+
+								"List results require a resource identity. "+
+								"This is always an issue with the provider and should be reported to the provider developers.",
+						),
+					},
+				},
+			},
+		},
+		"request-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: testListMethod("one", "two"),
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					DisplayName: "name-one",
+					Identity:    testIdentity("one"),
+				},
+				{
+					DisplayName: "name-two",
+					Identity:    testIdentity("two"),
+				},
+			},
+		},
+		"request-include-resource": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config:          testConfig,
+				IncludeResource: true,
+				ListResource: &testprovider.ListResource{
+					ListMethod: testListMethod("one", "two"),
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					DisplayName: "name-one",
+					Identity:    testIdentity("one"),
+					Resource:    testResource("one"),
+				},
+				{
+					DisplayName: "name-two",
+					Identity:    testIdentity("two"),
+					Resource:    testResource("two"),
+				},
+			},
+		},
+		"request-resource-not-requested": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+						stream.Results = slices.Values([]list.ListResult{
+							{
+								DisplayName: "name-one",
+								Identity:    testIdentity("one"),
+								Resource:    testResource("one"),
+							},
+						})
+					},
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					DisplayName: "name-one",
+					Identity:    testIdentity("one"),
+				},
+			},
+		},
+		"request-limit": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				Limit:  2,
+				ListResource: &testprovider.ListResource{
+					ListMethod: testListMethod("one", "two", "three"),
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					DisplayName: "name-one",
+					Identity:    testIdentity("one"),
+				},
+				{
+					DisplayName: "name-two",
+					Identity:    testIdentity("two"),
+				},
+			},
+		},
+		"request-context-cancelled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: testListMethod("one", "two"),
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: nil,
+		},
+		"request-no-results": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config:                 testConfig,
+				ListResource:           &testprovider.ListResource{},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: nil,
+		},
+		"response-missing-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+						stream.Results = func(push func(list.ListResult) bool) {
+							push(req.NewListResult(ctx))
+						}
+					},
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					Identity: &tfsdk.ResourceIdentity{
+						Raw: tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"id": tftypes.String,
+							},
+						}, nil),
+						Schema: testIdentitySchema,
+					},
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic(
+							"Missing Resource Identity in List Result",
+							"The Terraform Provider unexpectedly returned a list result without a resource identity. "+
+								"This is always a problem with the provider and should be reported to the provider developer.",
+						),
+					},
+				},
+			},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResource{
+					ListMethod: func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+						stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+							diag.NewErrorDiagnostic("error summary", "error detail"),
+						})
+					},
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					},
+				},
+			},
+		},
+		"request-ListResourceWithConfigure": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.Provider{},
+				ResourceConfigureData: "test-provider-data",
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+
+							return
+						}
+
+						if providerData != "test-provider-data" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								"Expected test-provider-data, got: "+providerData,
+							)
+						}
+					},
+					ListResource: &testprovider.ListResource{
+						ListMethod: testListMethod("one"),
+					},
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					DisplayName: "name-one",
+					Identity:    testIdentity("one"),
+				},
+			},
+		},
+		"request-ListResourceWithConfigure-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ListResourceRequest{
+				Config: testConfig,
+				ListResource: &testprovider.ListResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+					ListResource: &testprovider.ListResource{
+						ListMethod: testListMethod("one"),
+					},
+				},
+				ResourceSchema:         testResourceSchema,
+				ResourceIdentitySchema: testIdentitySchema,
+			},
+			expectedResults: []list.ListResult{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.ctx
+
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			stream := &fwserver.ListResultsStream{}
+			testCase.server.ListResource(ctx, testCase.request, stream)
+
+			got := slices.Collect(stream.Results)
+
+			if diff := cmp.Diff(got, testCase.expectedResults); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// MoveResourceStateRequest is the framework server request for the
+// MoveResourceState RPC.
+type MoveResourceStateRequest struct {
+	// SourcePrivate is the private state of the source resource.
+	SourcePrivate *privatestate.Data
+
+	// SourceProviderAddress is the address of the provider for the source
+	// resource type.
+	SourceProviderAddress string
+
+	// SourceRawState is the raw state of the source resource.
+	//
+	// TODO: Create framework defined type that is not protocol specific.
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/340
+	SourceRawState *tfprotov6.RawState
+
+	// SourceSchemaVersion is the version of the source resource state.
+	SourceSchemaVersion int64
+
+	// SourceTypeName is the source resource type for the move request.
+	SourceTypeName string
+
+	// TargetResource is the target resource for the move request.
+	TargetResource resource.Resource
+
+	// TargetResourceSchema is the target resource schema for the move
+	// request.
+	TargetResourceSchema fwschema.Schema
+
+	// TargetTypeName is the target resource type for the move request.
+	TargetTypeName string
+}
+
+// MoveResourceStateResponse is the framework server response for the
+// MoveResourceState RPC.
+type MoveResourceStateResponse struct {
+	Diagnostics   diag.Diagnostics
+	TargetPrivate *privatestate.Data
+	TargetState   *tfsdk.State
+}
+
+// MoveResourceState implements the framework server MoveResourceState RPC.
+func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRequest, resp *MoveResourceStateResponse) {
+	if req == nil {
+		return
+	}
+
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError(
+			"Missing Source Resource State",
+			"The MoveResourceState request did not include source resource state data. "+
+				"This is always an issue in Terraform and should be reported to the Terraform maintainers.",
+		)
+		return
+	}
+
+	if resourceWithConfigure, ok := req.TargetResource.(resource.ResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
+
+		configureReq := resource.ConfigureRequest{
+			ProviderData: s.ResourceConfigureData,
+		}
+		configureResp := resource.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure"}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resourceWithMoveState, ok := req.TargetResource.(resource.ResourceWithMoveState)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"The target resource implementation does not include support for moving resource state across resource types. "+
+				"The resource implementation can be updated by the provider developers to include this support with the MoveState method.\n\n"+
+				fmt.Sprintf("Source Provider Address: %s\n", req.SourceProviderAddress)+
+				fmt.Sprintf("Source Resource Type: %s\n", req.SourceTypeName)+
+				fmt.Sprintf("Source Resource Schema Version: %d\n", req.SourceSchemaVersion)+
+				fmt.Sprintf("Target Resource Type: %s", req.TargetTypeName),
+		)
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithMoveState")
+
+	var resourceStateMovers []resource.StateMover
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource MoveState")
+	recovered := fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: resourceWithMoveState, Name: "MoveState"}, func() {
+		resourceStateMovers = resourceWithMoveState.MoveState(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Resource MoveState")
+
+	if recovered {
+		return
+	}
+
+	// Define options to be used when unmarshalling raw state.
+	// IgnoreUndefinedAttributes will silently skip over fields in the JSON
+	// that do not have a matching entry in the schema.
+	unmarshalOpts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	sourcePrivate := privatestate.EmptyProviderData(ctx)
+
+	if req.SourcePrivate != nil && req.SourcePrivate.Provider != nil {
+		sourcePrivate = req.SourcePrivate.Provider
+	}
+
+	for idx, resourceStateMover := range resourceStateMovers {
+		logFields := map[string]interface{}{
+			logging.KeyStateMoverIndex: idx,
+		}
+
+		if resourceStateMover.StateMover == nil {
+			logging.FrameworkTrace(ctx, "Skipping StateMover with undefined StateMover function", logFields)
+			continue
+		}
+
+		if resourceStateMover.SourceTypeName != "" && resourceStateMover.SourceTypeName != req.SourceTypeName {
+			logging.FrameworkTrace(ctx, "Skipping StateMover with non-matching SourceTypeName", logFields)
+			continue
+		}
+
+		if resourceStateMover.SourceProviderAddress != "" && resourceStateMover.SourceProviderAddress != req.SourceProviderAddress {
+			logging.FrameworkTrace(ctx, "Skipping StateMover with non-matching SourceProviderAddress", logFields)
+			continue
+		}
+
+		moveStateReq := resource.MoveStateRequest{
+			SourcePrivate:         sourcePrivate,
+			SourceProviderAddress: req.SourceProviderAddress,
+			SourceRawState:        req.SourceRawState,
+			SourceSchemaVersion:   req.SourceSchemaVersion,
+			SourceTypeName:        req.SourceTypeName,
+		}
+
+		if resourceStateMover.SourceSchema != nil {
+			logging.FrameworkTrace(ctx, "Attempting to populate MoveResourceStateRequest source state from provider defined SourceSchema", logFields)
+
+			sourceSchemaType := resourceStateMover.SourceSchema.Type().TerraformType(ctx)
+
+			sourceStateValue, err := req.SourceRawState.UnmarshalWithOpts(sourceSchemaType, unmarshalOpts)
+
+			// An unrelated source resource type is expected to not match
+			// the schema, so this is not considered an error.
+			if err != nil {
+				logFields[logging.KeyError] = err.Error()
+
+				logging.FrameworkDebug(ctx, "Skipping StateMover due to error reading source state with provider defined SourceSchema", logFields)
+				continue
+			}
+
+			moveStateReq.SourceState = &tfsdk.State{
+				Raw:    sourceStateValue,
+				Schema: *resourceStateMover.SourceSchema,
+			}
+		}
+
+		moveStateResp := resource.MoveStateResponse{
+			TargetPrivate: privatestate.EmptyProviderData(ctx),
+			TargetState: tfsdk.State{
+				Raw:    tftypes.NewValue(req.TargetResourceSchema.Type().TerraformType(ctx), nil),
+				Schema: req.TargetResourceSchema,
+			},
+		}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined StateMover", logFields)
+		fwpanic.Call(ctx, &moveStateResp.Diagnostics, fwpanic.Method{Implementation: resourceStateMover, Name: "StateMover"}, func() {
+			resourceStateMover.StateMover(ctx, moveStateReq, &moveStateResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined StateMover", logFields)
+
+		resp.Diagnostics.Append(moveStateResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if moveStateResp.TargetState.Raw.IsNull() {
+			logging.FrameworkTrace(ctx, "StateMover did not set TargetState, attempting next StateMover", logFields)
+			continue
+		}
+
+		resp.TargetState = &moveStateResp.TargetState
+
+		if moveStateResp.TargetPrivate != nil {
+			resp.TargetPrivate = &privatestate.Data{
+				Provider: moveStateResp.TargetPrivate,
+			}
+		}
+
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to Move Resource State",
+		"The target resource implementation does not include support for moving resource state from the given source resource. "+
+			"The resource implementation can be updated by the provider developers to include this support with the MoveState method.\n\n"+
+			fmt.Sprintf("Source Provider Address: %s\n", req.SourceProviderAddress)+
+			fmt.Sprintf("Source Resource Type: %s\n", req.SourceTypeName)+
+			fmt.Sprintf("Source Resource Schema Version: %d\n", req.SourceSchemaVersion)+
+			fmt.Sprintf("Target Resource Type: %s", req.TargetTypeName),
+	)
+}
@@ -0,0 +1,563 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerMoveResourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"optional_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+			"required_attribute": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	schemaType := testSchema.Type().TerraformType(ctx)
+
+	testSourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_attribute": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSourceSchemaUnrelated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.NumberAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testSourceRawState := testNewRawState(t, map[string]interface{}{
+		"id":               "test-id-value",
+		"source_attribute": true,
+	})
+
+	testTargetState := &tfsdk.State{
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+			"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+			"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+		}),
+		Schema: testSchema,
+	}
+
+	testStateMover := resource.StateMover{
+		SourceSchema: &testSourceSchema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			var sourceStateData struct {
+				Id              string `tfsdk:"id"`
+				SourceAttribute bool   `tfsdk:"source_attribute"`
+			}
+
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &sourceStateData)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			targetStateData := struct {
+				Id                string  `tfsdk:"id"`
+				OptionalAttribute *string `tfsdk:"optional_attribute"`
+				RequiredAttribute string  `tfsdk:"required_attribute"`
+			}{
+				Id:                sourceStateData.Id,
+				RequiredAttribute: fmt.Sprintf("%t", sourceStateData.SourceAttribute),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, targetStateData)...)
+		},
+	}
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKey": "pValue"}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(ctx, testProviderKeyValue)
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(ctx),
+	}
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.MoveResourceStateRequest
+		expectedResponse *fwserver.MoveResourceStateResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{},
+		},
+		"request-SourceRawState-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceTypeName:       "test_source",
+				TargetResource:       &testprovider.Resource{},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Source Resource State",
+						"The MoveResourceState request did not include source resource state data. "+
+							"This is always an issue in Terraform and should be reported to the Terraform maintainers.",
+					),
+				},
+			},
+		},
+		"request-SourcePrivate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourcePrivate: &privatestate.Data{
+					Provider: testProviderData,
+				},
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									got, diags := req.SourcePrivate.GetKey(ctx, "providerKeyOne")
+
+									resp.Diagnostics.Append(diags...)
+
+									if string(got) != `{"pKey": "pValue"}` {
+										resp.Diagnostics.AddError("Unexpected req.SourcePrivate value", string(got))
+									}
+
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), types.StringValue("test-id-value"))...)
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("required_attribute"), types.StringValue("true"))...)
+								},
+							},
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"request-SourceRawState": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/test",
+				SourceRawState:        testSourceRawState,
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									if req.SourceProviderAddress != "registry.terraform.io/hashicorp/test" {
+										resp.Diagnostics.AddError("Unexpected req.SourceProviderAddress", req.SourceProviderAddress)
+									}
+
+									if req.SourceSchemaVersion != 1 {
+										resp.Diagnostics.AddError("Unexpected req.SourceSchemaVersion", fmt.Sprintf("%d", req.SourceSchemaVersion))
+									}
+
+									if req.SourceTypeName != "test_source" {
+										resp.Diagnostics.AddError("Unexpected req.SourceTypeName", req.SourceTypeName)
+									}
+
+									if req.SourceState != nil {
+										resp.Diagnostics.AddError("Unexpected req.SourceState", "expected nil without SourceSchema")
+									}
+
+									rawStateValue, err := req.SourceRawState.Unmarshal(testSourceSchema.Type().TerraformType(ctx))
+
+									if err != nil {
+										resp.Diagnostics.AddError("Unable to Unmarshal SourceRawState", err.Error())
+										return
+									}
+
+									var rawState map[string]tftypes.Value
+
+									if err := rawStateValue.As(&rawState); err != nil {
+										resp.Diagnostics.AddError("Unable to Convert SourceRawState", err.Error())
+										return
+									}
+
+									resp.TargetState.Raw = tftypes.NewValue(schemaType, map[string]tftypes.Value{
+										"id":                 rawState["id"],
+										"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+										"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+									})
+								},
+							},
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"request-SourceState": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{testStateMover}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"resource-configure-data": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.Provider{},
+				ResourceConfigureData: "test-provider-configure-value",
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithConfigureAndMoveState{
+					ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{testStateMover}
+					},
+					Resource: &testprovider.Resource{},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"resource-MoveState-not-implemented": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/test",
+				SourceRawState:        testSourceRawState,
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "test_source",
+				TargetResource:        &testprovider.Resource{},
+				TargetResourceSchema:  testSchema,
+				TargetTypeName:        "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Move Resource State",
+						"The target resource implementation does not include support for moving resource state across resource types. "+
+							"The resource implementation can be updated by the provider developers to include this support with the MoveState method.\n\n"+
+							"Source Provider Address: registry.terraform.io/hashicorp/test\n"+
+							"Source Resource Type: test_source\n"+
+							"Source Resource Schema Version: 1\n"+
+							"Target Resource Type: test_target",
+					),
+				},
+			},
+		},
+		"resource-MoveState-empty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/test",
+				SourceRawState:        testSourceRawState,
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Move Resource State",
+						"The target resource implementation does not include support for moving resource state from the given source resource. "+
+							"The resource implementation can be updated by the provider developers to include this support with the MoveState method.\n\n"+
+							"Source Provider Address: registry.terraform.io/hashicorp/test\n"+
+							"Source Resource Type: test_source\n"+
+							"Source Resource Schema Version: 1\n"+
+							"Target Resource Type: test_target",
+					),
+				},
+			},
+		},
+		"StateMover-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+									resp.Diagnostics.AddError("error summary", "error detail")
+								},
+							},
+							testStateMover,
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
+		"StateMover-SourceProviderAddress-mismatch": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/test",
+				SourceRawState:        testSourceRawState,
+				SourceTypeName:        "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceProviderAddress: "registry.terraform.io/hashicorp/other",
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "this StateMover should have been skipped")
+								},
+							},
+							testStateMover,
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"StateMover-SourceSchema-mismatch": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceSchema: &testSourceSchemaUnrelated,
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "this StateMover should have been skipped")
+								},
+							},
+							testStateMover,
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"StateMover-SourceTypeName-mismatch": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceTypeName: "test_other",
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "this StateMover should have been skipped")
+								},
+							},
+							testStateMover,
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+		"StateMover-TargetPrivate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceSchema: &testSourceSchema,
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, "providerKeyOne", []byte(`{"pKey": "pValue"}`))...)
+
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), types.StringValue("test-id-value"))...)
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("required_attribute"), types.StringValue("true"))...)
+								},
+							},
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: &privatestate.Data{
+					Provider: testProviderData,
+				},
+				TargetState: testTargetState,
+			},
+		},
+		"StateMover-TargetState-unset": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testSourceRawState,
+				SourceTypeName: "test_source",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					Resource: &testprovider.Resource{},
+					MoveStateMethod: func(_ context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+								},
+							},
+							testStateMover,
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_target",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				},
+				TargetPrivate: testEmptyPrivate,
+				TargetState:   testTargetState,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.MoveResourceStateResponse{}
+			testCase.server.MoveResourceState(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
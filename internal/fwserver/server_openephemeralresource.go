@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// OpenEphemeralResourceRequest is the framework server request for the
+// OpenEphemeralResource RPC.
+type OpenEphemeralResourceRequest struct {
+	ClientCapabilities      ephemeral.OpenClientCapabilities
+	Config                  *tfsdk.Config
+	EphemeralResourceSchema fwschema.Schema
+	EphemeralResource       ephemeral.EphemeralResource
+}
+
+// OpenEphemeralResourceResponse is the framework server response for the
+// OpenEphemeralResource RPC.
+type OpenEphemeralResourceResponse struct {
+	Deferred    *ephemeral.Deferred
+	Result      *tfsdk.EphemeralResultData
+	Private     *privatestate.Data
+	Diagnostics diag.Diagnostics
+	RenewAt     time.Time
+}
+
+// OpenEphemeralResource implements the framework server OpenEphemeralResource RPC.
+func (s *Server) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResourceRequest, resp *OpenEphemeralResourceResponse) {
+	if req == nil {
+		return
+	}
+
+	if s.ProviderDeferred != nil {
+		logging.FrameworkDebug(ctx,
+			"Provider has deferred response configured, automatically returning deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: s.ProviderDeferred.Reason.String(),
+			},
+		)
+
+		// The ephemeral result data is entirely unknown, as no provider
+		// defined logic has been called to determine any values.
+		resp.Result = &tfsdk.EphemeralResultData{
+			Raw:    tftypes.NewValue(req.EphemeralResourceSchema.Type().TerraformType(ctx), tftypes.UnknownValue),
+			Schema: req.EphemeralResourceSchema,
+		}
+		resp.Deferred = &ephemeral.Deferred{
+			Reason: ephemeral.DeferredReason(s.ProviderDeferred.Reason),
+		}
+
+		return
+	}
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithConfigure, Name: "Configure"}, func() {
+			ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	openReq := ephemeral.OpenRequest{
+		ClientCapabilities: req.ClientCapabilities,
+		Config: tfsdk.Config{
+			Schema: req.EphemeralResourceSchema,
+		},
+	}
+	openResp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: req.EphemeralResourceSchema,
+		},
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+
+	if req.Config != nil {
+		openReq.Config = *req.Config
+		openResp.Result.Raw = req.Config.Raw.Copy()
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Open")
+	fwpanic.Call(ctx, &openResp.Diagnostics, fwpanic.Method{Implementation: req.EphemeralResource, Name: "Open"}, func() {
+		req.EphemeralResource.Open(ctx, openReq, &openResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Open")
+
+	resp.Diagnostics = openResp.Diagnostics
+	resp.Result = &openResp.Result
+	resp.RenewAt = openResp.RenewAt
+
+	if openResp.Private != nil {
+		resp.Private = &privatestate.Data{
+			Provider: openResp.Private,
+		}
+	}
+
+	if openResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Ephemeral Resource Response",
+			"Ephemeral resource configured a deferred response for the Open operation but the Terraform request "+
+				"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	resp.Deferred = openResp.Deferred
+
+	if resp.Diagnostics.HasError() || req.Config == nil {
+		return
+	}
+
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionConfiguration,
+			Schema:         req.Config.Schema,
+			TerraformValue: req.Config.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionEphemeralResultData,
+			Schema:         resp.Result.Schema,
+			TerraformValue: resp.Result.Raw.Copy(),
+		},
+	}
+	semanticEqualityResp := &SchemaSemanticEqualityResponse{
+		NewData: semanticEqualityReq.ProposedNewData,
+	}
+
+	SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.Result.Raw) {
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Ephemeral result data updated due to semantic equality")
+
+	resp.Result.Raw = semanticEqualityResp.NewData.TerraformValue
+}
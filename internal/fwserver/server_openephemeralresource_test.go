@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerOpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testConfigValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, nil),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testResultValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, "test-result-value"),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSchemaWithSemanticEquals := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithSemanticEquals{
+					SemanticEquals: true,
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testSchemaWithSemanticEqualsDiagnostics := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithSemanticEquals{
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+						diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw:    testConfigValue,
+		Schema: testSchema,
+	}
+
+	testResultUnchanged := &tfsdk.EphemeralResultData{
+		Raw:    testConfigValue,
+		Schema: testSchema,
+	}
+
+	testResult := &tfsdk.EphemeralResultData{
+		Raw:    testResultValue,
+		Schema: testSchema,
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKey": "pValue"}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.OpenEphemeralResourceRequest
+		expectedResponse *fwserver.OpenEphemeralResourceResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{},
+		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				ClientCapabilities: ephemeral.OpenClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed to be true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				Result:  testResultUnchanged,
+			},
+		},
+		"request-config": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var config struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+						if config.TestRequired.ValueString() != "test-config-value" {
+							resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				Result:  testResultUnchanged,
+			},
+		},
+		"resource-configure-data": {
+			server: &fwserver.Server{
+				EphemeralResourceConfigureData: "test-provider-configure-value",
+				Provider:                       &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					EphemeralResource: &testprovider.EphemeralResource{
+						OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+							// In practice, the Configure method would save the
+							// provider data to the Resource implementation and
+							// use it here. The fact that Configure is able to
+							// read the data proves this can work.
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				Result:  testResultUnchanged,
+			},
+		},
+		"response-deferral-automatic": {
+			server: &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				ProviderDeferred: &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				ClientCapabilities: ephemeral.OpenClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Diagnostics.AddError("Test assertion failed: ", "open shouldn't be called")
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result: &tfsdk.EphemeralResultData{
+					Raw:    tftypes.NewValue(testType, tftypes.UnknownValue),
+					Schema: testSchema,
+				},
+				Deferred: &ephemeral.Deferred{Reason: ephemeral.DeferredReasonProviderConfigUnknown},
+			},
+		},
+		"response-deferral-manual": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				ClientCapabilities: ephemeral.OpenClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Deferred = &ephemeral.Deferred{Reason: ephemeral.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private:  testEmptyPrivate,
+				Result:   testResultUnchanged,
+				Deferred: &ephemeral.Deferred{Reason: ephemeral.DeferredReasonAbsentPrereq},
+			},
+		},
+		"response-deferral-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Deferred = &ephemeral.Deferred{Reason: ephemeral.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Ephemeral Resource Response",
+						"Ephemeral resource configured a deferred response for the Open operation but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Private: testEmptyPrivate,
+				Result:  testResultUnchanged,
+			},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				Private: testEmptyPrivate,
+				Result:  testResultUnchanged,
+			},
+		},
+		"response-diagnostics-semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithSemanticEqualsDiagnostics,
+				},
+				EphemeralResourceSchema: testSchemaWithSemanticEqualsDiagnostics,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var data struct {
+							TestComputed types.String                            `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						data.TestRequired = testtypes.StringValueWithSemanticEquals{
+							SemanticEquals: true,
+							SemanticEqualsDiagnostics: diag.Diagnostics{
+								diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+								diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+							},
+							StringValue: types.StringValue("test-semantic-equal-value"),
+						}
+
+						resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary 1", "test detail 1"),
+					diag.NewErrorDiagnostic("test summary 2", "test detail 2"),
+				},
+				Private: testEmptyPrivate,
+				Result: &tfsdk.EphemeralResultData{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						// The response result data is intentionally not updated when there are diagnostics
+						"test_required": tftypes.NewValue(tftypes.String, "test-semantic-equal-value"),
+					}),
+					Schema: testSchemaWithSemanticEqualsDiagnostics,
+				},
+			},
+		},
+		"response-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						diags := resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKey": "pValue"}`))
+
+						resp.Diagnostics.Append(diags...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+				Result: testResultUnchanged,
+			},
+		},
+		"response-renewat": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.RenewAt = time.Date(2024, 8, 29, 5, 10, 32, 0, time.UTC)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				RenewAt: time.Date(2024, 8, 29, 5, 10, 32, 0, time.UTC),
+				Result:  testResultUnchanged,
+			},
+		},
+		"response-result": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue("test-result-value")
+
+						resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				Result:  testResult,
+			},
+		},
+		"response-result-semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithSemanticEquals,
+				},
+				EphemeralResourceSchema: testSchemaWithSemanticEquals,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var data struct {
+							TestComputed types.String                            `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						// This value should be overwritten back to the config value.
+						data.TestRequired = testtypes.StringValueWithSemanticEquals{
+							SemanticEquals: true,
+							StringValue:    types.StringValue("test-semantic-equal-value"),
+						}
+
+						resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				Result: &tfsdk.EphemeralResultData{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithSemanticEquals,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.OpenEphemeralResourceResponse{}
+			testCase.server.OpenEphemeralResource(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
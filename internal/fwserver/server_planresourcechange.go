@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -20,24 +21,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // PlanResourceChangeRequest is the framework server request for the
 // PlanResourceChange RPC.
 type PlanResourceChangeRequest struct {
-	Config           *tfsdk.Config
-	PriorPrivate     *privatestate.Data
-	PriorState       *tfsdk.State
-	ProposedNewState *tfsdk.Plan
-	ProviderMeta     *tfsdk.Config
-	ResourceSchema   fwschema.Schema
-	Resource         resource.Resource
+	ClientCapabilities resource.ModifyPlanClientCapabilities
+	Config             *tfsdk.Config
+	IdentitySchema     fwschema.Schema
+	PriorIdentity      *tfsdk.ResourceIdentity
+	PriorPrivate       *privatestate.Data
+	PriorState         *tfsdk.State
+	ProposedNewState   *tfsdk.Plan
+	ProviderMeta       *tfsdk.Config
+	ResourceSchema     fwschema.Schema
+	Resource           resource.Resource
 }
 
 // PlanResourceChangeResponse is the framework server response for the
 // PlanResourceChange RPC.
 type PlanResourceChangeResponse struct {
+	Deferred        *resource.Deferred
 	Diagnostics     diag.Diagnostics
+	PlannedIdentity *tfsdk.ResourceIdentity
 	PlannedPrivate  *privatestate.Data
 	PlannedState    *tfsdk.State
 	RequiresReplace path.Paths
@@ -49,25 +56,6 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
-	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
-		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
-
-		configureReq := resource.ConfigureRequest{
-			ProviderData: s.ResourceConfigureData,
-		}
-		configureResp := resource.ConfigureResponse{}
-
-		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
-		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
-
-		resp.Diagnostics.Append(configureResp.Diagnostics...)
-
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
 	nullTfValue := tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil)
 
 	// Prevent potential panics by ensuring incoming Config/Plan/State are null
@@ -107,6 +95,66 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 	}
 
+	// Resource identity cannot change once known, so the prior identity is
+	// always the planned identity.
+	resp.PlannedIdentity = resourceIdentity(ctx, req.PriorIdentity, req.IdentitySchema)
+
+	if s.ProviderDeferred != nil {
+		logging.FrameworkDebug(ctx,
+			"Provider has deferred response configured, automatically returning deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: s.ProviderDeferred.Reason.String(),
+			},
+		)
+
+		resp.PlannedState = planToState(*req.ProposedNewState)
+
+		// Without calling any provider defined logic, the best plan is the
+		// proposed new state with any computed attributes that are null in
+		// the configuration marked as unknown.
+		if !resp.PlannedState.Raw.IsNull() && !resp.PlannedState.Raw.Equal(req.PriorState.Raw) {
+			modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error modifying plan",
+					"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+				)
+
+				return
+			}
+
+			resp.PlannedState.Raw = modifiedPlan
+		}
+
+		resp.Deferred = &resource.Deferred{
+			Reason: resource.DeferredReason(s.ProviderDeferred.Reason),
+		}
+
+		return
+	}
+
+	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
+
+		configureReq := resource.ConfigureRequest{
+			ProviderData: s.ResourceConfigureData,
+		}
+		configureResp := resource.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure"}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
 	// Set Defaults.
@@ -259,10 +307,11 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
 
 		modifyPlanReq := resource.ModifyPlanRequest{
-			Config:  *req.Config,
-			Plan:    stateToPlan(*resp.PlannedState),
-			State:   *req.PriorState,
-			Private: resp.PlannedPrivate.Provider,
+			ClientCapabilities: req.ClientCapabilities,
+			Config:             *req.Config,
+			Plan:               stateToPlan(*resp.PlannedState),
+			State:              *req.PriorState,
+			Private:            resp.PlannedPrivate.Provider,
 		}
 
 		if req.ProviderMeta != nil {
@@ -277,15 +326,44 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		fwpanic.Call(ctx, &modifyPlanResp.Diagnostics, fwpanic.Method{Implementation: resourceWithModifyPlan, Name: "ModifyPlan"}, func() {
+			resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ModifyPlan")
 
 		resp.Diagnostics = modifyPlanResp.Diagnostics
 		resp.PlannedState = planToState(modifyPlanResp.Plan)
 		resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
 		resp.PlannedPrivate.Provider = modifyPlanResp.Private
+
+		if modifyPlanResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+			resp.Diagnostics.AddError(
+				"Invalid Deferred Resource Response",
+				"Resource configured a deferred response for the PlanResourceChange operation but the Terraform request "+
+					"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+			)
+
+			return
+		}
+
+		resp.Deferred = modifyPlanResp.Deferred
 	}
 
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying Plan",
+			"There was an unexpected error nullifying write-only attribute values in the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.PlannedState.Raw = nullifiedPlan
+
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 
@@ -340,6 +418,12 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 				return val, nil
 			}
 
+			if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// ignore attributes/elements inside dynamic values, they have no schema of their own
+				logging.FrameworkTrace(ctx, "attribute is inside a dynamic attribute, not marking unknown")
+				return val, nil
+			}
+
 			if errors.Is(err, fwschema.ErrPathIsBlock) {
 				// ignore blocks, they do not have a computed field
 				logging.FrameworkTrace(ctx, "attribute is a block, not marking unknown")
@@ -362,10 +446,22 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			if a.BoolDefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithDynamicDefaultValue:
+			if a.DynamicDefaultValue() != nil {
+				return val, nil
+			}
+		case fwschema.AttributeWithFloat32DefaultValue:
+			if a.Float32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithFloat64DefaultValue:
 			if a.Float64DefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithInt32DefaultValue:
+			if a.Int32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithInt64DefaultValue:
 			if a.Int64DefaultValue() != nil {
 				return val, nil
@@ -398,7 +494,15 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 
 		logging.FrameworkDebug(ctx, "marking computed attribute that is null in the config as unknown")
 
-		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
+		unknownType := val.Type()
+
+		// The planned value of a dynamic attribute may have a concrete type,
+		// however the type of the final value is only known after apply.
+		if _, ok := attribute.GetType().(basetypes.DynamicTypable); ok {
+			unknownType = tftypes.DynamicPseudoType
+		}
+
+		return tftypes.NewValue(unknownType, tftypes.UnknownValue), nil
 	}
 }
 
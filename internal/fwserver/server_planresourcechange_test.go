@@ -21,10 +21,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -65,6 +68,17 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 				Optional: true,
 				Computed: true,
 			},
+			// nil computed dynamic values should be turned into unknown
+			"dynamic-nil-optional-computed": schema.DynamicAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			// non-nil dynamic values should be left alone, including any
+			// nil elements, as they don't have a schema of their own
+			"dynamic-value-optional-computed": schema.DynamicAttribute{
+				Optional: true,
+				Computed: true,
+			},
 			// nil objects should be unknown
 			"object-nil-optional-computed": schema.ObjectAttribute{
 				AttributeTypes: map[string]attr.Type{
@@ -160,6 +174,11 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 		"string-nil-computed":            tftypes.NewValue(tftypes.String, nil),
 		"string-nil-optional-computed":   tftypes.NewValue(tftypes.String, nil),
 		"string-value-optional-computed": tftypes.NewValue(tftypes.String, "hello, world"),
+		"dynamic-nil-optional-computed":  tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		"dynamic-value-optional-computed": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hello, world"),
+			tftypes.NewValue(tftypes.String, nil),
+		}),
 		"object-nil-optional-computed": tftypes.NewValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
 				"string-nil": tftypes.String,
@@ -223,6 +242,11 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 		"string-nil-computed":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 		"string-nil-optional-computed":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 		"string-value-optional-computed": tftypes.NewValue(tftypes.String, "hello, world"),
+		"dynamic-nil-optional-computed":  tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+		"dynamic-value-optional-computed": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hello, world"),
+			tftypes.NewValue(tftypes.String, nil),
+		}),
 		"object-nil-optional-computed": tftypes.NewValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
 				"string-nil": tftypes.String,
@@ -999,6 +1023,50 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeDynamic := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed":       tftypes.DynamicPseudoType,
+			"test_default":        tftypes.DynamicPseudoType,
+			"test_other_computed": tftypes.DynamicPseudoType,
+			"test_required":       tftypes.DynamicPseudoType,
+		},
+	}
+
+	testDynamicRequiredValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "test-config-value"),
+		tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testSchemaAttributePlanModifierAttributePlanDynamic := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.DynamicAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					testplanmodifier.Dynamic{
+						PlanModifyDynamicMethod: func(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+							if !req.ConfigValue.IsNull() {
+								resp.Diagnostics.AddError("Unexpected req.ConfigValue", "expected null, got "+req.ConfigValue.String())
+							}
+
+							resp.PlanValue = types.DynamicValue(types.StringValue("test-attributeplanmodifier-value"))
+						},
+					},
+				},
+			},
+			"test_default": schema.DynamicAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  dynamicdefault.StaticDynamic(types.DynamicValue(types.StringValue("test-default-value"))),
+			},
+			"test_other_computed": schema.DynamicAttribute{
+				Computed: true,
+			},
+			"test_required": schema.DynamicAttribute{
+				Required: true,
+			},
+		},
+	}
+
 	testSchemaAttributePlanModifierAttributePlanCustomType := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -1156,6 +1224,23 @@ func TestServerPlanResourceChange(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentityType := testIdentitySchema.Type().TerraformType(context.Background())
+
+	testPriorIdentity := &tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+			"test_id": tftypes.NewValue(tftypes.String, "id-123"),
+		}),
+		Schema: testIdentitySchema,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.PlanResourceChangeRequest
@@ -1222,6 +1307,179 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed to be true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-deferral-automatic": {
+			server: &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				ProviderDeferred: &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.Diagnostics.AddError("Test assertion failed: ", "ModifyPlan shouldn't be called")
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{Reason: resource.DeferredReasonProviderConfigUnknown},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-deferral-manual": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-deferral-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Resource Response",
+						"Resource configured a deferred response for the PlanResourceChange operation but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-mark-computed-config-nils-as-unknown": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -1256,6 +1514,88 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-plannedidentity-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				IdentitySchema: testIdentitySchema,
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedIdentity: &tfsdk.ResourceIdentity{
+					Raw:    tftypes.NewValue(testIdentityType, nil),
+					Schema: testIdentitySchema,
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-plannedidentity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				IdentitySchema: testIdentitySchema,
+				PriorIdentity:  testPriorIdentity,
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedIdentity: testPriorIdentity,
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-set-default-values": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -2519,6 +2859,49 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-attributeplanmodifier-response-attributeplan-dynamic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeDynamic, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_default":        tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_required":       testDynamicRequiredValue,
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlanDynamic,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeDynamic, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_default":        tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_required":       testDynamicRequiredValue,
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlanDynamic,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeDynamic, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlanDynamic,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlanDynamic,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeDynamic, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_default":        tftypes.NewValue(tftypes.String, "test-default-value"),
+						"test_other_computed": tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+						"test_required":       testDynamicRequiredValue,
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlanDynamic,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-attributeplanmodifier-response-attributeplan-custom-type": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -6,26 +6,33 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // ReadDataSourceRequest is the framework server request for the
 // ReadDataSource RPC.
 type ReadDataSourceRequest struct {
-	Config           *tfsdk.Config
-	DataSourceSchema fwschema.Schema
-	DataSource       datasource.DataSource
-	ProviderMeta     *tfsdk.Config
+	ClientCapabilities datasource.ReadClientCapabilities
+	Config             *tfsdk.Config
+	DataSourceSchema   fwschema.Schema
+	DataSource         datasource.DataSource
+	ProviderMeta       *tfsdk.Config
+	TypeName           string
 }
 
 // ReadDataSourceResponse is the framework server response for the
 // ReadDataSource RPC.
 type ReadDataSourceResponse struct {
+	Deferred    *datasource.Deferred
 	Diagnostics diag.Diagnostics
 	State       *tfsdk.State
 }
@@ -36,6 +43,27 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	if s.ProviderDeferred != nil {
+		logging.FrameworkDebug(ctx,
+			"Provider has deferred response configured, automatically returning deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: s.ProviderDeferred.Reason.String(),
+			},
+		)
+
+		// The data source state is entirely unknown, as no provider defined
+		// logic has been called to determine any values.
+		resp.State = &tfsdk.State{
+			Raw:    tftypes.NewValue(req.DataSourceSchema.Type().TerraformType(ctx), tftypes.UnknownValue),
+			Schema: req.DataSourceSchema,
+		}
+		resp.Deferred = &datasource.Deferred{
+			Reason: datasource.DeferredReason(s.ProviderDeferred.Reason),
+		}
+
+		return
+	}
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -45,7 +73,9 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: dataSourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -56,6 +86,7 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	}
 
 	readReq := datasource.ReadRequest{
+		ClientCapabilities: req.ClientCapabilities,
 		Config: tfsdk.Config{
 			Schema: req.DataSourceSchema,
 		},
@@ -75,17 +106,38 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		readReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	privateData := s.dataSourcePrivateDataCopy(ctx, req.TypeName)
+
+	readReq.Private = privateData
+	readResp.Private = privateData
+
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSource Read")
-	req.DataSource.Read(ctx, readReq, &readResp)
+	fwpanic.Call(ctx, &readResp.Diagnostics, fwpanic.Method{Implementation: req.DataSource, Name: "Read", TypeName: req.TypeName}, func() {
+		req.DataSource.Read(ctx, readReq, &readResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined DataSource Read")
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
 
+	if readResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Data Source Response",
+			"Data source configured a deferred response for the Read operation but the Terraform request "+
+				"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	resp.Deferred = readResp.Deferred
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	s.setDataSourcePrivateData(ctx, req.TypeName, readResp.Private)
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionConfiguration,
@@ -118,3 +170,41 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 
 	resp.State.Raw = semanticEqualityResp.NewData.TerraformValue
 }
+
+// dataSourcePrivateDataCopy returns a copy of the private data saved for the
+// data source type name, so the provider cannot modify the saved data while
+// other reads of the same type name are in progress.
+func (s *Server) dataSourcePrivateDataCopy(ctx context.Context, typeName string) *privatestate.ProviderData {
+	s.dataSourcePrivateDataMutex.Lock()
+	defer s.dataSourcePrivateDataMutex.Unlock()
+
+	return copyProviderData(ctx, s.dataSourcePrivateData[typeName])
+}
+
+// setDataSourcePrivateData saves a copy of the private data for the data
+// source type name for the next ReadDataSource RPC.
+func (s *Server) setDataSourcePrivateData(ctx context.Context, typeName string, data *privatestate.ProviderData) {
+	s.dataSourcePrivateDataMutex.Lock()
+	defer s.dataSourcePrivateDataMutex.Unlock()
+
+	if s.dataSourcePrivateData == nil {
+		s.dataSourcePrivateData = make(map[string]*privatestate.ProviderData)
+	}
+
+	s.dataSourcePrivateData[typeName] = copyProviderData(ctx, data)
+}
+
+// copyProviderData returns a copy of the given ProviderData, or empty
+// ProviderData if it is nil.
+func copyProviderData(ctx context.Context, data *privatestate.ProviderData) *privatestate.ProviderData {
+	dataCopy := privatestate.EmptyProviderData(ctx)
+
+	for _, key := range data.Keys(ctx) {
+		// Keys were validated when they were set.
+		value, _ := data.GetKey(ctx, key)
+
+		_ = dataCopy.SetKey(ctx, key, value)
+	}
+
+	return dataCopy
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -109,6 +110,28 @@ func TestServerReadDataSource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ReadDataSourceResponse{},
 		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed to be true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
 		"request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -203,6 +226,76 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"response-deferral-automatic": {
+			server: &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				ProviderDeferred: &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Diagnostics.AddError("Test assertion failed: ", "read shouldn't be called")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: &tfsdk.State{
+					Raw:    tftypes.NewValue(testType, tftypes.UnknownValue),
+					Schema: testSchema,
+				},
+				Deferred: &datasource.Deferred{Reason: datasource.DeferredReasonProviderConfigUnknown},
+			},
+		},
+		"response-deferral-manual": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State:    testStateUnchanged,
+				Deferred: &datasource.Deferred{Reason: datasource.DeferredReasonAbsentPrereq},
+			},
+		},
+		"response-deferral-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonAbsentPrereq}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Data Source Response",
+						"Data source configured a deferred response for the Read operation but the Terraform request "+
+							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				State: testStateUnchanged,
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -366,3 +459,123 @@ func TestServerReadDataSource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadDataSource_Private(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	// Each step reads the data source with the same server, in order, to
+	// verify the private data saved by prior steps.
+	testSteps := []struct {
+		name                string
+		typeName            string
+		setValue            []byte
+		setKey              string
+		addError            bool
+		expectedValue       []byte
+		expectedDiagnostics diag.Diagnostics
+	}{
+		{
+			name:     "first-read",
+			typeName: "test_data_source",
+			setKey:   "etag",
+			setValue: []byte(`"one"`),
+		},
+		{
+			name:          "same-type-name",
+			typeName:      "test_data_source",
+			setKey:        "etag",
+			setValue:      []byte(`"two"`),
+			addError:      true,
+			expectedValue: []byte(`"one"`),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		{
+			name:          "error-not-saved",
+			typeName:      "test_data_source",
+			expectedValue: []byte(`"one"`),
+		},
+		{
+			name:     "other-type-name",
+			typeName: "test_other_data_source",
+		},
+		{
+			name:     "invalid-key",
+			typeName: "test_data_source",
+			setKey:   ".framework",
+			setValue: []byte(`"one"`),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						"The key \".framework\" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.",
+				),
+			},
+			expectedValue: []byte(`"one"`),
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	for _, testStep := range testSteps {
+		var got []byte
+
+		request := &fwserver.ReadDataSourceRequest{
+			Config:           testConfig,
+			DataSourceSchema: testSchema,
+			DataSource: &testprovider.DataSource{
+				ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+					var diags diag.Diagnostics
+
+					got, diags = req.Private.GetKey(ctx, "etag")
+
+					resp.Diagnostics.Append(diags...)
+
+					if testStep.setKey != "" {
+						resp.Diagnostics.Append(resp.Private.SetKey(ctx, testStep.setKey, testStep.setValue)...)
+					}
+
+					if testStep.addError {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					}
+				},
+			},
+			TypeName: testStep.typeName,
+		}
+		response := &fwserver.ReadDataSourceResponse{}
+
+		server.ReadDataSource(context.Background(), request, response)
+
+		if diff := cmp.Diff(response.Diagnostics, testStep.expectedDiagnostics); diff != "" {
+			t.Errorf("%s: unexpected diagnostics difference: %s", testStep.name, diff)
+		}
+
+		if diff := cmp.Diff(got, testStep.expectedValue); diff != "" {
+			t.Errorf("%s: unexpected private data difference: %s", testStep.name, diff)
+		}
+	}
+}
@@ -6,7 +6,11 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -17,16 +21,22 @@ import (
 // ReadResourceRequest is the framework server request for the
 // ReadResource RPC.
 type ReadResourceRequest struct {
-	CurrentState *tfsdk.State
-	Resource     resource.Resource
-	Private      *privatestate.Data
-	ProviderMeta *tfsdk.Config
+	ClientCapabilities resource.ReadClientCapabilities
+	CurrentIdentity    *tfsdk.ResourceIdentity
+	CurrentState       *tfsdk.State
+	IdentitySchema     fwschema.Schema
+	Resource           resource.Resource
+	Private            *privatestate.Data
+	ProviderMeta       *tfsdk.Config
+	TypeName           string
 }
 
 // ReadResourceResponse is the framework server response for the
 // ReadResource RPC.
 type ReadResourceResponse struct {
+	Deferred    *resource.Deferred
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -47,6 +57,24 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	if s.ProviderDeferred != nil {
+		logging.FrameworkDebug(ctx,
+			"Provider has deferred response configured, automatically returning deferred response",
+			map[string]interface{}{
+				logging.KeyDeferredReason: s.ProviderDeferred.Reason.String(),
+			},
+		)
+
+		resp.NewState = req.CurrentState
+		resp.NewIdentity = req.CurrentIdentity
+		resp.Private = req.Private
+		resp.Deferred = &resource.Deferred{
+			Reason: resource.DeferredReason(s.ProviderDeferred.Reason),
+		}
+
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -56,7 +84,9 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -67,16 +97,19 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 	}
 
 	readReq := resource.ReadRequest{
+		ClientCapabilities: req.ClientCapabilities,
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
 			Raw:    req.CurrentState.Raw.Copy(),
 		},
+		Identity: resourceIdentity(ctx, req.CurrentIdentity, req.IdentitySchema),
 	}
 	readResp := resource.ReadResponse{
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
 			Raw:    req.CurrentState.Raw.Copy(),
 		},
+		Identity: resourceIdentity(ctx, req.CurrentIdentity, req.IdentitySchema),
 	}
 
 	if req.ProviderMeta != nil {
@@ -97,12 +130,40 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
+	releaseConcurrency, diags := s.acquireResourceConcurrency(ctx, req.TypeName, req.Resource)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer releaseConcurrency()
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
-	req.Resource.Read(ctx, readReq, &readResp)
+	fwpanic.Call(ctx, &readResp.Diagnostics, fwpanic.Method{Implementation: req.Resource, Name: "Read", TypeName: req.TypeName}, func() {
+		req.Resource.Read(ctx, readReq, &readResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
+	resp.NewIdentity = readResp.Identity
+
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedState, err := tftypes.Transform(resp.NewState.Raw, NullifyWriteOnlyAttributes(ctx, resp.NewState.Schema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State",
+			"There was an unexpected error nullifying write-only attribute values in the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewState.Raw = nullifiedState
 
 	if readResp.Private != nil {
 		if resp.Private == nil {
@@ -112,10 +173,41 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private.Provider = readResp.Private
 	}
 
+	if readResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Resource Response",
+			"Resource configured a deferred response for the Read operation but the Terraform request "+
+				"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	resp.Deferred = readResp.Deferred
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Resource identity is only required while the resource still exists.
+	if req.IdentitySchema != nil && !resp.NewState.Raw.IsNull() {
+		if resourceIdentityIsNull(resp.NewIdentity) {
+			resp.Diagnostics.AddError(
+				"Missing Resource Identity After Read",
+				"The Terraform Provider unexpectedly returned no resource identity after having no errors in the resource read. "+
+					"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(validateResourceIdentityUnchanged("read", req.CurrentIdentity, resp.NewIdentity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionState,
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// RenewEphemeralResourceRequest is the framework server request for the
+// RenewEphemeralResource RPC.
+type RenewEphemeralResourceRequest struct {
+	Private           *privatestate.Data
+	EphemeralResource ephemeral.EphemeralResource
+}
+
+// RenewEphemeralResourceResponse is the framework server response for the
+// RenewEphemeralResource RPC.
+type RenewEphemeralResourceResponse struct {
+	Private     *privatestate.Data
+	Diagnostics diag.Diagnostics
+	RenewAt     time.Time
+}
+
+// RenewEphemeralResource implements the framework server RenewEphemeralResource RPC.
+func (s *Server) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResourceRequest, resp *RenewEphemeralResourceResponse) {
+	if req == nil {
+		return
+	}
+
+	ephemeralResourceWithRenew, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithRenew)
+
+	if !ok {
+		// Terraform only calls Renew when a RenewAt time was returned from a
+		// prior Open or Renew call, so this is a provider implementation issue.
+		resp.Diagnostics.AddError(
+			"Ephemeral Resource Renew Not Implemented",
+			"An ephemeral resource RenewAt time was set, however the ephemeral resource does not implement the Renew method. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithRenew")
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithConfigure, Name: "Configure"}, func() {
+			ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	privateProviderData := privatestate.EmptyProviderData(ctx)
+
+	renewReq := ephemeral.RenewRequest{
+		Private: privateProviderData,
+	}
+	renewResp := ephemeral.RenewResponse{
+		Private: privateProviderData,
+	}
+
+	if req.Private != nil {
+		if req.Private.Provider != nil {
+			renewReq.Private = req.Private.Provider
+			renewResp.Private = req.Private.Provider
+		}
+
+		resp.Private = req.Private
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Renew")
+	fwpanic.Call(ctx, &renewResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithRenew, Name: "Renew"}, func() {
+		ephemeralResourceWithRenew.Renew(ctx, renewReq, &renewResp)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Renew")
+
+	resp.Diagnostics = renewResp.Diagnostics
+	resp.RenewAt = renewResp.RenewAt
+
+	if renewResp.Private != nil {
+		if resp.Private == nil {
+			resp.Private = &privatestate.Data{}
+		}
+
+		resp.Private.Provider = renewResp.Private
+	}
+}
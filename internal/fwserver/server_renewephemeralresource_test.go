@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerRenewEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKey": "pValue"}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.RenewEphemeralResourceRequest
+		expectedResponse *fwserver.RenewEphemeralResourceResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{},
+		},
+		"renew-not-implemented": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResource{},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Ephemeral Resource Renew Not Implemented",
+						"An ephemeral resource RenewAt time was set, however the ephemeral resource does not implement the Renew method. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"request-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						expected := `{"pKey": "pValue"}`
+
+						key := "providerKeyOne"
+
+						got, diags := req.Private.GetKey(ctx, key)
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"response-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						diags := resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKey": "pValue"}`))
+
+						resp.Diagnostics.Append(diags...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+		},
+		"response-renewat": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					EphemeralResource: &testprovider.EphemeralResource{},
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						resp.RenewAt = time.Date(2024, 8, 29, 5, 10, 32, 0, time.UTC)
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+				RenewAt: time.Date(2024, 8, 29, 5, 10, 32, 0, time.UTC),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.RenewEphemeralResourceResponse{}
+			testCase.server.RenewEphemeralResource(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// acquireResourceConcurrency waits until the concurrency limit of the managed
+// resource type allows another Create, Read, Update, or Delete call. The
+// returned function must be called to release the slot once the call
+// returns. If the context is cancelled while waiting, such as when Terraform
+// calls the StopProvider RPC, an error diagnostic is returned.
+func (s *Server) acquireResourceConcurrency(ctx context.Context, typeName string, r resource.Resource) (func(), diag.Diagnostics) {
+	semaphore, diags := s.resourceConcurrencySemaphore(ctx, typeName, r)
+
+	if diags.HasError() || semaphore == nil {
+		return func() {}, diags
+	}
+
+	logFields := map[string]interface{}{
+		logging.KeyConcurrencyLimit: cap(semaphore),
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, diags
+	default:
+	}
+
+	logging.FrameworkDebug(ctx, "Waiting for resource type concurrency limit", logFields)
+
+	select {
+	case semaphore <- struct{}{}:
+		logging.FrameworkDebug(ctx, "Acquired resource type concurrency limit", logFields)
+
+		return func() { <-semaphore }, diags
+	case <-ctx.Done():
+		logging.FrameworkDebug(ctx, "Cancelled waiting for resource type concurrency limit", logFields)
+
+		diags.AddError(
+			"Resource Concurrency Limit Wait Cancelled",
+			fmt.Sprintf("The request was cancelled while waiting for other %s operations to complete, ", typeName)+
+				fmt.Sprintf("since the resource type allows %d concurrent operations. ", cap(semaphore))+
+				"This can occur when Terraform is interrupted.\n\n"+
+				fmt.Sprintf("Error: %s", ctx.Err()),
+		)
+
+		return func() {}, diags
+	}
+}
+
+// resourceConcurrencySemaphore returns the cached concurrency limiting
+// semaphore of the managed resource type, determining the limit on first
+// use. A nil semaphore means the resource type is not limited.
+func (s *Server) resourceConcurrencySemaphore(ctx context.Context, typeName string, r resource.Resource) (chan struct{}, diag.Diagnostics) {
+	s.resourceConcurrencySemaphoresMutex.Lock()
+	defer s.resourceConcurrencySemaphoresMutex.Unlock()
+
+	if semaphore, ok := s.resourceConcurrencySemaphores[typeName]; ok {
+		return semaphore, nil
+	}
+
+	var diags diag.Diagnostics
+
+	limit := s.ResourceConcurrencyLimit
+
+	if resourceWithConcurrencyLimit, ok := r.(resource.ResourceWithConcurrencyLimit); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConcurrencyLimit")
+
+		limitReq := resource.ConcurrencyLimitRequest{}
+		limitResp := resource.ConcurrencyLimitResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource ConcurrencyLimit")
+		fwpanic.Call(ctx, &limitResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConcurrencyLimit, Name: "ConcurrencyLimit", TypeName: typeName}, func() {
+			resourceWithConcurrencyLimit.ConcurrencyLimit(ctx, limitReq, &limitResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource ConcurrencyLimit")
+
+		diags.Append(limitResp.Diagnostics...)
+
+		// Do not cache errors, so the next call can try again.
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		limit = limitResp.Limit
+	}
+
+	var semaphore chan struct{}
+
+	if limit > 0 {
+		semaphore = make(chan struct{}, limit)
+	}
+
+	if s.resourceConcurrencySemaphores == nil {
+		s.resourceConcurrencySemaphores = make(map[string]chan struct{})
+	}
+
+	s.resourceConcurrencySemaphores[typeName] = semaphore
+
+	return semaphore, diags
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestServerResourceConcurrencySemaphore(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server        *Server
+		resource      resource.Resource
+		expectedLimit int
+		expectedDiags diag.Diagnostics
+	}{
+		"unlimited": {
+			server:   &Server{},
+			resource: &testprovider.Resource{},
+		},
+		"server-default": {
+			server: &Server{
+				ResourceConcurrencyLimit: 2,
+			},
+			resource:      &testprovider.Resource{},
+			expectedLimit: 2,
+		},
+		"resource-limit": {
+			server: &Server{
+				ResourceConcurrencyLimit: 2,
+			},
+			resource: &testprovider.ResourceWithConcurrencyLimit{
+				Resource: &testprovider.Resource{},
+				ConcurrencyLimitMethod: func(_ context.Context, _ resource.ConcurrencyLimitRequest, resp *resource.ConcurrencyLimitResponse) {
+					resp.Limit = 1
+				},
+			},
+			expectedLimit: 1,
+		},
+		"resource-unlimited": {
+			server: &Server{
+				ResourceConcurrencyLimit: 2,
+			},
+			resource: &testprovider.ResourceWithConcurrencyLimit{
+				Resource: &testprovider.Resource{},
+				ConcurrencyLimitMethod: func(_ context.Context, _ resource.ConcurrencyLimitRequest, resp *resource.ConcurrencyLimitResponse) {
+					resp.Limit = 0
+				},
+			},
+		},
+		"resource-diagnostics": {
+			server: &Server{},
+			resource: &testprovider.ResourceWithConcurrencyLimit{
+				Resource: &testprovider.Resource{},
+				ConcurrencyLimitMethod: func(_ context.Context, _ resource.ConcurrencyLimitRequest, resp *resource.ConcurrencyLimitResponse) {
+					resp.Diagnostics.AddError("error summary", "error detail")
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			semaphore, diags := testCase.server.resourceConcurrencySemaphore(context.Background(), "test_resource", testCase.resource)
+
+			if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedLimit, cap(semaphore)); diff != "" {
+				t.Errorf("unexpected limit difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerAcquireResourceConcurrency(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		ResourceConcurrencyLimit: 1,
+	}
+
+	release, diags := server.acquireResourceConcurrency(context.Background(), "test_resource", &testprovider.Resource{})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Other resource types are not limited by this resource type.
+	releaseOther, diags := server.acquireResourceConcurrency(context.Background(), "test_other", &testprovider.Resource{})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	releaseOther()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, diags = server.acquireResourceConcurrency(ctx, "test_resource", &testprovider.Resource{})
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Concurrency Limit Wait Cancelled",
+			"The request was cancelled while waiting for other test_resource operations to complete, "+
+				"since the resource type allows 1 concurrent operations. "+
+				"This can occur when Terraform is interrupted.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	release()
+
+	release, diags = server.acquireResourceConcurrency(ctx, "test_resource", &testprovider.Resource{})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics after release: %v", diags)
+	}
+
+	release()
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+)
+
+// StopProvider implements the framework server handling of the StopProvider
+// RPC, after the protocol specific implementation has cancelled in-flight
+// request contexts.
+func (s *Server) StopProvider(ctx context.Context) {
+	reporter, ok := s.MetricsReporter.(metrics.ReporterWithStopProvider)
+
+	if !ok {
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined MetricsReporter StopProvider")
+	reporter.StopProvider(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined MetricsReporter StopProvider")
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,19 +22,23 @@ import (
 // UpdateResourceRequest is the framework server request for an update request
 // with the ApplyResourceChange RPC.
 type UpdateResourceRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config          *tfsdk.Config
+	IdentitySchema  fwschema.Schema
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PlannedState    *tfsdk.Plan
+	PriorState      *tfsdk.State
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
+	TypeName        string
 }
 
 // UpdateResourceResponse is the framework server response for an update request
 // with the ApplyResourceChange RPC.
 type UpdateResourceResponse struct {
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -53,7 +59,9 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure", TypeName: req.TypeName}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -78,12 +86,15 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    nullSchemaData,
 		},
+		Identity: resourceIdentity(ctx, req.PlannedIdentity, req.IdentitySchema),
 	}
 	updateResp := resource.UpdateResponse{
 		State: tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    nullSchemaData,
 		},
+		// Require explicit provider updates for tracking identity changes.
+		Identity: resourceIdentity(ctx, req.PlannedIdentity, req.IdentitySchema),
 	}
 
 	if req.Config != nil {
@@ -118,12 +129,35 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
+	releaseConcurrency, diags := s.acquireResourceConcurrency(ctx, req.TypeName, req.Resource)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer releaseConcurrency()
+
+	// Track deadlines from the resource/timeouts package for diagnostics.
+	ctx, timeoutsTracker := fwtimeouts.ContextWithTracker(ctx)
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Update")
-	req.Resource.Update(ctx, updateReq, &updateResp)
+	fwpanic.Call(ctx, &updateResp.Diagnostics, fwpanic.Method{Implementation: req.Resource, Name: "Update", TypeName: req.TypeName}, func() {
+		req.Resource.Update(ctx, updateReq, &updateResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Update")
 
 	resp.Diagnostics = updateResp.Diagnostics
+
+	// Only explain errors, since a successful response after the deadline
+	// still means the remote object was changed and must be saved.
+	if updateResp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(timeoutExceededDiagnostics(timeoutsTracker)...)
+	}
+
 	resp.NewState = &updateResp.State
+	resp.NewIdentity = updateResp.Identity
 
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
 		resp.Diagnostics.AddError(
@@ -133,6 +167,33 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		)
 	}
 
+	if !resp.Diagnostics.HasError() && req.IdentitySchema != nil {
+		if resourceIdentityIsNull(resp.NewIdentity) {
+			resp.Diagnostics.AddError(
+				"Missing Resource Identity After Update",
+				"The Terraform Provider unexpectedly returned no resource identity after having no errors in the resource update. "+
+					"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+			)
+		} else {
+			resp.Diagnostics.Append(validateResourceIdentityUnchanged("update", req.PlannedIdentity, resp.NewIdentity)...)
+		}
+	}
+
+	// Terraform requires that write-only attribute values are never
+	// persisted, regardless of what the provider returned.
+	nullifiedState, err := tftypes.Transform(resp.NewState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State",
+			"There was an unexpected error nullifying write-only attribute values in the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewState.Raw = nullifiedState
+
 	if updateResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
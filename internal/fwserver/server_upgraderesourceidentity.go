@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// UpgradeResourceIdentityRequest is the framework server request for the
+// UpgradeResourceIdentity RPC.
+type UpgradeResourceIdentityRequest struct {
+	RawIdentity *tfprotov6.RawState
+
+	IdentitySchema fwschema.Schema
+	Resource       resource.Resource
+	Version        int64
+}
+
+// UpgradeResourceIdentityResponse is the framework server response for the
+// UpgradeResourceIdentity RPC.
+type UpgradeResourceIdentityResponse struct {
+	Diagnostics      diag.Diagnostics
+	UpgradedIdentity *tfsdk.ResourceIdentity
+}
+
+// UpgradeResourceIdentity implements the framework server
+// UpgradeResourceIdentity RPC.
+func (s *Server) UpgradeResourceIdentity(ctx context.Context, req *UpgradeResourceIdentityRequest, resp *UpgradeResourceIdentityResponse) {
+	if req == nil {
+		return
+	}
+
+	// No UpgradedIdentity to return. This could return an error diagnostic
+	// about the odd scenario, but seems best to allow Terraform CLI to handle
+	// the situation itself in case it might be expected behavior.
+	if req.RawIdentity == nil {
+		return
+	}
+
+	if req.IdentitySchema == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			"This resource was implemented without an IdentitySchema() method, "+
+				"however Terraform was expecting an identity schema to upgrade the resource identity.\n\n"+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	// Define options to be used when unmarshalling raw identity.
+	// IgnoreUndefinedAttributes will silently skip over fields in the JSON
+	// that do not have a matching entry in the schema.
+	unmarshalOpts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	// Terraform CLI can call UpgradeResourceIdentity even if the stored
+	// identity version matches the current identity schema. When this
+	// happens, the framework will attempt to roundtrip the prior RawIdentity
+	// to a ResourceIdentity matching the current identity schema.
+	if req.Version == req.IdentitySchema.GetVersion() {
+		logging.FrameworkTrace(ctx, "UpgradeResourceIdentity request version matches current identity Schema version, using framework defined passthrough implementation")
+
+		identitySchemaType := req.IdentitySchema.Type().TerraformType(ctx)
+
+		rawIdentityValue, err := req.RawIdentity.UnmarshalWithOpts(identitySchemaType, unmarshalOpts)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved Identity for UpgradeResourceIdentity",
+				"There was an error reading the saved resource identity using the current resource identity schema.\n\n"+
+					"If you manually modified the resource identity, you will need to manually modify it to match the current resource identity schema. "+
+					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		resp.UpgradedIdentity = &tfsdk.ResourceIdentity{
+			Schema: req.IdentitySchema,
+			Raw:    rawIdentityValue,
+		}
+
+		return
+	}
+
+	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
+
+		configureReq := resource.ConfigureRequest{
+			ProviderData: s.ResourceConfigureData,
+		}
+		configureResp := resource.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure"}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resourceWithUpgradeIdentity, ok := req.Resource.(resource.ResourceWithUpgradeIdentity)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			"This resource was implemented without an UpgradeIdentity() method, "+
+				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeIdentity")
+
+	var resourceIdentityUpgraders map[int64]resource.IdentityUpgrader
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeIdentity")
+	recovered := fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: resourceWithUpgradeIdentity, Name: "UpgradeIdentity"}, func() {
+		resourceIdentityUpgraders = resourceWithUpgradeIdentity.UpgradeIdentity(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeIdentity")
+
+	if recovered {
+		return
+	}
+
+	// Panic prevention
+	if resourceIdentityUpgraders == nil {
+		resourceIdentityUpgraders = make(map[int64]resource.IdentityUpgrader, 0)
+	}
+
+	resourceIdentityUpgrader, ok := resourceIdentityUpgraders[req.Version]
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			"This resource was implemented with an UpgradeIdentity() method, "+
+				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	upgradeIdentityRequest := resource.UpgradeIdentityRequest{
+		RawIdentity: req.RawIdentity,
+	}
+
+	if resourceIdentityUpgrader.PriorSchema != nil {
+		logging.FrameworkTrace(ctx, "Initializing populated UpgradeIdentityRequest identity from provider defined prior schema and request RawIdentity")
+
+		priorSchemaType := resourceIdentityUpgrader.PriorSchema.Type().TerraformType(ctx)
+
+		rawIdentityValue, err := req.RawIdentity.UnmarshalWithOpts(priorSchemaType, unmarshalOpts)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved Identity for UpgradeResourceIdentity",
+				fmt.Sprintf("There was an error reading the saved resource identity using the prior resource identity schema defined for version %d upgrade.\n\n", req.Version)+
+					"Please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		upgradeIdentityRequest.Identity = &tfsdk.ResourceIdentity{
+			Raw:    rawIdentityValue,
+			Schema: *resourceIdentityUpgrader.PriorSchema,
+		}
+	}
+
+	upgradeIdentityResponse := resource.UpgradeIdentityResponse{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: req.IdentitySchema,
+			// Raw is intentionally not set.
+		},
+	}
+
+	if resourceIdentityUpgrader.IdentityUpgrader == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			"This resource was implemented with an UpgradeIdentity() method, "+
+				fmt.Sprintf("however the IdentityUpgrader for version %d upgrade was not defined.\n\n", req.Version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined IdentityUpgrader")
+	fwpanic.Call(ctx, &upgradeIdentityResponse.Diagnostics, fwpanic.Method{Implementation: resourceIdentityUpgrader, Name: "IdentityUpgrader"}, func() {
+		resourceIdentityUpgrader.IdentityUpgrader(ctx, upgradeIdentityRequest, &upgradeIdentityResponse)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined IdentityUpgrader")
+
+	resp.Diagnostics.Append(upgradeIdentityResponse.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if resourceIdentityIsNull(upgradeIdentityResponse.Identity) {
+		resp.Diagnostics.AddError(
+			"Missing Upgraded Resource Identity",
+			fmt.Sprintf("After attempting a resource identity upgrade to version %d, the provider did not return any identity data. ", req.Version)+
+				"Preventing the unexpected loss of resource identity data. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	resp.UpgradedIdentity = upgradeIdentityResponse.Identity
+}
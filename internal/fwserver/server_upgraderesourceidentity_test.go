@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerUpgradeResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"region": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+		},
+		Version: 1, // Must be above 0
+	}
+	identitySchemaType := testIdentitySchema.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.UpgradeResourceIdentityRequest
+		expectedResponse *fwserver.UpgradeResourceIdentityResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{},
+		},
+		"RawIdentity-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				IdentitySchema: testIdentitySchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{},
+		},
+		"IdentitySchema-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				Resource: &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"This resource was implemented without an IdentitySchema() method, "+
+							"however Terraform was expecting an identity schema to upgrade the resource identity.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"IdentityUpgrader": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"name": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndUpgradeIdentity{
+					Resource: &testprovider.Resource{},
+					UpgradeIdentityMethod: func(ctx context.Context) map[int64]resource.IdentityUpgrader {
+						return map[int64]resource.IdentityUpgrader{
+							0: {
+								PriorSchema: &identityschema.Schema{
+									Attributes: map[string]identityschema.Attribute{
+										"name": identityschema.StringAttribute{
+											RequiredForImport: true,
+										},
+									},
+								},
+								IdentityUpgrader: func(ctx context.Context, req resource.UpgradeIdentityRequest, resp *resource.UpgradeIdentityResponse) {
+									var name types.String
+
+									resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &name)...)
+
+									if resp.Diagnostics.HasError() {
+										return
+									}
+
+									upgradedIdentityData := struct {
+										Id     types.String `tfsdk:"id"`
+										Region types.String `tfsdk:"region"`
+									}{
+										Id:     name,
+										Region: types.StringNull(),
+									}
+
+									resp.Diagnostics.Append(resp.Identity.Set(ctx, upgradedIdentityData)...)
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(identitySchemaType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id-value"),
+						"region": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testIdentitySchema,
+				},
+			},
+		},
+		"IdentityUpgrader-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndUpgradeIdentity{
+					Resource: &testprovider.Resource{},
+					UpgradeIdentityMethod: func(ctx context.Context) map[int64]resource.IdentityUpgrader {
+						return map[int64]resource.IdentityUpgrader{
+							0: {},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"This resource was implemented with an UpgradeIdentity() method, "+
+							"however the IdentityUpgrader for version 0 upgrade was not defined.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"IdentityUpgrader-missing-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndUpgradeIdentity{
+					Resource: &testprovider.Resource{},
+					UpgradeIdentityMethod: func(ctx context.Context) map[int64]resource.IdentityUpgrader {
+						return map[int64]resource.IdentityUpgrader{
+							0: {
+								IdentityUpgrader: func(ctx context.Context, req resource.UpgradeIdentityRequest, resp *resource.UpgradeIdentityResponse) {
+									// Purposfully not setting resp.Identity
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Upgraded Resource Identity",
+						"After attempting a resource identity upgrade to version 0, the provider did not return any identity data. "+
+							"Preventing the unexpected loss of resource identity data. "+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"UpgradeIdentity-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+				Version:        0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"This resource was implemented without an UpgradeIdentity() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"Version-current-json-match": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+				Version:        1, // Must match current identity schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(identitySchemaType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id-value"),
+						"region": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testIdentitySchema,
+				},
+			},
+		},
+		"Version-current-json-mismatch": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id":                    "test-id-value",
+					"nonexistent_attribute": "value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource:       &testprovider.ResourceWithIdentity{},
+				Version:        1, // Must match current identity schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(identitySchemaType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id-value"),
+						"region": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testIdentitySchema,
+				},
+			},
+		},
+		"Version-not-implemented": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				IdentitySchema: testIdentitySchema,
+				Resource: &testprovider.ResourceWithIdentityAndUpgradeIdentity{
+					Resource: &testprovider.Resource{},
+					UpgradeIdentityMethod: func(ctx context.Context) map[int64]resource.IdentityUpgrader {
+						return nil
+					},
+				},
+				Version: 999,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"This resource was implemented with an UpgradeIdentity() method, "+
+							"however Terraform was expecting an implementation for version 999 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.UpgradeResourceIdentityResponse{}
+			testCase.server.UpgradeResourceIdentity(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -111,7 +112,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure"}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -135,10 +138,18 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeState")
 
+	var resourceStateUpgraders map[int64]resource.StateUpgrader
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeState")
-	resourceStateUpgraders := resourceWithUpgradeState.UpgradeState(ctx)
+	recovered := fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: resourceWithUpgradeState, Name: "UpgradeState"}, func() {
+		resourceStateUpgraders = resourceWithUpgradeState.UpgradeState(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeState")
 
+	if recovered {
+		return
+	}
+
 	// Panic prevention
 	if resourceStateUpgraders == nil {
 		resourceStateUpgraders = make(map[int64]resource.StateUpgrader, 0)
@@ -195,7 +206,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	// any errors.
 
 	logging.FrameworkDebug(ctx, "Calling provider defined StateUpgrader")
-	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	fwpanic.Call(ctx, &upgradeResourceStateResponse.Diagnostics, fwpanic.Method{Implementation: resourceStateUpgrader, Name: "StateUpgrader"}, func() {
+		resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
 
 	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -40,7 +41,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		configureResp := datasource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource Configure")
-		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: dataSourceWithConfigure, Name: "Configure"}, func() {
+			dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -57,26 +60,34 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	if dataSource, ok := req.DataSource.(datasource.DataSourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigValidators")
 
-		for _, configValidator := range dataSource.ConfigValidators(ctx) {
+		var configValidators []datasource.ConfigValidator
+
+		fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: dataSource, Name: "ConfigValidators"}, func() {
+			configValidators = dataSource.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vdscResp := &datasource.ValidateConfigResponse{}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined ConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.ValidateDataSource(ctx, vdscReq, vdscResp)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined ConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: configValidator, Name: "ValidateDataSource"}, func() {
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+				configValidator.ValidateDataSource(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+			})
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
 		}
@@ -90,7 +101,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		vdscResp := &datasource.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource ValidateConfig")
-		dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: dataSource, Name: "ValidateConfig"}, func() {
+			dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
@@ -230,6 +230,70 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-DataSourceWithConfigValidators-Description-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfig,
+				DataSource: &testprovider.DataSourceWithConfigValidators{
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []datasource.ConfigValidator {
+						return []datasource.ConfigValidator{
+							&testprovider.DataSourceConfigValidator{
+								DescriptionMethod: func(ctx context.Context) string {
+									panic("test panic")
+								},
+							},
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.DataSourceConfigValidator ValidateDataSource method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
+		"request-config-DataSourceWithConfigValidators-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfig,
+				DataSource: &testprovider.DataSourceWithConfigValidators{
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []datasource.ConfigValidator {
+						panic("test panic")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.DataSourceWithConfigValidators ConfigValidators method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
 		"request-config-DataSourceWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateEphemeralResourceConfigRequest is the framework server request for
+// the ValidateEphemeralResourceConfig RPC.
+type ValidateEphemeralResourceConfigRequest struct {
+	Config            *tfsdk.Config
+	EphemeralResource ephemeral.EphemeralResource
+}
+
+// ValidateEphemeralResourceConfigResponse is the framework server response
+// for the ValidateEphemeralResourceConfig RPC.
+type ValidateEphemeralResourceConfigResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+// ValidateEphemeralResourceConfig implements the framework server
+// ValidateEphemeralResourceConfig RPC.
+func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *ValidateEphemeralResourceConfigRequest, resp *ValidateEphemeralResourceConfigResponse) {
+	if req == nil || req.Config == nil {
+		return
+	}
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResourceWithConfigure, Name: "Configure"}, func() {
+			ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	vdscReq := ephemeral.ValidateConfigRequest{
+		Config: *req.Config,
+	}
+
+	if ephemeralResource, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigValidators")
+
+		var configValidators []ephemeral.ConfigValidator
+
+		fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: ephemeralResource, Name: "ConfigValidators"}, func() {
+			configValidators = ephemeralResource.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
+			// Instantiate a new response for each request to prevent validators
+			// from modifying or removing diagnostics.
+			vdscResp := &ephemeral.ValidateConfigResponse{}
+
+			fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: configValidator, Name: "ValidateEphemeralResource"}, func() {
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+				configValidator.ValidateEphemeralResource(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+			})
+
+			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		}
+	}
+
+	if ephemeralResource, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithValidateConfig")
+
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		vdscResp := &ephemeral.ValidateConfigResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined EphemeralResource ValidateConfig")
+		fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: ephemeralResource, Name: "ValidateConfig"}, func() {
+			ephemeralResource.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined EphemeralResource ValidateConfig")
+
+		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+	}
+
+	validateSchemaReq := ValidateSchemaRequest{
+		Config: *req.Config,
+	}
+	// Instantiate a new response for each request to prevent validators
+	// from modifying or removing diagnostics.
+	validateSchemaResp := ValidateSchemaResponse{}
+
+	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+}
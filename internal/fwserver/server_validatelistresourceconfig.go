@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateListResourceConfigRequest is the framework server request for
+// the ValidateListResourceConfig RPC.
+type ValidateListResourceConfigRequest struct {
+	Config       *tfsdk.Config
+	ListResource list.ListResource
+}
+
+// ValidateListResourceConfigResponse is the framework server response
+// for the ValidateListResourceConfig RPC.
+type ValidateListResourceConfigResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+// ValidateListResourceConfig implements the framework server
+// ValidateListResourceConfig RPC.
+func (s *Server) ValidateListResourceConfig(ctx context.Context, req *ValidateListResourceConfigRequest, resp *ValidateListResourceConfigResponse) {
+	if req == nil || req.Config == nil {
+		return
+	}
+
+	if listResourceWithConfigure, ok := req.ListResource.(list.ListResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "ListResource implements ListResourceWithConfigure")
+
+		configureReq := resource.ConfigureRequest{
+			ProviderData: s.ResourceConfigureData,
+		}
+		configureResp := resource.ConfigureResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined ListResource Configure")
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: listResourceWithConfigure, Name: "Configure"}, func() {
+			listResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined ListResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	vdscReq := list.ValidateConfigRequest{
+		Config: *req.Config,
+	}
+
+	if listResource, ok := req.ListResource.(list.ListResourceWithConfigValidators); ok {
+		logging.FrameworkTrace(ctx, "ListResource implements ListResourceWithConfigValidators")
+
+		var configValidators []list.ConfigValidator
+
+		fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: listResource, Name: "ConfigValidators"}, func() {
+			configValidators = listResource.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
+			// Instantiate a new response for each request to prevent validators
+			// from modifying or removing diagnostics.
+			vdscResp := &list.ValidateConfigResponse{}
+
+			fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: configValidator, Name: "ValidateListResource"}, func() {
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+				configValidator.ValidateListResource(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+			})
+
+			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		}
+	}
+
+	if listResource, ok := req.ListResource.(list.ListResourceWithValidateConfig); ok {
+		logging.FrameworkTrace(ctx, "ListResource implements ListResourceWithValidateConfig")
+
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		vdscResp := &list.ValidateConfigResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined ListResource ValidateConfig")
+		fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: listResource, Name: "ValidateConfig"}, func() {
+			listResource.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined ListResource ValidateConfig")
+
+		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+	}
+
+	validateSchemaReq := ValidateSchemaRequest{
+		Config: *req.Config,
+	}
+	// Instantiate a new response for each request to prevent validators
+	// from modifying or removing diagnostics.
+	validateSchemaResp := ValidateSchemaResponse{}
+
+	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+}
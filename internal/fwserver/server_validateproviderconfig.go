@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -38,26 +39,34 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	if providerWithConfigValidators, ok := s.Provider.(provider.ProviderWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Provider implements ProviderWithConfigValidators")
 
-		for _, configValidator := range providerWithConfigValidators.ConfigValidators(ctx) {
+		var configValidators []provider.ConfigValidator
+
+		fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: providerWithConfigValidators, Name: "ConfigValidators"}, func() {
+			configValidators = providerWithConfigValidators.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vpcRes := &provider.ValidateConfigResponse{}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined ConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.ValidateProvider(ctx, vpcReq, vpcRes)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined ConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			fwpanic.Call(ctx, &vpcRes.Diagnostics, fwpanic.Method{Implementation: configValidator, Name: "ValidateProvider"}, func() {
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+				configValidator.ValidateProvider(ctx, vpcReq, vpcRes)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+			})
 
 			resp.Diagnostics.Append(vpcRes.Diagnostics...)
 		}
@@ -71,7 +80,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		vpcRes := &provider.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Provider ValidateConfig")
-		providerWithValidateConfig.ValidateConfig(ctx, vpcReq, vpcRes)
+		fwpanic.Call(ctx, &vpcRes.Diagnostics, fwpanic.Method{Implementation: providerWithValidateConfig, Name: "ValidateConfig"}, func() {
+			providerWithValidateConfig.ValidateConfig(ctx, vpcReq, vpcRes)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Provider ValidateConfig")
 
 		resp.Diagnostics.Append(vpcRes.Diagnostics...)
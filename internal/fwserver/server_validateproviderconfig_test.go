@@ -234,6 +234,70 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testConfig,
 			},
 		},
+		"request-config-ProviderWithConfigValidators-Description-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							&testprovider.ProviderConfigValidator{
+								DescriptionMethod: func(ctx context.Context) string {
+									panic("test panic")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfig,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				PreparedConfig: &testConfig,
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.ProviderConfigValidator ValidateProvider method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
+		"request-config-ProviderWithConfigValidators-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						panic("test panic")
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfig,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				PreparedConfig: &testConfig,
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.ProviderWithConfigValidators ConfigValidators method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
 		"request-config-ProviderWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithValidateConfig{
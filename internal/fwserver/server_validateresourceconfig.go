@@ -5,8 +5,13 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -15,8 +20,9 @@ import (
 // ValidateResourceConfigRequest is the framework server request for the
 // ValidateResourceConfig RPC.
 type ValidateResourceConfigRequest struct {
-	Config   *tfsdk.Config
-	Resource resource.Resource
+	ClientCapabilities resource.ValidateConfigClientCapabilities
+	Config             *tfsdk.Config
+	Resource           resource.Resource
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -40,7 +46,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		configureResp := resource.ConfigureResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource Configure")
-		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		fwpanic.Call(ctx, &configureResp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigure, Name: "Configure"}, func() {
+			resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource Configure")
 
 		resp.Diagnostics.Append(configureResp.Diagnostics...)
@@ -51,32 +59,41 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	}
 
 	vdscReq := resource.ValidateConfigRequest{
-		Config: *req.Config,
+		ClientCapabilities: req.ClientCapabilities,
+		Config:             *req.Config,
 	}
 
 	if resourceWithConfigValidators, ok := req.Resource.(resource.ResourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidators")
 
-		for _, configValidator := range resourceWithConfigValidators.ConfigValidators(ctx) {
+		var configValidators []resource.ConfigValidator
+
+		fwpanic.Call(ctx, &resp.Diagnostics, fwpanic.Method{Implementation: resourceWithConfigValidators, Name: "ConfigValidators"}, func() {
+			configValidators = resourceWithConfigValidators.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			vdscResp := &resource.ValidateConfigResponse{}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined ResourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.ValidateResource(ctx, vdscReq, vdscResp)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined ResourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: configValidator, Name: "ValidateResource"}, func() {
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ResourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+				configValidator.ValidateResource(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ResourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: configValidator.Description(ctx),
+					},
+				)
+			})
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
 		}
@@ -90,7 +107,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		vdscResp := &resource.ValidateConfigResponse{}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ValidateConfig")
-		resourceWithValidateConfig.ValidateConfig(ctx, vdscReq, vdscResp)
+		fwpanic.Call(ctx, &vdscResp.Diagnostics, fwpanic.Method{Implementation: resourceWithValidateConfig, Name: "ValidateConfig"}, func() {
+			resourceWithValidateConfig.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
@@ -106,4 +125,60 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
 	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
+	// Terraform versions without write-only attribute support would
+	// otherwise persist the values in plan and state.
+	if !req.ClientCapabilities.WriteOnlyAttributesAllowed {
+		resp.Diagnostics.Append(validateWriteOnlyAttributesNull(ctx, *req.Config)...)
+	}
+}
+
+// validateWriteOnlyAttributesNull returns an error diagnostic for each
+// write-only attribute with a non-null configuration value.
+func validateWriteOnlyAttributesNull(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := tftypes.Walk(config.Raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		// we are only checking attributes, not the entire resource
+		if len(tfPath.Steps()) < 1 {
+			return true, nil
+		}
+
+		attribute, err := config.Schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Non-schema attributes, such as elements of collections, and blocks
+		// cannot be write-only.
+		if err != nil {
+			return true, nil
+		}
+
+		if !attribute.IsWriteOnly() || value.IsNull() {
+			return true, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfPath, config.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		diags.AddAttributeError(
+			fwPath,
+			"WriteOnly Attribute Not Allowed",
+			fmt.Sprintf("The resource contains a non-null value for WriteOnly attribute %s. ", fwPath)+
+				"Write-only attributes are only supported in Terraform 1.11 and later.",
+		)
+
+		// Child attributes of a write-only attribute are also write-only.
+		return false, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error Validating Write-Only Attributes",
+			"There was an unexpected error validating write-only attribute values. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
@@ -89,6 +90,66 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testDynamicType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.DynamicPseudoType,
+		},
+	}
+
+	testDynamicAttributeValue := tftypes.NewValue(
+		tftypes.List{ElementType: tftypes.String},
+		[]tftypes.Value{
+			tftypes.NewValue(tftypes.String, "test-value"),
+		},
+	)
+
+	testSchemaAttributeValidatorDynamic := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.DynamicAttribute{
+				Required: true,
+				Validators: []validator.Dynamic{
+					testvalidator.Dynamic{
+						ValidateDynamicMethod: func(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+							if !req.ConfigValue.Equal(types.DynamicValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test-value")}))) {
+								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value list, got "+req.ConfigValue.String())
+							}
+
+							resp.Diagnostics.AddAttributeWarning(req.Path, "warning summary", "warning detail")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfigAttributeValidatorDynamic := tfsdk.Config{
+		Raw: tftypes.NewValue(testDynamicType, map[string]tftypes.Value{
+			"test": testDynamicAttributeValue,
+		}),
+		Schema: testSchemaAttributeValidatorDynamic,
+	}
+
+	testSchemaWriteOnly := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+
+	testConfigWriteOnly := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaWriteOnly,
+	}
+
+	testConfigWriteOnlyNull := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaWriteOnly,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -150,6 +211,28 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-AttributeValidator-dynamic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorDynamic,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorDynamic
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"warning summary",
+						"warning detail",
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -230,6 +313,70 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-ResourceWithConfigValidators-Description-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidators{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								DescriptionMethod: func(ctx context.Context) string {
+									panic("test panic")
+								},
+							},
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.ResourceConfigValidator ValidateResource method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
+		"request-config-ResourceWithConfigValidators-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidators{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						panic("test panic")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"The provider panicked while calling the *testprovider.ResourceWithConfigValidators ConfigValidators method. "+
+							"This is always an issue with the provider and should be reported to the provider developers. "+
+							"The provider logs contain the stack trace of the panic.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -289,6 +436,60 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-write-only-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				ClientCapabilities: resource.ValidateConfigClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+				Config: &testConfigWriteOnly,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-write-only-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigWriteOnly,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"WriteOnly Attribute Not Allowed",
+						"The resource contains a non-null value for WriteOnly attribute test. "+
+							"Write-only attributes are only supported in Terraform 1.11 and later.",
+					),
+				},
+			},
+		},
+		"request-config-write-only-not-allowed-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigWriteOnlyNull,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// NullifyWriteOnlyAttributes returns a tftypes.Transform callback which
+// replaces the value of any write-only attribute with null. Terraform
+// requires that write-only attribute values are never returned in plan or
+// state data.
+func NullifyWriteOnlyAttributes(ctx context.Context, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, path)
+
+		if err != nil {
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
+				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
+				logging.FrameworkTrace(ctx, "attribute is a non-schema attribute, not nullifying")
+				return val, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// ignore attributes/elements inside dynamic values, they have no schema of their own
+				logging.FrameworkTrace(ctx, "attribute is inside a dynamic attribute, not nullifying")
+				return val, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathIsBlock) {
+				// ignore blocks, they do not have a write-only field
+				logging.FrameworkTrace(ctx, "attribute is a block, not nullifying")
+				return val, nil
+			}
+
+			logging.FrameworkError(ctx, "couldn't find attribute in resource schema")
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
+		if !attribute.IsWriteOnly() || val.IsNull() {
+			return val, nil
+		}
+
+		logging.FrameworkDebug(ctx, "nullifying write-only attribute value")
+
+		return tftypes.NewValue(val.Type(), nil), nil
+	}
+}
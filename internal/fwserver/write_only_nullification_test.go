@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullifyWriteOnlyAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// non-write-only values should be left alone
+			"string-value": schema.StringAttribute{
+				Required: true,
+			},
+			// write-only values should be nullified
+			"string-value-write-only": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+			// null write-only values should be left alone
+			"string-nil-write-only": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+			// write-only collections should be nullified without
+			// inspecting their elements
+			"list-value-write-only": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				WriteOnly:   true,
+			},
+			// write-only nested attributes should be nullified
+			"nested-value-write-only": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"string-value-write-only": schema.StringAttribute{
+						Optional:  true,
+						WriteOnly: true,
+					},
+				},
+				Optional:  true,
+				WriteOnly: true,
+			},
+			// write-only attributes within nested attributes should be
+			// nullified while leaving other attributes alone
+			"nested-value": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"string-value": schema.StringAttribute{
+						Optional: true,
+					},
+					"string-value-write-only": schema.StringAttribute{
+						Optional:  true,
+						WriteOnly: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// write-only attributes within blocks should be nullified
+			"block-value": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"string-value": schema.StringAttribute{
+							Optional: true,
+						},
+						"string-value-write-only": schema.StringAttribute{
+							Optional:  true,
+							WriteOnly: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nestedWriteOnlyType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string-value-write-only": tftypes.String,
+		},
+	}
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string-value":            tftypes.String,
+			"string-value-write-only": tftypes.String,
+		},
+	}
+
+	input := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+		"string-value-write-only": tftypes.NewValue(tftypes.String, "secret"),
+		"string-nil-write-only":   tftypes.NewValue(tftypes.String, nil),
+		"list-value-write-only": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "secret"),
+		}),
+		"nested-value-write-only": tftypes.NewValue(nestedWriteOnlyType, map[string]tftypes.Value{
+			"string-value-write-only": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+		"nested-value": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+			"string-value-write-only": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+		"block-value": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+				"string-value-write-only": tftypes.NewValue(tftypes.String, "secret"),
+			}),
+		}),
+	})
+	expected := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+		"string-value-write-only": tftypes.NewValue(tftypes.String, nil),
+		"string-nil-write-only":   tftypes.NewValue(tftypes.String, nil),
+		"list-value-write-only":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"nested-value-write-only": tftypes.NewValue(nestedWriteOnlyType, nil),
+		"nested-value": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+			"string-value-write-only": tftypes.NewValue(tftypes.String, nil),
+		}),
+		"block-value": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"string-value":            tftypes.NewValue(tftypes.String, "hello, world"),
+				"string-value-write-only": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	})
+
+	got, err := tftypes.Transform(input, fwserver.NullifyWriteOnlyAttributes(context.Background(), s))
+
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	diff, err := expected.Diff(got)
+
+	if err != nil {
+		t.Errorf("Error diffing values: %s", err)
+		return
+	}
+
+	if len(diff) > 0 {
+		t.Errorf("Unexpected diff (value1 expected, value2 got): %v", diff)
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwtimeouts contains the shared implementation of the resource and
+// data source timeouts packages, such as timeout parsing and validation, and
+// the tracking of operation deadlines for framework server diagnostics.
+package fwtimeouts
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtimeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = DurationValidator{}
+
+// DurationValidator validates that a string attribute value can be parsed by
+// time.ParseDuration.
+type DurationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v DurationValidator) Description(_ context.Context) string {
+	return "value must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as \"30s\" or \"2h45m\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
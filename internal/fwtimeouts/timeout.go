@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtimeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	// AttributeNameCreate is the timeouts attribute name for the create
+	// operation.
+	AttributeNameCreate = "create"
+
+	// AttributeNameDelete is the timeouts attribute name for the delete
+	// operation.
+	AttributeNameDelete = "delete"
+
+	// AttributeNameRead is the timeouts attribute name for the read
+	// operation.
+	AttributeNameRead = "read"
+
+	// AttributeNameUpdate is the timeouts attribute name for the update
+	// operation.
+	AttributeNameUpdate = "update"
+)
+
+// AttributeDescription is the shared description of the timeouts attributes.
+const AttributeDescription = `A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+	`consisting of numbers and unit suffixes, such as "30s" or "2h45m". ` +
+	`Valid time units are "s" (seconds), "m" (minutes), "h" (hours).`
+
+// Timeout returns the duration of the named timeout attribute in the given
+// timeouts object. The default timeout is returned when the object or the
+// attribute is null, unknown, or missing.
+func Timeout(ctx context.Context, object basetypes.ObjectValue, name string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if object.IsNull() || object.IsUnknown() {
+		return defaultTimeout, diags
+	}
+
+	value, ok := object.Attributes()[name]
+
+	if !ok {
+		logging.FrameworkDebug(ctx, "Timeout configuration not found, using default", map[string]any{logging.KeyTimeoutName: name})
+
+		return defaultTimeout, diags
+	}
+
+	stringValue, ok := value.(basetypes.StringValuable)
+
+	if !ok {
+		diags.AddError(
+			"Invalid Timeout Value Type",
+			fmt.Sprintf("The %q timeout value is a %T instead of a string. ", name, value)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return 0, diags
+	}
+
+	str, strDiags := stringValue.ToStringValue(ctx)
+
+	diags.Append(strDiags...)
+
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	if str.IsNull() || str.IsUnknown() {
+		return defaultTimeout, diags
+	}
+
+	timeout, err := time.ParseDuration(str.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("The %q timeout cannot be parsed: %s", name, err),
+		)
+
+		return 0, diags
+	}
+
+	return timeout, diags
+}
+
+// Context returns a context with a deadline of the named timeout in the given
+// timeouts object, or the default timeout when it is not configured. The
+// deadline is recorded in any Tracker from the given context, so the
+// framework server can report when it was exceeded. If the timeout cannot be
+// determined, the given context is returned as-is with a no-op cancel function
+// so callers can always defer the cancellation.
+func Context(ctx context.Context, object basetypes.ObjectValue, name string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	timeout, diags := Timeout(ctx, object, name, defaultTimeout)
+
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+
+	if tracker := trackerFromContext(ctx); tracker != nil {
+		tracker.track(timeoutCtx, name, timeout)
+	}
+
+	return timeoutCtx, cancel, diags
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtimeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"create": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		object        basetypes.ObjectValue
+		name          string
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"object-null": {
+			object:   types.ObjectNull(testAttrTypes),
+			name:     "create",
+			expected: 10 * time.Minute,
+		},
+		"object-unknown": {
+			object:   types.ObjectUnknown(testAttrTypes),
+			name:     "create",
+			expected: 10 * time.Minute,
+		},
+		"attribute-missing": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("30m"),
+			}),
+			name:     "delete",
+			expected: 10 * time.Minute,
+		},
+		"attribute-null": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringNull(),
+			}),
+			name:     "create",
+			expected: 10 * time.Minute,
+		},
+		"attribute-unknown": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringUnknown(),
+			}),
+			name:     "create",
+			expected: 10 * time.Minute,
+		},
+		"attribute-value": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("30m"),
+			}),
+			name:     "create",
+			expected: 30 * time.Minute,
+		},
+		"attribute-value-invalid": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("invalid"),
+			}),
+			name: "create",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`The "create" timeout cannot be parsed: time: invalid duration "invalid"`,
+				),
+			},
+		},
+		"attribute-type-invalid": {
+			object: types.ObjectValueMust(
+				map[string]attr.Type{
+					"create": types.BoolType,
+				},
+				map[string]attr.Value{
+					"create": types.BoolValue(true),
+				},
+			),
+			name: "create",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeout Value Type",
+					`The "create" timeout value is a basetypes.BoolValue instead of a string. `+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fwtimeouts.Timeout(context.Background(), testCase.object, testCase.name, 10*time.Minute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"create": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		object           basetypes.ObjectValue
+		expectDeadline   bool
+		expectedExceeded bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"deadline": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("1h"),
+			}),
+			expectDeadline: true,
+		},
+		"deadline-exceeded": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("1ns"),
+			}),
+			expectDeadline:   true,
+			expectedExceeded: true,
+		},
+		"invalid": {
+			object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+				"create": types.StringValue("invalid"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`The "create" timeout cannot be parsed: time: invalid duration "invalid"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, tracker := fwtimeouts.ContextWithTracker(context.Background())
+
+			timeoutCtx, cancel, diags := fwtimeouts.Context(ctx, testCase.object, "create", 10*time.Minute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.expectedExceeded {
+				<-timeoutCtx.Done()
+			}
+
+			cancel()
+
+			if _, ok := timeoutCtx.Deadline(); ok != testCase.expectDeadline {
+				t.Errorf("expected deadline %t, got %t", testCase.expectDeadline, ok)
+			}
+
+			if _, _, exceeded := tracker.Exceeded(); exceeded != testCase.expectedExceeded {
+				t.Errorf("expected exceeded %t, got %t", testCase.expectedExceeded, exceeded)
+			}
+		})
+	}
+}
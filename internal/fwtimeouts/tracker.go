@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtimeouts
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// trackerKey is the context key for ContextWithTracker.
+type trackerKey struct{}
+
+// Tracker records the operation deadlines created by Context during a
+// provider defined method call, so the caller can determine whether any of
+// them were exceeded.
+type Tracker struct {
+	deadlines []trackedDeadline
+	mu        sync.Mutex
+}
+
+// trackedDeadline is a context with a deadline created by Context.
+type trackedDeadline struct {
+	ctx       context.Context
+	operation string
+	timeout   time.Duration
+}
+
+// ContextWithTracker returns a context containing a new Tracker.
+func ContextWithTracker(ctx context.Context) (context.Context, *Tracker) {
+	tracker := &Tracker{}
+
+	return context.WithValue(ctx, trackerKey{}, tracker), tracker
+}
+
+// Exceeded returns the operation and timeout of the first tracked deadline
+// which was exceeded. The boolean is false if no deadline was exceeded.
+func (t *Tracker) Exceeded() (string, time.Duration, bool) {
+	if t == nil {
+		return "", 0, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, deadline := range t.deadlines {
+		// Contexts are typically cancelled by the provider before returning,
+		// however the original context error is preserved in that case.
+		if errors.Is(deadline.ctx.Err(), context.DeadlineExceeded) {
+			return deadline.operation, deadline.timeout, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// track records the given deadline context.
+func (t *Tracker) track(ctx context.Context, operation string, timeout time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.deadlines = append(t.deadlines, trackedDeadline{
+		ctx:       ctx,
+		operation: operation,
+		timeout:   timeout,
+	})
+}
+
+// trackerFromContext returns the Tracker from ContextWithTracker, if any.
+func trackerFromContext(ctx context.Context) *Tracker {
+	tracker, ok := ctx.Value(trackerKey{}).(*Tracker)
+
+	if !ok {
+		return nil
+	}
+
+	return tracker
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtimeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTrackerExceeded(t *testing.T) {
+	t.Parallel()
+
+	testObject := types.ObjectValueMust(
+		map[string]attr.Type{
+			"create": types.StringType,
+			"delete": types.StringType,
+		},
+		map[string]attr.Value{
+			"create": types.StringValue("1h"),
+			"delete": types.StringValue("1ns"),
+		},
+	)
+
+	ctx, tracker := fwtimeouts.ContextWithTracker(context.Background())
+
+	_, createCancel, _ := fwtimeouts.Context(ctx, testObject, "create", 0)
+	defer createCancel()
+
+	if _, _, ok := tracker.Exceeded(); ok {
+		t.Fatal("unexpected exceeded deadline")
+	}
+
+	deleteCtx, deleteCancel, _ := fwtimeouts.Context(ctx, testObject, "delete", 0)
+
+	<-deleteCtx.Done()
+	deleteCancel()
+
+	operation, timeout, ok := tracker.Exceeded()
+
+	if !ok {
+		t.Fatal("expected exceeded deadline")
+	}
+
+	if operation != "delete" {
+		t.Errorf("expected delete operation, got: %s", operation)
+	}
+
+	if timeout != time.Nanosecond {
+		t.Errorf("expected 1ns timeout, got: %s", timeout)
+	}
+}
+
+func TestTrackerExceeded_Nil(t *testing.T) {
+	t.Parallel()
+
+	var tracker *fwtimeouts.Tracker
+
+	if _, _, ok := tracker.Exceeded(); ok {
+		t.Fatal("unexpected exceeded deadline")
+	}
+}
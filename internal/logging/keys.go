@@ -15,9 +15,16 @@ const (
 	// as parent.0.child in this project.
 	KeyAttributePath = "tf_attribute_path"
 
+	// The maximum number of concurrent calls, such as for a managed resource
+	// type concurrency limit.
+	KeyConcurrencyLimit = "tf_concurrency_limit"
+
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
+	// The reason a response was deferred, such as "Provider Config Unknown".
+	KeyDeferredReason = "tf_deferred_reason"
+
 	// Human readable string when calling a provider defined type that must
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
@@ -25,9 +32,38 @@ const (
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
+	// The type of ephemeral resource being operated on, such as "random_password"
+	KeyEphemeralResourceType = "tf_ephemeral_resource_type"
+
+	// The name of the function being operated on, such as "parse_xyz"
+	KeyFunctionName = "tf_function_name"
+
+	// The type of list resource being operated on, such as "random_password"
+	KeyListResourceType = "tf_list_resource_type"
+
+	// The key of a keyed mutex, such as a remote parent object identifier.
+	KeyMutexKey = "tf_mutex_key"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
 
+	// The attempt number of a retried operation or state refresh, starting
+	// at 1.
+	KeyRetryAttempt = "tf_retry_attempt"
+
+	// The remote object state returned by a state refresh, such as "pending".
+	KeyRetryState = "tf_retry_state"
+
+	// The stack trace of a recovered panic.
+	KeyStackTrace = "stack_trace"
+
+	// The index of the StateMover being operated on, from the list returned
+	// by the resource MoveState method.
+	KeyStateMoverIndex = "tf_state_mover_index"
+
+	// The name of the timeout being operated on, such as "create".
+	KeyTimeoutName = "tf_timeout_name"
+
 	// The type of value being operated on, such as "JSONStringValue".
 	KeyValueType = "tf_value_type"
 )
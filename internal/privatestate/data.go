@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"unicode/utf8"
 
@@ -72,9 +73,9 @@ func (d *Data) Bytes(ctx context.Context) ([]byte, diag.Diagnostics) {
 			if !json.Valid(v) {
 				diags.AddError(
 					"Error Encoding Private State",
-					fmt.Sprintf("An error was encountered when validating private state value."+
+					"An error was encountered when validating private state value."+
 						fmt.Sprintf("The value associated with key %q is is not valid JSON.\n\n", k)+
-						"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer."),
+						"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
 				)
 
 				tflog.Error(ctx, "error encoding private state: invalid JSON value", map[string]interface{}{"key": k, "value": v})
@@ -354,6 +355,49 @@ func (d *ProviderData) SetKey(ctx context.Context, key string, value []byte) dia
 	return nil
 }
 
+// DeleteKey removes the private state data associated with the given key.
+//
+// If the key is reserved for framework usage, an error diagnostic
+// is returned. Removing a key which has no data is not an error.
+func (d *ProviderData) DeleteKey(ctx context.Context, key string) diag.Diagnostics {
+	diags := ValidateProviderDataKey(ctx, key)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if d == nil || d.data == nil {
+		return nil
+	}
+
+	delete(d.data, key)
+
+	return nil
+}
+
+// Keys returns the sorted keys which have private state data associated
+// with them.
+func (d *ProviderData) Keys(_ context.Context) []string {
+	if d == nil || len(d.data) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(d.data))
+
+	for k, v := range d.data {
+		// Empty values are not persisted, see Data.Bytes.
+		if len(v) == 0 {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 // ValidateProviderDataKey determines whether the key supplied is allowed on the basis of any
 // restrictions that are in place, such as key prefixes that are reserved for use with
 // framework private state data.
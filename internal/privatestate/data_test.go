@@ -898,6 +898,137 @@ func TestProviderData_SetKey(t *testing.T) {
 	}
 }
 
+func TestProviderData_DeleteKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		key           string
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			key:          "key",
+		},
+		"key-invalid": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+				},
+			},
+			key: ".key",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".key" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"key-not-found": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+				},
+			},
+			key: "keyTwo",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+				},
+			},
+		},
+		"key-deleted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+					"keyTwo": []byte(`{"buzz": "bazz"}`),
+				},
+			},
+			key: "keyOne",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"keyTwo": []byte(`{"buzz": "bazz"}`),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := testCase.providerData.DeleteKey(context.Background(), testCase.key)
+
+			if diff := cmp.Diff(testCase.expected, testCase.providerData, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(actual, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderData_Keys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+		expected     []string
+	}{
+		"nil": {
+			providerData: nil,
+		},
+		"empty": {
+			providerData: &ProviderData{
+				data: map[string][]byte{},
+			},
+		},
+		"sorted": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"keyTwo": []byte(`{"buzz": "bazz"}`),
+					"keyOne": []byte(`{"foo": "bar"}`),
+				},
+			},
+			expected: []string{"keyOne", "keyTwo"},
+		},
+		"empty-value": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"keyOne": []byte(`{"foo": "bar"}`),
+					"keyTwo": nil,
+				},
+			},
+			expected: []string{"keyOne"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := testCase.providerData.Keys(context.Background())
+
+			if diff := cmp.Diff(actual, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateProviderDataKey(t *testing.T) {
 	t.Parallel()
 
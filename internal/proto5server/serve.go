@@ -7,22 +7,59 @@ import (
 	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/mutexkv"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
 var _ tfprotov5.ProviderServer = &Server{}
+var _ tfprotov5.FunctionServer = &Server{}
+var _ tfprotov5.ListResourceServer = &Server{}
 
 // Provider server implementation.
 type Server struct {
 	FrameworkServer fwserver.Server
 
+	// NestedAttributesAsBlocks enables converting single, list, and set
+	// nested attributes into the equivalent nested blocks, since protocol
+	// version 5 does not support nested attributes. Schema and data
+	// conversions are handled automatically. Nested attributes which cannot
+	// be represented as blocks, such as map nested attributes, return errors.
+	NestedAttributesAsBlocks bool
+
+	// DisablePanicRecovery prevents recovering panics in provider defined
+	// methods, which are otherwise converted into error diagnostics. This
+	// can be useful while debugging a provider.
+	DisablePanicRecovery bool
+
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
 }
 
+// registerContext returns a cancellable context which is cancelled when
+// Terraform calls the StopProvider RPC. Streaming RPCs, such as ListResource,
+// continue to use this context after returning, so consuming their results
+// also stops when the provider is stopped. The context also signals whether
+// nested attributes are converted to blocks for the protocol and whether
+// panics in provider defined methods are recovered.
 func (s *Server) registerContext(in context.Context) context.Context {
 	ctx, cancel := context.WithCancel(in)
+
+	if s.NestedAttributesAsBlocks {
+		ctx = fwschema.ContextWithNestedAttributesAsBlocks(ctx)
+	}
+
+	if s.DisablePanicRecovery {
+		ctx = fwpanic.ContextWithRecoveryDisabled(ctx)
+	}
+
+	ctx = fwmetrics.ContextWithReporter(ctx, s.FrameworkServer.MetricsReporter)
+	ctx = mutexkv.NewContext(ctx, s.FrameworkServer.MutexKV())
+
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
 	s.contextCancels = append(s.contextCancels, cancel)
@@ -40,7 +77,11 @@ func (s *Server) cancelRegisteredContexts(_ context.Context) {
 
 // StopProvider satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	ctx = logging.InitContext(ctx)
+
 	s.cancelRegisteredContexts(ctx)
 
+	s.FrameworkServer.StopProvider(ctx)
+
 	return &tfprotov5.StopProviderResponse{}, nil
 }
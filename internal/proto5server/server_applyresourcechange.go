@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -36,6 +37,14 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -44,7 +53,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
+	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, identitySchema, providerMetaSchema)
 
 	fwResp.Diagnostics.Append(diags...)
 
@@ -52,7 +61,9 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationApplyResourceChange, proto5Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 }
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// CallFunction satisfies the tfprotov5.FunctionServer interface.
+func (s *Server) CallFunction(ctx context.Context, proto5Req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+
+	fwResp := &fwserver.CallFunctionResponse{}
+
+	functionImpl, diags := s.FrameworkServer.Function(ctx, proto5Req.Name)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, function.FuncErrorFromDiags(ctx, diags))
+
+	if fwResp.Error != nil {
+		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	functionDefinition, diags := s.FrameworkServer.FunctionDefinition(ctx, proto5Req.Name)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, function.FuncErrorFromDiags(ctx, diags))
+
+	if fwResp.Error != nil {
+		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	fwReq, funcErr := fromproto5.CallFunctionRequest(ctx, proto5Req, functionImpl, functionDefinition)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, funcErr)
+
+	if fwResp.Error != nil {
+		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.InterceptFunction(ctx, proto5Req.Name, &fwResp.Error, func(ctx context.Context) {
+		s.FrameworkServer.CallFunction(ctx, fwReq, fwResp)
+	})
+
+	return toproto5.CallFunctionResponse(ctx, fwResp), nil
+}
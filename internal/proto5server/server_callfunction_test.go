@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerCallFunction(t *testing.T) {
+	t.Parallel()
+
+	testArgumentDynamicValue, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, "arg0"))
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	testResultDynamicValue, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, "result-arg0"))
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	testFunction := func() function.Function {
+		return &testprovider.Function{
+			DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+				resp.Definition = function.Definition{
+					Parameters: []function.Parameter{
+						function.StringParameter{
+							Name: "input",
+						},
+					},
+					Return: function.StringReturn{},
+				}
+			},
+			MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+				resp.Name = "testfunction"
+			},
+			RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+				var arg0 basetypes.StringValue
+
+				resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0))
+
+				if resp.Error != nil {
+					return
+				}
+
+				resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "result-"+arg0.ValueString()))
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov5.CallFunctionRequest
+		expectedError    error
+		expectedResponse *tfprotov5.CallFunctionResponse
+	}{
+		"function-missing": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{},
+				},
+			},
+			request: &tfprotov5.CallFunctionRequest{
+				Name: "testfunction",
+			},
+			expectedResponse: &tfprotov5.CallFunctionResponse{
+				Error: &tfprotov5.FunctionError{
+					Text: "Function Not Found: No function named \"testfunction\" was found in the provider.",
+				},
+			},
+		},
+		"request-arguments": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{
+						FunctionsMethod: func(_ context.Context) []func() function.Function {
+							return []func() function.Function{
+								testFunction,
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.CallFunctionRequest{
+				Arguments: []*tfprotov5.DynamicValue{
+					&testArgumentDynamicValue,
+				},
+				Name: "testfunction",
+			},
+			expectedResponse: &tfprotov5.CallFunctionResponse{
+				Result: &testResultDynamicValue,
+			},
+		},
+		"request-arguments-missing": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{
+						FunctionsMethod: func(_ context.Context) []func() function.Function {
+							return []func() function.Function{
+								testFunction,
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.CallFunctionRequest{
+				Name: "testfunction",
+			},
+			expectedResponse: &tfprotov5.CallFunctionResponse{
+				Error: &tfprotov5.FunctionError{
+					Text: "Unexpected Function Arguments Data: " +
+						"The provider received an unexpected number of function arguments from Terraform for the given function definition. " +
+						"This is always an issue in terraform-plugin-framework or Terraform itself and should be reported to the provider developers.\n\n" +
+						"Expected function arguments: 1\n" +
+						"Given function arguments: 0",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.CallFunction(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
@@ -36,7 +37,9 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.Con
 		return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationConfigureProvider, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
 }
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// GetFunctions satisfies the tfprotov5.FunctionServer interface.
+func (s *Server) GetFunctions(ctx context.Context, proto5Req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+
+	fwReq := fromproto5.GetFunctionsRequest(ctx, proto5Req)
+	fwResp := &fwserver.GetFunctionsResponse{}
+
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationGetFunctions, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.GetFunctions(ctx, fwReq, fwResp)
+	})
+
+	return toproto5.GetFunctionsResponse(ctx, fwResp), nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestServerGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov5.GetMetadataRequest
+		expectedError    error
+		expectedResponse *tfprotov5.GetMetadataResponse
+	}{
+		"datasources-resources": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							return []func() datasource.DataSource{
+								func() datasource.DataSource {
+									return &testprovider.DataSource{
+										MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+											resp.TypeName = "test_data_source"
+										},
+									}
+								},
+							}
+						},
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.GetMetadataRequest{},
+			expectedResponse: &tfprotov5.GetMetadataResponse{
+				DataSources: []tfprotov5.DataSourceMetadata{
+					{
+						TypeName: "test_data_source",
+					},
+				},
+				EphemeralResources: []tfprotov5.EphemeralResourceMetadata{},
+				Functions:          []tfprotov5.FunctionMetadata{},
+				ListResources:      []tfprotov5.ListResourceMetadata{},
+				Resources: []tfprotov5.ResourceMetadata{
+					{
+						TypeName: "test_resource",
+					},
+				},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.GetMetadata(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
+
+// TestServerGetMetadata_LazySchemas verifies that type specific RPCs called
+// without a prior GetProviderSchema RPC only load the schema they need.
+func TestServerGetMetadata_LazySchemas(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testDynamicValue, err := tfprotov5.NewDynamicValue(testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "test"
+				},
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+					panic("unexpected Provider Schema call")
+				},
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_data_source"
+								},
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, _ *datasource.SchemaResponse) {
+									panic("unexpected DataSource Schema call")
+								},
+							}
+						},
+					}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_resource1"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+							}
+						},
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_resource2"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+									panic("unexpected Resource Schema call")
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	_, err = server.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected GetMetadata error: %s", err)
+	}
+
+	got, err := server.ValidateResourceTypeConfig(context.Background(), &tfprotov5.ValidateResourceTypeConfigRequest{
+		Config:   &testDynamicValue,
+		TypeName: "test_resource1",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ValidateResourceTypeConfig error: %s", err)
+	}
+
+	if diff := cmp.Diff(&tfprotov5.ValidateResourceTypeConfigResponse{}, got); diff != "" {
+		t.Errorf("unexpected response difference: %s", diff)
+	}
+}
@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -21,7 +22,9 @@ func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.Get
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
-	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationGetProviderSchema, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
 }
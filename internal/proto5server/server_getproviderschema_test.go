@@ -102,12 +102,17 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -166,12 +171,17 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -204,12 +214,17 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -231,7 +246,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov5.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -245,7 +263,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -268,7 +288,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov5.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
@@ -285,7 +308,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -334,7 +359,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov5.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
@@ -363,7 +391,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 					},
 				},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -422,12 +452,17 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -460,12 +495,17 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{},
 				},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 				ServerCapabilities: &tfprotov5.ServerCapabilities{
-					PlanDestroy: true,
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
 				},
 			},
 		},
@@ -517,16 +557,6 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 	}
 
 	expectedEntries := []map[string]interface{}{
-		{
-			"@level":   "debug",
-			"@message": "Calling provider defined Provider Metadata",
-			"@module":  "sdk.framework",
-		},
-		{
-			"@level":   "debug",
-			"@message": "Called provider defined Provider Metadata",
-			"@module":  "sdk.framework",
-		},
 		{
 			"@level":   "trace",
 			"@message": "Checking ProviderSchema lock",
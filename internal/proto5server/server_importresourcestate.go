@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -36,7 +37,7 @@ func (s *Server) ImportResourceState(ctx context.Context, proto5Req *tfprotov5.I
 		return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto5.ImportResourceStateRequest(ctx, proto5Req, resource, resourceSchema)
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
@@ -44,7 +45,17 @@ func (s *Server) ImportResourceState(ctx context.Context, proto5Req *tfprotov5.I
 		return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	fwReq, diags := fromproto5.ImportResourceStateRequest(ctx, proto5Req, resource, resourceSchema, identitySchema)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationImportResourceState, proto5Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.ImportResourceStateResponse(ctx, fwResp), nil
 }
@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -36,7 +37,9 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationValidateProviderConfig, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
 }
@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -52,7 +53,9 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationReadDataSource, proto5Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -419,3 +420,154 @@ func TestServerReadResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadResource_NestedAttributesAsBlocks(t *testing.T) {
+	t.Parallel()
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list_nested": tftypes.List{
+				ElementType: testNestedObjectType,
+			},
+			"single_nested": testNestedObjectType,
+		},
+	}
+
+	testCurrentStateValue, err := tfprotov5.NewDynamicValue(testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+		"list_nested":   tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{}),
+		"single_nested": tftypes.NewValue(testNestedObjectType, nil),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testNewStateValue, err := tfprotov5.NewDynamicValue(testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+		"list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{}),
+		"single_nested": tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+			"nested_string": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testNestedAttributes := map[string]schema.Attribute{
+		"nested_string": schema.StringAttribute{
+			Optional: true,
+		},
+	}
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"list_nested": schema.ListNestedAttribute{
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: testNestedAttributes,
+												},
+												Optional: true,
+											},
+											"single_nested": schema.SingleNestedAttribute{
+												Attributes: testNestedAttributes,
+												Optional:   true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+									var listNested types.List
+
+									resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("list_nested"), &listNested)...)
+
+									// The empty block list from Terraform is a null attribute value.
+									if !listNested.IsNull() {
+										resp.Diagnostics.AddError("unexpected list_nested value", listNested.String())
+									}
+
+									resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("single_nested").AtName("nested_string"), "test-value")...)
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+		NestedAttributesAsBlocks: true,
+	}
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected GetProviderSchema error: %s", err)
+	}
+
+	if len(schemaResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected GetProviderSchema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	expectedNestedBlock := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{
+				Name:     "nested_string",
+				Optional: true,
+				Type:     tftypes.String,
+			},
+		},
+	}
+
+	expectedSchema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					Block:    expectedNestedBlock,
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					TypeName: "list_nested",
+				},
+				{
+					Block:    expectedNestedBlock,
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+					TypeName: "single_nested",
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expectedSchema, schemaResp.ResourceSchemas["test_resource"]); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	got, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+		CurrentState: &testCurrentStateValue,
+		TypeName:     "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected ReadResource error: %s", err)
+	}
+
+	expectedResponse := &tfprotov5.ReadResourceResponse{
+		// The null attribute value is an empty block list for Terraform.
+		NewState: &testNewStateValue,
+	}
+
+	if diff := cmp.Diff(expectedResponse, got); diff != "" {
+		t.Errorf("unexpected response difference: %s", diff)
+	}
+}
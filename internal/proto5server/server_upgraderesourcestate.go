@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -48,7 +49,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationUpgradeResourceState, proto5Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 }
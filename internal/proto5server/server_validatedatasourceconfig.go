@@ -6,6 +6,7 @@ package proto5server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -44,7 +45,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationValidateDataSourceConfig, proto5Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	})
 
 	return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 }
@@ -7,22 +7,47 @@ import (
 	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwmetrics"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/mutexkv"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 var _ tfprotov6.ProviderServer = &Server{}
+var _ tfprotov6.FunctionServer = &Server{}
+var _ tfprotov6.ListResourceServer = &Server{}
 
 // Provider server implementation.
 type Server struct {
 	FrameworkServer fwserver.Server
 
+	// DisablePanicRecovery prevents recovering panics in provider defined
+	// methods, which are otherwise converted into error diagnostics. This
+	// can be useful while debugging a provider.
+	DisablePanicRecovery bool
+
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
 }
 
+// registerContext returns a cancellable context which is cancelled when
+// Terraform calls the StopProvider RPC. Streaming RPCs, such as ListResource,
+// continue to use this context after returning, so consuming their results
+// also stops when the provider is stopped. The context also signals whether
+// panics in provider defined methods are recovered and carries the metrics
+// reporter, if any, and the keyed mutex of the provider server.
 func (s *Server) registerContext(in context.Context) context.Context {
 	ctx, cancel := context.WithCancel(in)
+
+	if s.DisablePanicRecovery {
+		ctx = fwpanic.ContextWithRecoveryDisabled(ctx)
+	}
+
+	ctx = fwmetrics.ContextWithReporter(ctx, s.FrameworkServer.MetricsReporter)
+	ctx = mutexkv.NewContext(ctx, s.FrameworkServer.MutexKV())
+
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
 	s.contextCancels = append(s.contextCancels, cancel)
@@ -40,7 +65,11 @@ func (s *Server) cancelRegisteredContexts(_ context.Context) {
 
 // StopProvider satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	ctx = logging.InitContext(ctx)
+
 	s.cancelRegisteredContexts(ctx)
 
+	s.FrameworkServer.StopProvider(ctx)
+
 	return &tfprotov6.StopProviderResponse{}, nil
 }
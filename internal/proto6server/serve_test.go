@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/mutexkv"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -54,6 +55,23 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// canceled, or we have an error reported
 }
 
+func TestServerRegisterContext_MutexKV(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+
+	first := mutexkv.FromContext(s.registerContext(context.Background()))
+	second := mutexkv.FromContext(s.registerContext(context.Background()))
+
+	if first == nil {
+		t.Fatal("expected MutexKV in context")
+	}
+
+	if first != second {
+		t.Error("expected the same MutexKV across requests")
+	}
+}
+
 func testNewDynamicValue(t *testing.T, schemaType tftypes.Type, schemaValue map[string]tftypes.Value) *tfprotov6.DynamicValue {
 	t.Helper()
 
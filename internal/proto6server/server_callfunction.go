@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// CallFunction satisfies the tfprotov6.FunctionServer interface.
+func (s *Server) CallFunction(ctx context.Context, proto6Req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+
+	fwResp := &fwserver.CallFunctionResponse{}
+
+	functionImpl, diags := s.FrameworkServer.Function(ctx, proto6Req.Name)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, function.FuncErrorFromDiags(ctx, diags))
+
+	if fwResp.Error != nil {
+		return toproto6.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	functionDefinition, diags := s.FrameworkServer.FunctionDefinition(ctx, proto6Req.Name)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, function.FuncErrorFromDiags(ctx, diags))
+
+	if fwResp.Error != nil {
+		return toproto6.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	fwReq, funcErr := fromproto6.CallFunctionRequest(ctx, proto6Req, functionImpl, functionDefinition)
+
+	fwResp.Error = function.ConcatFuncErrors(fwResp.Error, funcErr)
+
+	if fwResp.Error != nil {
+		return toproto6.CallFunctionResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.InterceptFunction(ctx, proto6Req.Name, &fwResp.Error, func(ctx context.Context) {
+		s.FrameworkServer.CallFunction(ctx, fwReq, fwResp)
+	})
+
+	return toproto6.CallFunctionResponse(ctx, fwResp), nil
+}
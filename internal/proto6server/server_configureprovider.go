@@ -6,6 +6,7 @@ package proto6server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
@@ -36,7 +37,9 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationConfigureProvider, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
+	})
 
 	return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
 }
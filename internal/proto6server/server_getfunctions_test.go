@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerGetFunctions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov6.GetFunctionsRequest
+		expectedError    error
+		expectedResponse *tfprotov6.GetFunctionsResponse
+	}{
+		"functions": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithFunctions{
+						FunctionsMethod: func(_ context.Context) []func() function.Function {
+							return []func() function.Function{
+								func() function.Function {
+									return &testprovider.Function{
+										DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+											resp.Definition = function.Definition{
+												Parameters: []function.Parameter{
+													function.StringParameter{
+														Name: "input",
+													},
+												},
+												Return: function.StringReturn{},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+											resp.Name = "testfunction"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.GetFunctionsRequest{},
+			expectedResponse: &tfprotov6.GetFunctionsResponse{
+				Functions: map[string]*tfprotov6.Function{
+					"testfunction": {
+						Parameters: []*tfprotov6.FunctionParameter{
+							{
+								Name: "input",
+								Type: tftypes.String,
+							},
+						},
+						Return: &tfprotov6.FunctionReturn{
+							Type: tftypes.String,
+						},
+					},
+				},
+			},
+		},
+		"provider-without-functions": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: &tfprotov6.GetFunctionsRequest{},
+			expectedResponse: &tfprotov6.GetFunctionsResponse{
+				Functions: map[string]*tfprotov6.Function{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.GetFunctions(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
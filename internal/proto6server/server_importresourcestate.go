@@ -6,6 +6,7 @@ package proto6server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -36,7 +37,7 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto6.ImportResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
@@ -44,7 +45,17 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	fwReq, diags := fromproto6.ImportResourceStateRequest(ctx, proto6Req, resource, resourceSchema, identitySchema)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationImportResourceState, proto6Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
+	})
 
 	return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
 }
@@ -36,6 +36,12 @@ func TestServerImportResourceState(t *testing.T) {
 		"required": tftypes.NewValue(tftypes.String, nil),
 	})
 
+	testOtherStateDynamicValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "test-other-id"),
+		"optional": tftypes.NewValue(tftypes.String, nil),
+		"required": tftypes.NewValue(tftypes.String, nil),
+	})
+
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -184,6 +190,71 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-importedresources-additional": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithImportState{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+										},
+										ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+											resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+											other, diags := req.NewImportedResource(ctx, "test_other")
+
+											resp.Diagnostics.Append(diags...)
+
+											if resp.Diagnostics.HasError() {
+												return
+											}
+
+											resp.Diagnostics.Append(other.State.SetAttribute(ctx, path.Root("id"), "test-other-id")...)
+
+											resp.AdditionalResources = append(resp.AdditionalResources, other)
+										},
+									}
+								},
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_other"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ImportResourceStateRequest{
+				ID:       "test-id",
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.ImportResourceStateResponse{
+				ImportedResources: []*tfprotov6.ImportedResource{
+					{
+						State:    testStateDynamicValue,
+						TypeName: "test_resource",
+					},
+					{
+						State:    testOtherStateDynamicValue,
+						TypeName: "test_other",
+					},
+				},
+			},
+		},
 		"response-importedresources-private": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
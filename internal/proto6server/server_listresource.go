@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ListResource satisfies the tfprotov6.ListResourceServer interface.
+//
+// The request context is registered so the results stream stops when
+// Terraform calls the StopProvider RPC.
+func (s *Server) ListResource(ctx context.Context, proto6Req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+
+	var allDiags diag.Diagnostics
+
+	fwStream := &fwserver.ListResultsStream{}
+
+	listResource, diags := s.FrameworkServer.ListResourceType(ctx, proto6Req.TypeName)
+
+	allDiags.Append(diags...)
+
+	if allDiags.HasError() {
+		fwStream.Results = list.ListResultsStreamDiagnostics(allDiags)
+
+		return toproto6.ListResultsStream(ctx, fwStream), nil
+	}
+
+	listResourceSchema, diags := s.FrameworkServer.ListResourceSchema(ctx, proto6Req.TypeName)
+
+	allDiags.Append(diags...)
+
+	if allDiags.HasError() {
+		fwStream.Results = list.ListResultsStreamDiagnostics(allDiags)
+
+		return toproto6.ListResultsStream(ctx, fwStream), nil
+	}
+
+	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
+
+	allDiags.Append(diags...)
+
+	if allDiags.HasError() {
+		fwStream.Results = list.ListResultsStreamDiagnostics(allDiags)
+
+		return toproto6.ListResultsStream(ctx, fwStream), nil
+	}
+
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto6Req.TypeName)
+
+	allDiags.Append(diags...)
+
+	if allDiags.HasError() {
+		fwStream.Results = list.ListResultsStreamDiagnostics(allDiags)
+
+		return toproto6.ListResultsStream(ctx, fwStream), nil
+	}
+
+	fwReq, diags := fromproto6.ListResourceRequest(ctx, proto6Req, listResource, listResourceSchema, resourceSchema, identitySchema)
+
+	allDiags.Append(diags...)
+
+	if allDiags.HasError() {
+		fwStream.Results = list.ListResultsStreamDiagnostics(allDiags)
+
+		return toproto6.ListResultsStream(ctx, fwStream), nil
+	}
+
+	s.FrameworkServer.InterceptListResource(ctx, proto6Req.TypeName, fwStream, func(ctx context.Context) {
+		s.FrameworkServer.ListResource(ctx, fwReq, fwStream)
+	})
+
+	return toproto6.ListResultsStream(ctx, fwStream), nil
+}
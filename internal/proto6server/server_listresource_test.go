@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestServerListResource(t *testing.T) {
+	t.Parallel()
+
+	testConfigType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"filter": tftypes.String,
+		},
+	}
+
+	testConfigValue := tftypes.NewValue(testConfigType, map[string]tftypes.Value{
+		"filter": tftypes.NewValue(tftypes.String, "test-filter"),
+	})
+
+	testConfigDynamicValue, err := tfprotov6.NewDynamicValue(testConfigType, testConfigValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testIdentityDynamicValue := func(id string) *tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(testType, tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		}))
+
+		if err != nil {
+			t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+		}
+
+		return &dv
+	}
+
+	testProvider := func(resourceWithIdentity bool, ids ...string) *testprovider.ProviderWithListResources {
+		return &testprovider.ProviderWithListResources{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							r := &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												Computed: true,
+											},
+										},
+									}
+								},
+							}
+
+							if !resourceWithIdentity {
+								return r
+							}
+
+							return &testprovider.ResourceWithIdentity{
+								Resource: r,
+								IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+									resp.IdentitySchema = identityschema.Schema{
+										Attributes: map[string]identityschema.Attribute{
+											"id": identityschema.StringAttribute{
+												RequiredForImport: true,
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			ListResourcesMethod: func(_ context.Context) []func() list.ListResource {
+				return []func() list.ListResource{
+					func() list.ListResource {
+						return &testprovider.ListResource{
+							ListMethod: func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+								stream.Results = func(push func(list.ListResult) bool) {
+									for _, id := range ids {
+										result := req.NewListResult(ctx)
+										result.DisplayName = id
+										result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), id)...)
+
+										if !push(result) {
+											return
+										}
+									}
+								}
+							},
+							ListResourceConfigSchemaMethod: func(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+								resp.Schema = listschema.Schema{
+									Attributes: map[string]listschema.Attribute{
+										"filter": listschema.StringAttribute{
+											Optional: true,
+										},
+									},
+								}
+							},
+							MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = "test_resource"
+							},
+						}
+					},
+				}
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		server          *Server
+		request         *tfprotov6.ListResourceRequest
+		expectedError   error
+		expectedResults []tfprotov6.ListResourceResult
+	}{
+		"request-list-resource-not-found": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(true),
+				},
+			},
+			request: &tfprotov6.ListResourceRequest{
+				Config:   &testConfigDynamicValue,
+				TypeName: "test_other",
+			},
+			expectedResults: []tfprotov6.ListResourceResult{
+				{
+					Diagnostics: []*tfprotov6.Diagnostic{
+						{
+							Severity: tfprotov6.DiagnosticSeverityError,
+							Summary:  "List Resource Type Not Found",
+							Detail:   "No list resource type named \"test_other\" was found in the provider.",
+						},
+					},
+				},
+			},
+		},
+		"request-resource-without-identity": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(false, "one"),
+				},
+			},
+			request: &tfprotov6.ListResourceRequest{
+				Config:   &testConfigDynamicValue,
+				TypeName: "test_resource",
+			},
+			expectedResults: []tfprotov6.ListResourceResult{
+				{
+					Diagnostics: []*tfprotov6.Diagnostic{
+						{
+							Severity: tfprotov6.DiagnosticSeverityError,
+							Summary:  "Resource Identity Not Supported",
+							Detail: "The *testprovider.ListResource ListResource is tied to a managed resource type which does not define an identity schema. " +
+								"List results require a resource identity. " +
+								"This is always an issue with the provider and should be reported to the provider developers.",
+						},
+					},
+				},
+			},
+		},
+		"response-results": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(true, "one", "two"),
+				},
+			},
+			request: &tfprotov6.ListResourceRequest{
+				Config:   &testConfigDynamicValue,
+				TypeName: "test_resource",
+			},
+			expectedResults: []tfprotov6.ListResourceResult{
+				{
+					DisplayName: "one",
+					Identity: &tfprotov6.ResourceIdentityData{
+						IdentityData: testIdentityDynamicValue("one"),
+					},
+				},
+				{
+					DisplayName: "two",
+					Identity: &tfprotov6.ResourceIdentityData{
+						IdentityData: testIdentityDynamicValue("two"),
+					},
+				},
+			},
+		},
+		"response-results-limit": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(true, "one", "two"),
+				},
+			},
+			request: &tfprotov6.ListResourceRequest{
+				Config:   &testConfigDynamicValue,
+				Limit:    1,
+				TypeName: "test_resource",
+			},
+			expectedResults: []tfprotov6.ListResourceResult{
+				{
+					DisplayName: "one",
+					Identity: &tfprotov6.ResourceIdentityData{
+						IdentityData: testIdentityDynamicValue("one"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.ListResource(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResults, slices.Collect(got.Results)); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerListResourceStopProvider(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.ProviderWithListResources{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"id": identityschema.StringAttribute{
+													RequiredForImport: true,
+												},
+											},
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+				ListResourcesMethod: func(_ context.Context) []func() list.ListResource {
+					return []func() list.ListResource{
+						func() list.ListResource {
+							return &testprovider.ListResource{
+								ListMethod: func(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+									// Emit results indefinitely until the stream is stopped.
+									stream.Results = func(push func(list.ListResult) bool) {
+										for {
+											result := req.NewListResult(ctx)
+											result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), "test-id")...)
+
+											if !push(result) {
+												return
+											}
+										}
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	stream, err := server.ListResource(context.Background(), &tfprotov6.ListResourceRequest{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var count int
+
+	for result := range stream.Results {
+		if len(result.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+
+		count++
+
+		if count == 2 {
+			_, err := server.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+
+		if count > 2 {
+			t.Fatal("expected results stream to stop after StopProvider")
+		}
+	}
+
+	if count != 2 {
+		t.Errorf("expected 2 results, got: %d", count)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerMoveResourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"optional_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+			"required_attribute": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	schemaType := testSchema.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov6.MoveResourceStateRequest
+		expectedResponse *tfprotov6.MoveResourceStateResponse
+		expectedError    error
+	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request:          nil,
+			expectedResponse: &tfprotov6.MoveResourceStateResponse{},
+		},
+		"request-SourceState": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithMoveState{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+										},
+										MoveStateMethod: func(ctx context.Context) []resource.StateMover {
+											return []resource.StateMover{
+												{
+													StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+														expectedSourceRawState := testNewRawState(t, map[string]interface{}{
+															"id":                 "test-id-value",
+															"required_attribute": true,
+														})
+
+														if diff := cmp.Diff(req.SourceRawState, expectedSourceRawState); diff != "" {
+															resp.Diagnostics.AddError("Unexpected req.SourceRawState difference", diff)
+														}
+
+														resp.TargetState.Raw = tftypes.NewValue(schemaType, map[string]tftypes.Value{
+															"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+															"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+															"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+														})
+													},
+												},
+											}
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/examplecloud",
+				SourceState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": true,
+				}),
+				SourceTypeName: "examplecloud_thing",
+				TargetTypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.MoveResourceStateResponse{
+				TargetState: testNewDynamicValue(t, schemaType, map[string]tftypes.Value{
+					"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+					"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+					"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+				}),
+			},
+		},
+		"request-TargetTypeName-missing": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: &tfprotov6.MoveResourceStateRequest{},
+			expectedResponse: &tfprotov6.MoveResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Resource Type Not Found",
+						Detail:   "No resource type named \"\" was found in the provider.",
+					},
+				},
+			},
+		},
+		"request-TargetTypeName-unknown": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request: &tfprotov6.MoveResourceStateRequest{
+				TargetTypeName: "unknown",
+			},
+			expectedResponse: &tfprotov6.MoveResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Resource Type Not Found",
+						Detail:   "No resource type named \"unknown\" was found in the provider.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.MoveResourceState(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
@@ -867,6 +867,62 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"invalid-schema-implementation": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = schema.Schema{
+												Attributes: map[string]schema.Attribute{
+													"test_computed": schema.StringAttribute{
+														Computed:  true,
+														WriteOnly: true,
+													},
+													"test_required": schema.StringAttribute{
+														Required: true,
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				ProposedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				TypeName:   "test_resource",
+			},
+			expectedResponse: &tfprotov6.PlanResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Invalid Attribute Implementation",
+						Detail: "When validating the schema, an implementation issue was found. " +
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+							"\"test_computed\" is a WriteOnly Attribute that is also Computed. " +
+							"WriteOnly Attributes must be Optional or Required, as their values are never persisted to plan or state.",
+					},
+				},
+			},
+		},
 		"update-response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
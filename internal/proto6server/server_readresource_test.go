@@ -419,3 +419,89 @@ func TestServerReadResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadResource_DisablePanicRecovery(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testCurrentStateValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+	})
+
+	testServer := func(disablePanicRecovery bool) *Server {
+		return &Server{
+			FrameworkServer: fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = schema.Schema{
+											Attributes: map[string]schema.Attribute{
+												"test": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+									},
+									ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+										panic("test panic")
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			DisablePanicRecovery: disablePanicRecovery,
+		}
+	}
+
+	request := &tfprotov6.ReadResourceRequest{
+		CurrentState: testCurrentStateValue,
+		TypeName:     "test_resource",
+	}
+
+	got, err := testServer(false).ReadResource(context.Background(), request)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedResponse := &tfprotov6.ReadResourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Provider Panic",
+				Detail: "The provider panicked while calling the *testprovider.Resource Read method for test_resource. " +
+					"This is always an issue with the provider and should be reported to the provider developers. " +
+					"The provider logs contain the stack trace of the panic.\n\n" +
+					"Panic: test panic",
+			},
+		},
+		NewState: testCurrentStateValue,
+	}
+
+	if diff := cmp.Diff(got, expectedResponse); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	defer func() {
+		if r := recover(); r != "test panic" {
+			t.Errorf("expected test panic, got: %v", r)
+		}
+	}()
+
+	_, _ = testServer(true).ReadResource(context.Background(), request)
+
+	t.Fatal("expected panic")
+}
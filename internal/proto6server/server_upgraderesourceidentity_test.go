@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+func TestServerUpgradeResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+		Version: 1, // Must be above 0
+	}
+	identitySchemaType := testIdentitySchema.Type().TerraformType(ctx)
+
+	testProvider := &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.ResourceWithIdentityAndUpgradeIdentity{
+						Resource: &testprovider.Resource{
+							MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = "test_resource"
+							},
+						},
+						IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+							resp.IdentitySchema = testIdentitySchema
+						},
+						UpgradeIdentityMethod: func(ctx context.Context) map[int64]resource.IdentityUpgrader {
+							return map[int64]resource.IdentityUpgrader{
+								0: {
+									PriorSchema: &identityschema.Schema{
+										Attributes: map[string]identityschema.Attribute{
+											"name": identityschema.StringAttribute{
+												RequiredForImport: true,
+											},
+										},
+									},
+									IdentityUpgrader: func(ctx context.Context, req resource.UpgradeIdentityRequest, resp *resource.UpgradeIdentityResponse) {
+										var priorIdentityData struct {
+											Name string `tfsdk:"name"`
+										}
+
+										resp.Diagnostics.Append(req.Identity.Get(ctx, &priorIdentityData)...)
+
+										upgradedIdentityData := struct {
+											Id string `tfsdk:"id"`
+										}{
+											Id: priorIdentityData.Name,
+										}
+
+										resp.Diagnostics.Append(resp.Identity.Set(ctx, upgradedIdentityData)...)
+									},
+								},
+							}
+						},
+					}
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov6.UpgradeResourceIdentityRequest
+		expectedResponse *tfprotov6.UpgradeResourceIdentityResponse
+		expectedError    error
+	}{
+		"nil": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			request:          nil,
+			expectedResponse: &tfprotov6.UpgradeResourceIdentityResponse{},
+		},
+		"request-RawIdentity": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider,
+				},
+			},
+			request: &tfprotov6.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"name": "test-id-value",
+				}),
+				TypeName: "test_resource",
+				Version:  0,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfprotov6.ResourceIdentityData{
+					IdentityData: testNewDynamicValue(t, identitySchemaType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+					}),
+				},
+			},
+		},
+		"request-Version-current": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider,
+				},
+			},
+			request: &tfprotov6.UpgradeResourceIdentityRequest{
+				RawIdentity: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				TypeName: "test_resource",
+				Version:  1,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfprotov6.ResourceIdentityData{
+					IdentityData: testNewDynamicValue(t, identitySchemaType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+					}),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.UpgradeResourceIdentity(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
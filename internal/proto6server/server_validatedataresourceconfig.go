@@ -6,6 +6,7 @@ package proto6server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -44,7 +45,9 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationValidateDataSourceConfig, proto6Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
+	})
 
 	return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
 }
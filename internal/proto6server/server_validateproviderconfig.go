@@ -6,6 +6,7 @@ package proto6server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -36,7 +37,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationValidateProviderConfig, "", &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
+	})
 
 	return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
 }
@@ -6,6 +6,7 @@ package proto6server
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -44,7 +45,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
 	}
 
-	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	s.FrameworkServer.Intercept(ctx, interceptor.OperationValidateResourceConfig, proto6Req.TypeName, &fwResp.Diagnostics, func(ctx context.Context) {
+		s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+	})
 
 	return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
 }
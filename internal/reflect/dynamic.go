@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// underlyingValuer is implemented by dynamic values, such as
+// basetypes.DynamicValue, which wrap a value of a concrete type. It is
+// declared here as the basetypes package depends on this package.
+type underlyingValuer interface {
+	UnderlyingValue() attr.Value
+}
+
+// isDynamicType returns true if the given type is a dynamic type, whose
+// concrete type is only determined by its value.
+func isDynamicType(ctx context.Context, typ attr.Type) bool {
+	return typ.TerraformType(ctx).Is(tftypes.DynamicPseudoType)
+}
+
+// isNaturalDynamicTarget returns true if the given target type should receive
+// dynamic values as natural Go representations, which is the case for `any`,
+// `map[string]any`, and `[]any`.
+func isNaturalDynamicTarget(target reflect.Type) bool {
+	isAny := func(t reflect.Type) bool {
+		return t.Kind() == reflect.Interface && t.NumMethod() == 0
+	}
+
+	switch target.Kind() {
+	case reflect.Interface:
+		return isAny(target)
+	case reflect.Map:
+		return target.Key().Kind() == reflect.String && isAny(target.Elem())
+	case reflect.Slice:
+		return isAny(target.Elem())
+	default:
+		return false
+	}
+}
+
+// Dynamic builds a reflect.Value from a known, non-null dynamic value. If
+// `target` is `any`, `map[string]any`, or `[]any`, the value is converted into
+// its natural Go representation:
+//
+//   - bool for Bool values.
+//   - string for String values.
+//   - int64 for Number values which are integers that fit, otherwise float64
+//     for Number values which can be represented exactly, otherwise
+//     *big.Float.
+//   - []any for List, Set, and Tuple values.
+//   - map[string]any for Map and Object values.
+//   - nil for null values.
+//
+// Any other target is populated using the concrete type of the value, as if
+// the value was defined with that type in the schema.
+//
+// It is meant to be called through BuildValue, not directly.
+func Dynamic(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if isNaturalDynamicTarget(target.Type()) {
+		goVal, goValDiags := dynamicToGo(ctx, typ, val, target.Type(), opts, path)
+		diags.Append(goValDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+
+		result := reflect.New(target.Type()).Elem()
+
+		if goVal == nil {
+			return result, diags
+		}
+
+		goValue := reflect.ValueOf(goVal)
+
+		if !goValue.Type().AssignableTo(target.Type()) {
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Received dynamic value of type %s, which cannot be represented by the target type.\n\n", val.Type())+
+					fmt.Sprintf("Path: %s\nTarget Type: %s\nDynamic Value Go Type: %s\nSuggested Type: %s", path.String(), target.Type(), goValue.Type(), reflect.TypeOf(typ.ValueType(ctx))),
+			)
+			return target, diags
+		}
+
+		result.Set(goValue)
+
+		return result, diags
+	}
+
+	attrValue, err := typ.ValueFromTerraform(ctx, val)
+
+	if err != nil {
+		return target, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	dynamicValue, ok := attrValue.(underlyingValuer)
+
+	if !ok || dynamicValue.UnderlyingValue() == nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to determine the underlying type of dynamic value type %T. ", attrValue)+
+				"Use the corresponding `types` package type, `any`, `map[string]any`, or `[]any`.\n\n"+
+				fmt.Sprintf("Path: %s\nTarget Type: %s", path.String(), target.Type()),
+		)
+		return target, diags
+	}
+
+	return BuildValue(ctx, dynamicValue.UnderlyingValue().Type(ctx), val, target, opts, path)
+}
+
+// dynamicToGo returns the natural Go representation of a dynamic value. The
+// dynamic `typ` is used to build set element paths and `targetType` is only
+// used for diagnostics.
+func dynamicToGo(ctx context.Context, typ attr.Type, val tftypes.Value, targetType reflect.Type, opts Options, path path.Path) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !val.IsKnown() {
+		if opts.UnhandledUnknownAsEmpty {
+			return nil, diags
+		}
+
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received unknown value in a dynamic value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+				fmt.Sprintf("Path: %s\nTarget Type: %s\nSuggested Type: %s", path.String(), targetType, reflect.TypeOf(typ.ValueType(ctx))),
+		)
+		return nil, diags
+	}
+
+	if val.IsNull() {
+		return nil, diags
+	}
+
+	switch valType := val.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, append(diags, DiagIntoIncompatibleType{Val: val, TargetType: targetType, Err: err})
+		}
+
+		result := make([]any, 0, len(elems))
+
+		for i, elem := range elems {
+			elemPath := path.AtListIndex(i)
+
+			if _, ok := valType.(tftypes.Set); ok {
+				elemValue, err := typ.ValueFromTerraform(ctx, elem)
+
+				if err != nil {
+					return nil, append(diags, valueFromTerraformErrorDiag(err, elemPath))
+				}
+
+				elemPath = path.AtSetValue(elemValue)
+			}
+
+			goElem, goElemDiags := dynamicToGo(ctx, typ, elem, targetType, opts, elemPath)
+			diags.Append(goElemDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result = append(result, goElem)
+		}
+
+		return result, diags
+	case tftypes.Map, tftypes.Object:
+		var attrs map[string]tftypes.Value
+
+		if err := val.As(&attrs); err != nil {
+			return nil, append(diags, DiagIntoIncompatibleType{Val: val, TargetType: targetType, Err: err})
+		}
+
+		result := make(map[string]any, len(attrs))
+
+		for key, attrVal := range attrs {
+			attrPath := path.AtName(key)
+
+			if _, ok := valType.(tftypes.Map); ok {
+				attrPath = path.AtMapKey(key)
+			}
+
+			goAttr, goAttrDiags := dynamicToGo(ctx, typ, attrVal, targetType, opts, attrPath)
+			diags.Append(goAttrDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result[key] = goAttr
+		}
+
+		return result, diags
+	}
+
+	switch {
+	case val.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := val.As(&b); err != nil {
+			return nil, append(diags, DiagIntoIncompatibleType{Val: val, TargetType: targetType, Err: err})
+		}
+
+		return b, diags
+	case val.Type().Is(tftypes.String):
+		var s string
+
+		if err := val.As(&s); err != nil {
+			return nil, append(diags, DiagIntoIncompatibleType{Val: val, TargetType: targetType, Err: err})
+		}
+
+		return s, diags
+	case val.Type().Is(tftypes.Number):
+		bf := big.NewFloat(0)
+
+		if err := val.As(&bf); err != nil {
+			return nil, append(diags, DiagIntoIncompatibleType{Val: val, TargetType: targetType, Err: err})
+		}
+
+		if bf.IsInt() {
+			if i, accuracy := bf.Int64(); accuracy == big.Exact {
+				return i, diags
+			}
+		}
+
+		if f, accuracy := bf.Float64(); accuracy == big.Exact {
+			return f, diags
+		}
+
+		return bf, diags
+	}
+
+	diags.AddAttributeError(
+		path,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Received dynamic value of unsupported type %s.\n\n", val.Type())+
+			fmt.Sprintf("Path: %s\nTarget Type: %s", path.String(), targetType),
+	)
+
+	return nil, diags
+}
+
+// FromDynamic returns an attr.Value as produced by the dynamic `typ` from
+// any Go value. As there is no type information in the schema, the Terraform
+// type is inferred from the Go value:
+//
+//   - Bool for bool values.
+//   - String for string values.
+//   - Number for integer, float, *big.Int, and *big.Float values.
+//   - Tuple for slice and array values, as element types may differ.
+//   - Object for map values with string keys and for struct values, using the
+//     "tfsdk" struct tags as attribute names.
+//   - The value's own type for attr.Value values.
+//   - A null value without a type for nil values and nil pointers.
+//
+// It is meant to be called through FromValue, not directly.
+func FromDynamic(ctx context.Context, typ attr.Type, val any, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Preserve dynamic values, including custom dynamic value types, rather
+	// than rebuilding them from their underlying value.
+	if v, ok := val.(attr.Value); ok && isDynamicType(ctx, v.Type(ctx)) {
+		return FromAttributeValue(ctx, typ, v, path)
+	}
+
+	tfVal, tfValDiags := goToDynamicTerraformValue(ctx, val, path)
+	diags.Append(tfValDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	dynamic, err := typ.ValueFromTerraform(ctx, tfVal)
+
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return dynamic, diags
+}
+
+// goToDynamicTerraformValue returns a tftypes.Value with a Terraform type
+// inferred from the given Go value. See FromDynamic for the inference rules.
+func goToDynamicTerraformValue(ctx context.Context, val any, path path.Path) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nullValue := tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+
+	switch v := val.(type) {
+	case nil:
+		return nullValue, diags
+	case attr.Value:
+		tfVal, err := v.ToTerraformValue(ctx)
+
+		if err != nil {
+			return nullValue, append(diags, toTerraformValueErrorDiag(err, path))
+		}
+
+		return tfVal, diags
+	case *big.Float:
+		if v == nil {
+			return nullValue, diags
+		}
+
+		return tftypes.NewValue(tftypes.Number, v), diags
+	case *big.Int:
+		if v == nil {
+			return nullValue, diags
+		}
+
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetInt(v)), diags
+	}
+
+	value := reflect.ValueOf(val)
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nullValue, diags
+		}
+
+		return goToDynamicTerraformValue(ctx, value.Elem().Interface(), path)
+	case reflect.Bool:
+		return tftypes.NewValue(tftypes.Bool, value.Bool()), diags
+	case reflect.String:
+		return tftypes.NewValue(tftypes.String, value.String()), diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(value.Int())), diags
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(value.Uint())), diags
+	case reflect.Float32, reflect.Float64:
+		f := value.Float()
+
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Cannot store %v as a Terraform number in a dynamic value.", f),
+			)
+			return nullValue, diags
+		}
+
+		return tftypes.NewValue(tftypes.Number, big.NewFloat(f)), diags
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nullValue, diags
+		}
+
+		elemTypes := make([]tftypes.Type, 0, value.Len())
+		elems := make([]tftypes.Value, 0, value.Len())
+
+		for i := 0; i < value.Len(); i++ {
+			elem, elemDiags := goToDynamicTerraformValue(ctx, value.Index(i).Interface(), path.AtListIndex(i))
+			diags.Append(elemDiags...)
+
+			if diags.HasError() {
+				return nullValue, diags
+			}
+
+			elemTypes = append(elemTypes, elem.Type())
+			elems = append(elems, elem)
+		}
+
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: elemTypes}, elems), diags
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Cannot infer a Terraform type for a dynamic value from %T, map keys must be strings.", val),
+			)
+			return nullValue, diags
+		}
+
+		if value.IsNil() {
+			return nullValue, diags
+		}
+
+		attrTypes := make(map[string]tftypes.Type, value.Len())
+		attrs := make(map[string]tftypes.Value, value.Len())
+		iter := value.MapRange()
+
+		for iter.Next() {
+			key := iter.Key().String()
+
+			attrVal, attrDiags := goToDynamicTerraformValue(ctx, iter.Value().Interface(), path.AtName(key))
+			diags.Append(attrDiags...)
+
+			if diags.HasError() {
+				return nullValue, diags
+			}
+
+			attrTypes[key] = attrVal.Type()
+			attrs[key] = attrVal
+		}
+
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, attrs), diags
+	case reflect.Struct:
+		tags, err := getStructTags(ctx, value, path)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nullValue, diags
+		}
+
+		attrTypes := make(map[string]tftypes.Type, len(tags))
+		attrs := make(map[string]tftypes.Value, len(tags))
+
+		for name, fieldNo := range tags {
+			attrVal, attrDiags := goToDynamicTerraformValue(ctx, value.Field(fieldNo).Interface(), path.AtName(name))
+			diags.Append(attrDiags...)
+
+			if diags.HasError() {
+				return nullValue, diags
+			}
+
+			attrTypes[name] = attrVal.Type()
+			attrs[name] = attrVal
+		}
+
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, attrs), diags
+	default:
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot infer a Terraform type for a dynamic value from %T (%s).", val, value.Kind()),
+		)
+		return nullValue, diags
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInto_dynamicAny(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		val           tftypes.Value
+		opts          refl.Options
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"bool": {
+			val:      tftypes.NewValue(tftypes.Bool, true),
+			expected: true,
+		},
+		"string": {
+			val:      tftypes.NewValue(tftypes.String, "hello"),
+			expected: "hello",
+		},
+		"number-int": {
+			val:      tftypes.NewValue(tftypes.Number, 123),
+			expected: int64(123),
+		},
+		"number-float": {
+			val:      tftypes.NewValue(tftypes.Number, 1.5),
+			expected: 1.5,
+		},
+		"number-big": {
+			val:      tftypes.NewValue(tftypes.Number, new(big.Float).SetPrec(256).Quo(big.NewFloat(1), big.NewFloat(3))),
+			expected: new(big.Float).SetPrec(256).Quo(big.NewFloat(1), big.NewFloat(3)),
+		},
+		"null": {
+			val:      tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expected: nil,
+		},
+		"underlying-null": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: nil,
+		},
+		"tuple": {
+			val: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number, tftypes.Bool}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Bool, nil),
+				},
+			),
+			expected: []any{"hello", int64(1), nil},
+		},
+		"set": {
+			val: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expected: []any{"hello"},
+		},
+		"object": {
+			val: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+					"tags": tftypes.Map{ElementType: tftypes.String},
+				}},
+				map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello"),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"key": tftypes.NewValue(tftypes.String, "value"),
+					}),
+				},
+			),
+			expected: map[string]any{
+				"name": "hello",
+				"tags": map[string]any{
+					"key": "value",
+				},
+			},
+		},
+		"nested-unknown": {
+			val: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+				}},
+				map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtName("list").AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value in a dynamic value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: test.list[0]\nTarget Type: interface {}\nSuggested Type: basetypes.DynamicValue",
+				),
+			},
+		},
+		"nested-unknown-UnhandledUnknownAsEmpty": {
+			val: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			opts: refl.Options{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: []any{"hello", nil},
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got any
+
+			diags := refl.Into(context.Background(), types.DynamicType, tc.val, &got, tc.opts, path.Root("test"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected, cmp.Comparer(func(x, y *big.Float) bool { return x.Cmp(y) == 0 })); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_dynamicTypedTargets(t *testing.T) {
+	t.Parallel()
+
+	objectVal := tftypes.NewValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		}},
+		map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "hello"),
+		},
+	)
+	listVal := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+	})
+
+	t.Run("map-string-any", func(t *testing.T) {
+		t.Parallel()
+
+		var got map[string]any
+
+		diags := refl.Into(context.Background(), types.DynamicType, objectVal, &got, refl.Options{}, path.Root("test"))
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if diff := cmp.Diff(got, map[string]any{"name": "hello"}); diff != "" {
+			t.Errorf("unexpected result (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("slice-any", func(t *testing.T) {
+		t.Parallel()
+
+		var got []any
+
+		diags := refl.Into(context.Background(), types.DynamicType, listVal, &got, refl.Options{}, path.Root("test"))
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if diff := cmp.Diff(got, []any{"hello"}); diff != "" {
+			t.Errorf("unexpected result (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("slice-any-mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		var got []any
+
+		diags := refl.Into(context.Background(), types.DynamicType, objectVal, &got, refl.Options{}, path.Root("test"))
+
+		expectedDiags := diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("test"),
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Received dynamic value of type tftypes.Object[\"name\":tftypes.String], which cannot be represented by the target type.\n\n"+
+					"Path: test\nTarget Type: []interface {}\nDynamic Value Go Type: map[string]interface {}\nSuggested Type: basetypes.DynamicValue",
+			),
+		}
+
+		if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+			t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		var got string
+
+		diags := refl.Into(context.Background(), types.DynamicType, tftypes.NewValue(tftypes.String, "hello"), &got, refl.Options{}, path.Root("test"))
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if got != "hello" {
+			t.Errorf("expected %q, got %q", "hello", got)
+		}
+	})
+
+	t.Run("slice-string", func(t *testing.T) {
+		t.Parallel()
+
+		var got []string
+
+		diags := refl.Into(context.Background(), types.DynamicType, listVal, &got, refl.Options{}, path.Root("test"))
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if diff := cmp.Diff(got, []string{"hello"}); diff != "" {
+			t.Errorf("unexpected result (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		t.Parallel()
+
+		var got struct {
+			Name string `tfsdk:"name"`
+		}
+
+		diags := refl.Into(context.Background(), types.DynamicType, objectVal, &got, refl.Options{}, path.Root("test"))
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if got.Name != "hello" {
+			t.Errorf("expected %q, got %q", "hello", got.Name)
+		}
+	})
+
+	t.Run("struct-field-any", func(t *testing.T) {
+		t.Parallel()
+
+		var got struct {
+			Value any `tfsdk:"value"`
+		}
+
+		objectType := types.ObjectType{AttrTypes: map[string]attr.Type{"value": types.DynamicType}}
+		val := tftypes.NewValue(
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{"value": tftypes.DynamicPseudoType}},
+			map[string]tftypes.Value{
+				"value": tftypes.NewValue(tftypes.Bool, true),
+			},
+		)
+
+		diags := refl.Into(context.Background(), objectType, val, &got, refl.Options{}, path.Empty())
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if got.Value != true {
+			t.Errorf("expected %v, got %v", true, got.Value)
+		}
+	})
+}
+
+func TestFromDynamic(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name   string  `tfsdk:"name"`
+		Count  *int64  `tfsdk:"count"`
+		Ignore float64 `tfsdk:"-"`
+	}
+
+	cases := map[string]struct {
+		val           any
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val:      nil,
+			expected: types.DynamicNull(),
+		},
+		"bool": {
+			val:      true,
+			expected: types.DynamicValue(types.BoolValue(true)),
+		},
+		"string": {
+			val:      "hello",
+			expected: types.DynamicValue(types.StringValue("hello")),
+		},
+		"int": {
+			val:      123,
+			expected: types.DynamicValue(types.NumberValue(big.NewFloat(123))),
+		},
+		"float": {
+			val:      1.5,
+			expected: types.DynamicValue(types.NumberValue(big.NewFloat(1.5))),
+		},
+		"pointer-nil": {
+			val:      (*string)(nil),
+			expected: types.DynamicNull(),
+		},
+		"pointer": {
+			val:      &[]string{"hello"}[0],
+			expected: types.DynamicValue(types.StringValue("hello")),
+		},
+		"attr-value": {
+			val:      types.StringValue("hello"),
+			expected: types.DynamicValue(types.StringValue("hello")),
+		},
+		"dynamic-value": {
+			val:      types.DynamicValue(types.StringValue("hello")),
+			expected: types.DynamicValue(types.StringValue("hello")),
+		},
+		"slice": {
+			val: []any{"hello", true},
+			expected: types.DynamicValue(basetypes.NewTupleValueMust(
+				[]attr.Type{types.StringType, types.BoolType},
+				[]attr.Value{types.StringValue("hello"), types.BoolValue(true)},
+			)),
+		},
+		"map": {
+			val: map[string]any{"name": "hello", "enabled": nil},
+			expected: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType, "enabled": types.DynamicType},
+				map[string]attr.Value{"name": types.StringValue("hello"), "enabled": types.DynamicNull()},
+			)),
+		},
+		"struct": {
+			val: testStruct{Name: "hello", Ignore: 1.5},
+			expected: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType, "count": types.DynamicType},
+				map[string]attr.Value{"name": types.StringValue("hello"), "count": types.DynamicNull()},
+			)),
+		},
+		"map-non-string-keys": {
+			val: map[int]string{1: "hello"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer a Terraform type for a dynamic value from map[int]string, map keys must be strings.",
+				),
+			},
+		},
+		"nested-unsupported": {
+			val: map[string]any{"func": func() {}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtName("func"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer a Terraform type for a dynamic value from func() (func).",
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), types.DynamicType, tc.val, path.Root("test"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -148,6 +148,13 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 
 		return target, diags
 	}
+	// dynamic values have no type information in the schema, so the
+	// concrete type of the value determines how it is reflected
+	if isDynamicType(ctx, typ) {
+		val, valDiags := Dynamic(ctx, typ, val, target, opts, path)
+		diags.Append(valDiags...)
+		return val, diags
+	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
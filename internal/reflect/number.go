@@ -169,6 +169,12 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		}
 	case reflect.Float32:
 		floatResult, acc := result.Float32()
+		if acc != big.Exact && isShortestFloat32(result, floatResult) {
+			// The number is the shortest decimal representation of a 32-bit
+			// float, such as 1.1 from a Float32 value or configuration, which
+			// is not a rounding of the intended value.
+			acc = big.Exact
+		}
 		if acc != big.Exact && !opts.AllowRoundingNumbers {
 			return target, append(diags, roundingErrorDiag)
 		} else if acc == big.Above {
@@ -238,6 +244,23 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	return target, diags
 }
 
+// isShortestFloat32 returns true if the given number equals the shortest
+// decimal representation of the given float32, at the precision of the
+// number.
+func isShortestFloat32(number *big.Float, f float32) bool {
+	if math.IsInf(float64(f), 0) || f == 0 {
+		return false
+	}
+
+	shortest, _, err := big.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 10, number.Prec(), big.ToNearestEven)
+
+	if err != nil {
+		return false
+	}
+
+	return shortest.Cmp(number) == 0
+}
+
 // FromInt creates an attr.Value using `typ` from an int64.
 //
 // It is meant to be called through FromValue, not directly.
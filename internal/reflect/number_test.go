@@ -943,6 +943,76 @@ func TestNumber_uint64UnderflowError(t *testing.T) {
 
 func TestNumber_float32(t *testing.T) {
 	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.Float32Type, tftypes.NewValue(tftypes.Number, 123), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 123 {
+		t.Errorf("Expected %v, got %v", 123, n)
+	}
+}
+
+func TestNumber_float32ShortestDecimal(t *testing.T) {
+	t.Parallel()
+
+	float32Value, err := types.Float32Value(1.1).ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		val tftypes.Value
+	}{
+		"float64": {
+			val: tftypes.NewValue(tftypes.Number, 1.1),
+		},
+		"Float32Value": {
+			val: float32Value,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var n float32
+
+			result, diags := refl.Number(context.Background(), types.Float32Type, testCase.val, reflect.ValueOf(n), refl.Options{}, path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			reflect.ValueOf(&n).Elem().Set(result)
+			if n != 1.1 {
+				t.Errorf("Expected %v, got %v", float32(1.1), n)
+			}
+		})
+	}
+}
+
+func TestNumber_float32RoundingError(t *testing.T) {
+	t.Parallel()
+
+	var n float32
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Empty(),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 1.23456789 in float32",
+		),
+	}
+
+	_, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 1.23456789), reflect.ValueOf(n), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
 }
 
 func TestNumber_float32Overflow(t *testing.T) {
@@ -1195,6 +1265,33 @@ func TestFromInt(t *testing.T) {
 			typ:      types.NumberType,
 			expected: types.NumberValue(big.NewFloat(1)),
 		},
+		"Int32Type": {
+			val:      math.MaxInt32,
+			typ:      types.Int32Type,
+			expected: types.Int32Value(math.MaxInt32),
+		},
+		"Int32Type-overflow": {
+			val: math.MaxInt32 + 1,
+			typ: types.Int32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Int32 Type Validation Error",
+					"Value %!s(*big.Float=2147483648) cannot be represented as a 32-bit integer.",
+				),
+			},
+		},
+		"Int32Type-underflow": {
+			val: math.MinInt32 - 1,
+			typ: types.Int32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Int32 Type Validation Error",
+					"Value %!s(*big.Float=-2147483649) cannot be represented as a 32-bit integer.",
+				),
+			},
+		},
 		"WithValidateWarning": {
 			val: 1,
 			typ: testtypes.NumberTypeWithValidateWarning{},
@@ -1312,6 +1409,33 @@ func TestFromFloat(t *testing.T) {
 			typ:      types.NumberType,
 			expected: types.NumberValue(big.NewFloat(1.234)),
 		},
+		"Float32Type": {
+			val:      1.25,
+			typ:      types.Float32Type,
+			expected: types.Float32Value(1.25),
+		},
+		"Float32Type-overflow": {
+			val: math.MaxFloat64,
+			typ: types.Float32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Float32 Type Validation Error",
+					"Value %!s(*big.Float=1.797693135e+308) cannot be represented as a 32-bit floating point.",
+				),
+			},
+		},
+		"Float32Type-underflow": {
+			val: math.SmallestNonzeroFloat64,
+			typ: types.Float32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Float32 Type Validation Error",
+					"Value %!s(*big.Float=4.940656458e-324) cannot be represented as a 32-bit floating point.",
+				),
+			},
+		},
 		"WithValidateWarning": {
 			val: 1,
 			typ: testtypes.NumberTypeWithValidateWarning{},
@@ -22,6 +22,12 @@ import (
 func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	// dynamic values have no type information in the schema, so the
+	// Terraform type is inferred from the Go value
+	if isDynamicType(ctx, typ) {
+		return FromDynamic(ctx, typ, val, path)
+	}
+
 	if v, ok := val.(attr.Value); ok {
 		return FromAttributeValue(ctx, typ, v, path)
 	}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+var _ ephemeral.EphemeralResource = &EphemeralResourceWithValidateConfig{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &EphemeralResourceWithValidateConfig{}
+
+// Declarative ephemeral.EphemeralResourceWithValidateConfig for unit testing.
+type EphemeralResourceWithValidateConfig struct {
+	*EphemeralResource
+
+	// EphemeralResourceWithValidateConfig interface methods
+	ValidateConfigMethod func(context.Context, ephemeral.ValidateConfigRequest, *ephemeral.ValidateConfigResponse)
+}
+
+// ValidateConfig satisfies the ephemeral.EphemeralResourceWithValidateConfig interface.
+func (r *EphemeralResourceWithValidateConfig) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	if r.ValidateConfigMethod == nil {
+		return
+	}
+
+	r.ValidateConfigMethod(ctx, req, resp)
+}
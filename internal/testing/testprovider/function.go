@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &Function{}
+
+// Declarative function.Function for unit testing.
+type Function struct {
+	// Function interface methods
+	DefinitionMethod func(context.Context, function.DefinitionRequest, *function.DefinitionResponse)
+	MetadataMethod   func(context.Context, function.MetadataRequest, *function.MetadataResponse)
+	RunMethod        func(context.Context, function.RunRequest, *function.RunResponse)
+}
+
+// Definition satisfies the function.Function interface.
+func (f *Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	if f.DefinitionMethod == nil {
+		return
+	}
+
+	f.DefinitionMethod(ctx, req, resp)
+}
+
+// Metadata satisfies the function.Function interface.
+func (f *Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	if f.MetadataMethod == nil {
+		return
+	}
+
+	f.MetadataMethod(ctx, req, resp)
+}
+
+// Run satisfies the function.Function interface.
+func (f *Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	if f.RunMethod == nil {
+		return
+	}
+
+	f.RunMethod(ctx, req, resp)
+}
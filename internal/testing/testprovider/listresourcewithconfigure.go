@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ list.ListResource = &ListResourceWithConfigure{}
+var _ list.ListResourceWithConfigure = &ListResourceWithConfigure{}
+
+// Declarative list.ListResourceWithConfigure for unit testing.
+type ListResourceWithConfigure struct {
+	*ListResource
+
+	// ListResourceWithConfigure interface methods
+	ConfigureMethod func(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse)
+}
+
+// Configure satisfies the list.ListResourceWithConfigure interface.
+func (r *ListResourceWithConfigure) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.ConfigureMethod == nil {
+		return
+	}
+
+	r.ConfigureMethod(ctx, req, resp)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithEphemeralResources{}
+var _ provider.ProviderWithEphemeralResources = &ProviderWithEphemeralResources{}
+
+// Declarative provider.ProviderWithEphemeralResources for unit testing.
+type ProviderWithEphemeralResources struct {
+	*Provider
+
+	// ProviderWithEphemeralResources interface methods
+	EphemeralResourcesMethod func(context.Context) []func() ephemeral.EphemeralResource
+}
+
+// EphemeralResources satisfies the provider.ProviderWithEphemeralResources interface.
+func (p *ProviderWithEphemeralResources) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	if p.EphemeralResourcesMethod == nil {
+		return nil
+	}
+
+	return p.EphemeralResourcesMethod(ctx)
+}
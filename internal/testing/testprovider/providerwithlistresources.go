@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithListResources{}
+var _ provider.ProviderWithListResources = &ProviderWithListResources{}
+
+// Declarative provider.ProviderWithListResources for unit testing.
+type ProviderWithListResources struct {
+	*Provider
+
+	// ProviderWithListResources interface methods
+	ListResourcesMethod func(context.Context) []func() list.ListResource
+}
+
+// ListResources satisfies the provider.ProviderWithListResources interface.
+func (p *ProviderWithListResources) ListResources(ctx context.Context) []func() list.ListResource {
+	if p.ListResourcesMethod == nil {
+		return nil
+	}
+
+	return p.ListResourcesMethod(ctx)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConcurrencyLimit{}
+var _ resource.ResourceWithConcurrencyLimit = &ResourceWithConcurrencyLimit{}
+
+// Declarative resource.ResourceWithConcurrencyLimit for unit testing.
+type ResourceWithConcurrencyLimit struct {
+	*Resource
+
+	// ResourceWithConcurrencyLimit interface methods
+	ConcurrencyLimitMethod func(context.Context, resource.ConcurrencyLimitRequest, *resource.ConcurrencyLimitResponse)
+}
+
+// ConcurrencyLimit satisfies the resource.ResourceWithConcurrencyLimit
+// interface.
+func (p *ResourceWithConcurrencyLimit) ConcurrencyLimit(ctx context.Context, req resource.ConcurrencyLimitRequest, resp *resource.ConcurrencyLimitResponse) {
+	if p.ConcurrencyLimitMethod == nil {
+		return
+	}
+
+	p.ConcurrencyLimitMethod(ctx, req, resp)
+}
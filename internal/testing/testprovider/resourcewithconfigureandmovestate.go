@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConfigureAndMoveState{}
+var _ resource.ResourceWithConfigure = &ResourceWithConfigureAndMoveState{}
+var _ resource.ResourceWithMoveState = &ResourceWithConfigureAndMoveState{}
+
+// Declarative resource.ResourceWithConfigureAndMoveState for unit testing.
+type ResourceWithConfigureAndMoveState struct {
+	*Resource
+
+	// ResourceWithConfigureAndMoveState interface methods
+	ConfigureMethod func(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse)
+
+	// ResourceWithMoveState interface methods
+	MoveStateMethod func(context.Context) []resource.StateMover
+}
+
+// Configure satisfies the resource.ResourceWithConfigureAndMoveState interface.
+func (r *ResourceWithConfigureAndMoveState) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.ConfigureMethod == nil {
+		return
+	}
+
+	r.ConfigureMethod(ctx, req, resp)
+}
+
+// MoveState satisfies the resource.ResourceWithMoveState interface.
+func (r *ResourceWithConfigureAndMoveState) MoveState(ctx context.Context) []resource.StateMover {
+	if r.MoveStateMethod == nil {
+		return nil
+	}
+
+	return r.MoveStateMethod(ctx)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithIdentityAndImportState{}
+var _ resource.ResourceWithIdentity = &ResourceWithIdentityAndImportState{}
+var _ resource.ResourceWithImportState = &ResourceWithIdentityAndImportState{}
+
+// Declarative resource.ResourceWithIdentity and
+// resource.ResourceWithImportState for unit testing.
+type ResourceWithIdentityAndImportState struct {
+	*Resource
+
+	// ResourceWithIdentity interface methods
+	IdentitySchemaMethod func(context.Context, resource.IdentitySchemaRequest, *resource.IdentitySchemaResponse)
+
+	// ResourceWithImportState interface methods
+	ImportStateMethod func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+}
+
+// IdentitySchema satisfies the resource.ResourceWithIdentity interface.
+func (p *ResourceWithIdentityAndImportState) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if p.IdentitySchemaMethod == nil {
+		return
+	}
+
+	p.IdentitySchemaMethod(ctx, req, resp)
+}
+
+// ImportState satisfies the resource.ResourceWithImportState interface.
+func (p *ResourceWithIdentityAndImportState) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if p.ImportStateMethod == nil {
+		return
+	}
+
+	p.ImportStateMethod(ctx, req, resp)
+}
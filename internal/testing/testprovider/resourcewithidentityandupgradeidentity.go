@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithIdentityAndUpgradeIdentity{}
+var _ resource.ResourceWithIdentity = &ResourceWithIdentityAndUpgradeIdentity{}
+var _ resource.ResourceWithUpgradeIdentity = &ResourceWithIdentityAndUpgradeIdentity{}
+
+// Declarative resource.ResourceWithUpgradeIdentity for unit testing.
+type ResourceWithIdentityAndUpgradeIdentity struct {
+	*Resource
+
+	// ResourceWithIdentity interface methods
+	IdentitySchemaMethod func(context.Context, resource.IdentitySchemaRequest, *resource.IdentitySchemaResponse)
+
+	// ResourceWithUpgradeIdentity interface methods
+	UpgradeIdentityMethod func(context.Context) map[int64]resource.IdentityUpgrader
+}
+
+// IdentitySchema satisfies the resource.ResourceWithIdentity interface.
+func (p *ResourceWithIdentityAndUpgradeIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if p.IdentitySchemaMethod == nil {
+		return
+	}
+
+	p.IdentitySchemaMethod(ctx, req, resp)
+}
+
+// UpgradeIdentity satisfies the resource.ResourceWithUpgradeIdentity interface.
+func (p *ResourceWithIdentityAndUpgradeIdentity) UpgradeIdentity(ctx context.Context) map[int64]resource.IdentityUpgrader {
+	if p.UpgradeIdentityMethod == nil {
+		return nil
+	}
+
+	return p.UpgradeIdentityMethod(ctx)
+}
@@ -19,6 +19,7 @@ type Attribute struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -77,3 +78,8 @@ func (a Attribute) IsRequired() bool {
 func (a Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a Attribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithBoolDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Bool
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithBoolDefaultValue) IsRequired() bool {
 func (a AttributeWithBoolDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithBoolDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithBoolPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Bool
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithBoolPlanModifiers) IsRequired() bool {
 func (a AttributeWithBoolPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithBoolPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
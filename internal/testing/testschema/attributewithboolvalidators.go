@@ -22,6 +22,7 @@ type AttributeWithBoolValidators struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Validators          []validator.Bool
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithBoolValidators) IsRequired() bool {
 func (a AttributeWithBoolValidators) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithBoolValidators) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithFloat64PlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Float64
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithFloat64PlanModifiers) IsRequired() bool {
 func (a AttributeWithFloat64PlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat64PlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithInt64DefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Int64
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithInt64DefaultValue) IsRequired() bool {
 func (a AttributeWithInt64DefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithInt64DefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithInt64PlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Int64
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithInt64PlanModifiers) IsRequired() bool {
 func (a AttributeWithInt64PlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithInt64PlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -22,6 +22,7 @@ type AttributeWithInt64Validators struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Validators          []validator.Int64
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithInt64Validators) IsRequired() bool {
 func (a AttributeWithInt64Validators) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithInt64Validators) IsWriteOnly() bool {
+	return a.WriteOnly
+}
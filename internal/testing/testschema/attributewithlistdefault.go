@@ -23,6 +23,7 @@ type AttributeWithListDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.List
 }
 
@@ -88,3 +89,8 @@ func (a AttributeWithListDefaultValue) IsRequired() bool {
 func (a AttributeWithListDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithListDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -23,6 +23,7 @@ type AttributeWithListPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.List
 }
 
@@ -84,6 +85,11 @@ func (a AttributeWithListPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithListPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ListPlanModifiers satisfies the fwxschema.AttributeWithListPlanModifiers interface.
 func (a AttributeWithListPlanModifiers) ListPlanModifiers() []planmodifier.List {
 	return a.PlanModifiers
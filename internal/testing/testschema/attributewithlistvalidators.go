@@ -23,6 +23,7 @@ type AttributeWithListValidators struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Validators          []validator.List
 }
 
@@ -84,6 +85,11 @@ func (a AttributeWithListValidators) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithListValidators) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ListValidators satisfies the fwxschema.AttributeWithListValidators interface.
 func (a AttributeWithListValidators) ListValidators() []validator.List {
 	return a.Validators
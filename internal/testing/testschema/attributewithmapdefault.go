@@ -23,6 +23,7 @@ type AttributeWithMapDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Map
 }
 
@@ -88,3 +89,8 @@ func (a AttributeWithMapDefaultValue) IsRequired() bool {
 func (a AttributeWithMapDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithMapDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -23,6 +23,7 @@ type AttributeWithMapPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Map
 }
 
@@ -84,6 +85,11 @@ func (a AttributeWithMapPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithMapPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // MapPlanModifiers satisfies the fwxschema.AttributeWithMapPlanModifiers interface.
 func (a AttributeWithMapPlanModifiers) MapPlanModifiers() []planmodifier.Map {
 	return a.PlanModifiers
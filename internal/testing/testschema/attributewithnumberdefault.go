@@ -22,6 +22,7 @@ type AttributeWithNumberDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Number
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithNumberDefaultValue) IsRequired() bool {
 func (a AttributeWithNumberDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithNumberDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
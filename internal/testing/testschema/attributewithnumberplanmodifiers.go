@@ -22,6 +22,7 @@ type AttributeWithNumberPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Number
 }
 
@@ -81,6 +82,11 @@ func (a AttributeWithNumberPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithNumberPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // NumberPlanModifiers satisfies the fwxschema.AttributeWithNumberPlanModifiers interface.
 func (a AttributeWithNumberPlanModifiers) NumberPlanModifiers() []planmodifier.Number {
 	return a.PlanModifiers
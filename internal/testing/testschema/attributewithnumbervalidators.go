@@ -22,6 +22,7 @@ type AttributeWithNumberValidators struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Validators          []validator.Number
 }
 
@@ -81,6 +82,11 @@ func (a AttributeWithNumberValidators) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithNumberValidators) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // NumberValidators satisfies the fwxschema.AttributeWithNumberValidators interface.
 func (a AttributeWithNumberValidators) NumberValidators() []validator.Number {
 	return a.Validators
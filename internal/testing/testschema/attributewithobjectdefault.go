@@ -23,6 +23,7 @@ type AttributeWithObjectDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Object
 }
 
@@ -88,3 +89,8 @@ func (a AttributeWithObjectDefaultValue) IsRequired() bool {
 func (a AttributeWithObjectDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithObjectDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
@@ -23,6 +23,7 @@ type AttributeWithSetDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.Set
 }
 
@@ -88,3 +89,8 @@ func (a AttributeWithSetDefaultValue) IsRequired() bool {
 func (a AttributeWithSetDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithSetDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
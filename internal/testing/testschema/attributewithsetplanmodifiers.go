@@ -23,6 +23,7 @@ type AttributeWithSetPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.Set
 }
 
@@ -84,6 +85,11 @@ func (a AttributeWithSetPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithSetPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // SetPlanModifiers satisfies the fwxschema.AttributeWithSetPlanModifiers interface.
 func (a AttributeWithSetPlanModifiers) SetPlanModifiers() []planmodifier.Set {
 	return a.PlanModifiers
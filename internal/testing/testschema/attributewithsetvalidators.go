@@ -23,6 +23,7 @@ type AttributeWithSetValidators struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Validators          []validator.Set
 }
 
@@ -84,6 +85,11 @@ func (a AttributeWithSetValidators) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithSetValidators) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // SetValidators satisfies the fwxschema.AttributeWithSetValidators interface.
 func (a AttributeWithSetValidators) SetValidators() []validator.Set {
 	return a.Validators
@@ -22,6 +22,7 @@ type AttributeWithStringDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Default             defaults.String
 }
 
@@ -85,3 +86,8 @@ func (a AttributeWithStringDefaultValue) IsRequired() bool {
 func (a AttributeWithStringDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
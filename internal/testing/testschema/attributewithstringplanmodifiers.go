@@ -22,6 +22,7 @@ type AttributeWithStringPlanModifiers struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	PlanModifiers       []planmodifier.String
 }
 
@@ -81,6 +82,11 @@ func (a AttributeWithStringPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a AttributeWithStringPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // StringPlanModifiers satisfies the fwxschema.AttributeWithStringPlanModifiers interface.
 func (a AttributeWithStringPlanModifiers) StringPlanModifiers() []planmodifier.String {
 	return a.PlanModifiers
@@ -25,6 +25,7 @@ type NestedAttribute struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -155,3 +156,8 @@ func (a NestedAttribute) IsRequired() bool {
 func (a NestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
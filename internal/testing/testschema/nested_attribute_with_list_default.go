@@ -27,6 +27,7 @@ type NestedAttributeWithListDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -102,6 +103,11 @@ func (a NestedAttributeWithListDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttributeWithListDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ListDefaultValue satisfies the fwschema.AttributeWithListDefaultValue interface.
 func (a NestedAttributeWithListDefaultValue) ListDefaultValue() defaults.List {
 	return a.Default
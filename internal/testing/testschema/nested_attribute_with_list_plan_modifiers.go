@@ -27,6 +27,7 @@ type NestedAttributeWithListPlanModifiers struct {
 	PlanModifiers       []planmodifier.List
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -102,6 +103,11 @@ func (a NestedAttributeWithListPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttributeWithListPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ListPlanModifiers satisfies the fwxschema.AttributeWithListPlanModifiers interface.
 func (a NestedAttributeWithListPlanModifiers) ListPlanModifiers() []planmodifier.List {
 	return a.PlanModifiers
@@ -27,6 +27,7 @@ type NestedAttributeWithMapDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -102,6 +103,11 @@ func (a NestedAttributeWithMapDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttributeWithMapDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // MapDefaultValue satisfies the fwschema.AttributeWithMapDefaultValue interface.
 func (a NestedAttributeWithMapDefaultValue) MapDefaultValue() defaults.Map {
 	return a.Default
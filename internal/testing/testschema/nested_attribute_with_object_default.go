@@ -28,6 +28,7 @@ type NestedAttributeWithObjectDefaultValue struct {
 	Optional            bool
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -105,6 +106,11 @@ func (a NestedAttributeWithObjectDefaultValue) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttributeWithObjectDefaultValue) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ObjectDefaultValue satisfies the fwschema.AttributeWithListDefaultValue interface.
 func (a NestedAttributeWithObjectDefaultValue) ObjectDefaultValue() defaults.Object {
 	return a.Default
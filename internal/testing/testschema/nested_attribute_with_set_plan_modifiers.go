@@ -27,6 +27,7 @@ type NestedAttributeWithSetPlanModifiers struct {
 	PlanModifiers       []planmodifier.Set
 	Required            bool
 	Sensitive           bool
+	WriteOnly           bool
 	Type                attr.Type
 }
 
@@ -102,6 +103,11 @@ func (a NestedAttributeWithSetPlanModifiers) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly satisfies the fwschema.Attribute interface.
+func (a NestedAttributeWithSetPlanModifiers) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // SetPlanModifiers satisfies the fwxschema.AttributeWithSetPlanModifiers interface.
 func (a NestedAttributeWithSetPlanModifiers) SetPlanModifiers() []planmodifier.Set {
 	return a.PlanModifiers
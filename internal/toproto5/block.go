@@ -49,6 +49,19 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 
 	for attrName, attr := range nestedBlockObject.GetAttributes() {
 		attrPath := path.WithAttributeName(attrName)
+
+		if nestedAttr, ok := attr.(fwschema.NestedAttribute); ok && fwschema.NestedAttributesAsBlocks(ctx) {
+			blockProto5, err := NestedAttributeBlock(ctx, attrName, attrPath, nestedAttr)
+
+			if err != nil {
+				return nil, err
+			}
+
+			schemaNestedBlock.Block.BlockTypes = append(schemaNestedBlock.Block.BlockTypes, blockProto5)
+
+			continue
+		}
+
 		attrProto5, err := SchemaAttribute(ctx, attrName, attrPath, attr)
 
 		if err != nil {
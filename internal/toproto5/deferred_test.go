@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDataSourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *datasource.Deferred
+		expected *tfprotov5.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &datasource.Deferred{
+				Reason: datasource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov5.Deferred{
+				Reason: tfprotov5.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.DataSourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEphemeralResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *ephemeral.Deferred
+		expected *tfprotov5.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &ephemeral.Deferred{
+				Reason: ephemeral.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov5.Deferred{
+				Reason: tfprotov5.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.EphemeralResourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *resource.Deferred
+		expected *tfprotov5.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &resource.Deferred{
+				Reason: resource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov5.Deferred{
+				Reason: tfprotov5.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.ResourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
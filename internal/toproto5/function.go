@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// Function returns the *tfprotov5.Function for a function.Definition.
+func Function(ctx context.Context, fw function.Definition) *tfprotov5.Function {
+	proto := &tfprotov5.Function{
+		DeprecationMessage: fw.DeprecationMessage,
+		Parameters:         make([]*tfprotov5.FunctionParameter, 0, len(fw.Parameters)),
+		Return:             FunctionReturn(ctx, fw.Return),
+		Summary:            fw.Summary,
+	}
+
+	if fw.MarkdownDescription != "" {
+		proto.Description = fw.MarkdownDescription
+		proto.DescriptionKind = tfprotov5.StringKindMarkdown
+	} else if fw.Description != "" {
+		proto.Description = fw.Description
+		proto.DescriptionKind = tfprotov5.StringKindPlain
+	}
+
+	for _, fwParameter := range fw.Parameters {
+		proto.Parameters = append(proto.Parameters, FunctionParameter(ctx, fwParameter))
+	}
+
+	if fw.VariadicParameter != nil {
+		proto.VariadicParameter = FunctionParameter(ctx, fw.VariadicParameter)
+	}
+
+	return proto
+}
+
+// FunctionParameter returns the *tfprotov5.FunctionParameter for a
+// function.Parameter.
+func FunctionParameter(ctx context.Context, fw function.Parameter) *tfprotov5.FunctionParameter {
+	if fw == nil {
+		return nil
+	}
+
+	proto := &tfprotov5.FunctionParameter{
+		AllowNullValue:     fw.GetAllowNullValue(),
+		AllowUnknownValues: fw.GetAllowUnknownValues(),
+		Name:               fw.GetName(),
+		Type:               fw.GetType().TerraformType(ctx),
+	}
+
+	if fw.GetMarkdownDescription() != "" {
+		proto.Description = fw.GetMarkdownDescription()
+		proto.DescriptionKind = tfprotov5.StringKindMarkdown
+	} else if fw.GetDescription() != "" {
+		proto.Description = fw.GetDescription()
+		proto.DescriptionKind = tfprotov5.StringKindPlain
+	}
+
+	return proto
+}
+
+// FunctionReturn returns the *tfprotov5.FunctionReturn for a
+// function.Return.
+func FunctionReturn(ctx context.Context, fw function.Return) *tfprotov5.FunctionReturn {
+	if fw == nil {
+		return nil
+	}
+
+	proto := &tfprotov5.FunctionReturn{
+		Type: fw.GetType().TerraformType(ctx),
+	}
+
+	return proto
+}
+
+// FunctionResultData returns the *tfprotov5.DynamicValue for a given
+// function.ResultData.
+func FunctionResultData(ctx context.Context, data function.ResultData) (*tfprotov5.DynamicValue, *function.FuncError) {
+	attrValue := data.Value()
+
+	if attrValue == nil {
+		return nil, nil
+	}
+
+	tfType := attrValue.Type(ctx).TerraformType(ctx)
+	tfValue, err := attrValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, function.NewFuncError(
+			"Unable to Convert Function Result Data: An unexpected error was encountered when converting the function result data to the protocol type. " +
+				"Please report this to the provider developer:\n\n" +
+				"Unable to convert framework type to tftypes: " + err.Error(),
+		)
+	}
+
+	dynamicValue, err := tfprotov5.NewDynamicValue(tfType, tfValue)
+
+	if err != nil {
+		return nil, function.NewFuncError(
+			"Unable to Convert Function Result Data: An unexpected error was encountered when converting the function result data to the protocol type. " +
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+				"Unable to create DynamicValue: " + err.Error(),
+		)
+	}
+
+	return &dynamicValue, nil
+}
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       function.Definition
+		expected *tfprotov5.Function
+	}{
+		"deprecationmessage": {
+			fw: function.Definition{
+				DeprecationMessage: "test deprecation message",
+				Return:             function.StringReturn{},
+			},
+			expected: &tfprotov5.Function{
+				DeprecationMessage: "test deprecation message",
+				Parameters:         []*tfprotov5.FunctionParameter{},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+			},
+		},
+		"description": {
+			fw: function.Definition{
+				Description: "test description",
+				Return:      function.StringReturn{},
+			},
+			expected: &tfprotov5.Function{
+				Description:     "test description",
+				DescriptionKind: tfprotov5.StringKindPlain,
+				Parameters:      []*tfprotov5.FunctionParameter{},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+			},
+		},
+		"description-markdown": {
+			fw: function.Definition{
+				Description:         "test description",
+				MarkdownDescription: "test markdown description",
+				Return:              function.StringReturn{},
+			},
+			expected: &tfprotov5.Function{
+				Description:     "test markdown description",
+				DescriptionKind: tfprotov5.StringKindMarkdown,
+				Parameters:      []*tfprotov5.FunctionParameter{},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+			},
+		},
+		"parameters": {
+			fw: function.Definition{
+				Parameters: []function.Parameter{
+					function.BoolParameter{},
+					function.Int64Parameter{},
+					function.StringParameter{},
+				},
+				Return: function.StringReturn{},
+			},
+			expected: &tfprotov5.Function{
+				Parameters: []*tfprotov5.FunctionParameter{
+					{
+						Type: tftypes.Bool,
+					},
+					{
+						Type: tftypes.Number,
+					},
+					{
+						Type: tftypes.String,
+					},
+				},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+			},
+		},
+		"summary": {
+			fw: function.Definition{
+				Return:  function.StringReturn{},
+				Summary: "test summary",
+			},
+			expected: &tfprotov5.Function{
+				Parameters: []*tfprotov5.FunctionParameter{},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+				Summary: "test summary",
+			},
+		},
+		"variadicparameter": {
+			fw: function.Definition{
+				Return:            function.StringReturn{},
+				VariadicParameter: function.StringParameter{},
+			},
+			expected: &tfprotov5.Function{
+				Parameters: []*tfprotov5.FunctionParameter{},
+				Return: &tfprotov5.FunctionReturn{
+					Type: tftypes.String,
+				},
+				VariadicParameter: &tfprotov5.FunctionParameter{
+					Type: tftypes.String,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.Function(context.Background(), testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       function.Parameter
+		expected *tfprotov5.FunctionParameter
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"allownullvalue": {
+			fw: function.StringParameter{
+				AllowNullValue: true,
+			},
+			expected: &tfprotov5.FunctionParameter{
+				AllowNullValue: true,
+				Type:           tftypes.String,
+			},
+		},
+		"allowunknownvalues": {
+			fw: function.StringParameter{
+				AllowUnknownValues: true,
+			},
+			expected: &tfprotov5.FunctionParameter{
+				AllowUnknownValues: true,
+				Type:               tftypes.String,
+			},
+		},
+		"description": {
+			fw: function.StringParameter{
+				Description: "test description",
+			},
+			expected: &tfprotov5.FunctionParameter{
+				Description:     "test description",
+				DescriptionKind: tfprotov5.StringKindPlain,
+				Type:            tftypes.String,
+			},
+		},
+		"description-markdown": {
+			fw: function.StringParameter{
+				Description:         "test description",
+				MarkdownDescription: "test markdown description",
+			},
+			expected: &tfprotov5.FunctionParameter{
+				Description:     "test markdown description",
+				DescriptionKind: tfprotov5.StringKindMarkdown,
+				Type:            tftypes.String,
+			},
+		},
+		"name": {
+			fw: function.StringParameter{
+				Name: "test",
+			},
+			expected: &tfprotov5.FunctionParameter{
+				Name: "test",
+				Type: tftypes.String,
+			},
+		},
+		"type-list": {
+			fw: function.ListParameter{
+				ElementType: basetypes.StringType{},
+			},
+			expected: &tfprotov5.FunctionParameter{
+				Type: tftypes.List{
+					ElementType: tftypes.String,
+				},
+			},
+		},
+		"type-object": {
+			fw: function.ObjectParameter{
+				AttributeTypes: map[string]attr.Type{
+					"test": basetypes.BoolType{},
+				},
+			},
+			expected: &tfprotov5.FunctionParameter{
+				Type: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Bool,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.FunctionParameter(context.Background(), testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionReturn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       function.Return
+		expected *tfprotov5.FunctionReturn
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"bool": {
+			fw: function.BoolReturn{},
+			expected: &tfprotov5.FunctionReturn{
+				Type: tftypes.Bool,
+			},
+		},
+		"map": {
+			fw: function.MapReturn{
+				ElementType: basetypes.Int64Type{},
+			},
+			expected: &tfprotov5.FunctionReturn{
+				Type: tftypes.Map{
+					ElementType: tftypes.Number,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.FunctionReturn(context.Background(), testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionResultData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw              function.ResultData
+		expected        *tfprotov5.DynamicValue
+		expectedFuncErr *function.FuncError
+	}{
+		"empty": {
+			fw:       function.ResultData{},
+			expected: nil,
+		},
+		"value": {
+			fw:       function.NewResultData(basetypes.NewBoolValue(true)),
+			expected: testNewDynamicValueMust(t, tftypes.NewValue(tftypes.Bool, true)),
+		},
+		"value-unknown": {
+			fw:       function.NewResultData(basetypes.NewStringUnknown()),
+			expected: testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, funcErr := toproto5.FunctionResultData(context.Background(), testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(funcErr, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func testNewDynamicValueMust(t *testing.T, value tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	dynamicValue, err := tfprotov5.NewDynamicValue(value.Type(), value)
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	return &dynamicValue
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGetFunctionsResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *fwserver.GetFunctionsResponse
+		expected *tfprotov5.GetFunctionsResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"diagnostics": {
+			input: &fwserver.GetFunctionsResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+			},
+			expected: &tfprotov5.GetFunctionsResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "test error summary",
+						Detail:   "test error detail",
+					},
+				},
+				Functions: map[string]*tfprotov5.Function{},
+			},
+		},
+		"functions": {
+			input: &fwserver.GetFunctionsResponse{
+				FunctionDefinitions: map[string]function.Definition{
+					"testfunction1": {
+						Return: function.StringReturn{},
+					},
+					"testfunction2": {
+						Return: function.BoolReturn{},
+					},
+				},
+			},
+			expected: &tfprotov5.GetFunctionsResponse{
+				Functions: map[string]*tfprotov5.Function{
+					"testfunction1": {
+						Parameters: []*tfprotov5.FunctionParameter{},
+						Return: &tfprotov5.FunctionReturn{
+							Type: tftypes.String,
+						},
+					},
+					"testfunction2": {
+						Parameters: []*tfprotov5.FunctionParameter{},
+						Return: &tfprotov5.FunctionReturn{
+							Type: tftypes.Bool,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.GetFunctionsResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// GetMetadataResponse returns the *tfprotov5.GetMetadataResponse
+// equivalent of a *fwserver.GetMetadataResponse.
+func GetMetadataResponse(ctx context.Context, fw *fwserver.GetMetadataResponse) *tfprotov5.GetMetadataResponse {
+	if fw == nil {
+		return nil
+	}
+
+	protov5 := &tfprotov5.GetMetadataResponse{
+		DataSources:        make([]tfprotov5.DataSourceMetadata, 0, len(fw.DataSources)),
+		Diagnostics:        Diagnostics(ctx, fw.Diagnostics),
+		EphemeralResources: make([]tfprotov5.EphemeralResourceMetadata, 0, len(fw.EphemeralResources)),
+		Functions:          make([]tfprotov5.FunctionMetadata, 0, len(fw.Functions)),
+		ListResources:      make([]tfprotov5.ListResourceMetadata, 0, len(fw.ListResources)),
+		Resources:          make([]tfprotov5.ResourceMetadata, 0, len(fw.Resources)),
+		ServerCapabilities: ServerCapabilities(ctx, fw.ServerCapabilities),
+	}
+
+	for _, datasource := range fw.DataSources {
+		protov5.DataSources = append(protov5.DataSources, tfprotov5.DataSourceMetadata{
+			TypeName: datasource.TypeName,
+		})
+	}
+
+	for _, ephemeralResource := range fw.EphemeralResources {
+		protov5.EphemeralResources = append(protov5.EphemeralResources, tfprotov5.EphemeralResourceMetadata{
+			TypeName: ephemeralResource.TypeName,
+		})
+	}
+
+	for _, function := range fw.Functions {
+		protov5.Functions = append(protov5.Functions, tfprotov5.FunctionMetadata{
+			Name: function.Name,
+		})
+	}
+
+	for _, listResource := range fw.ListResources {
+		protov5.ListResources = append(protov5.ListResources, tfprotov5.ListResourceMetadata{
+			TypeName: listResource.TypeName,
+		})
+	}
+
+	for _, resource := range fw.Resources {
+		protov5.Resources = append(protov5.Resources, tfprotov5.ResourceMetadata{
+			TypeName: resource.TypeName,
+		})
+	}
+
+	return protov5
+}
@@ -18,10 +18,17 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	}
 
 	protov5 := &tfprotov5.GetProviderSchemaResponse{
-		DataSourceSchemas:  map[string]*tfprotov5.Schema{},
-		Diagnostics:        Diagnostics(ctx, fw.Diagnostics),
-		ResourceSchemas:    map[string]*tfprotov5.Schema{},
-		ServerCapabilities: ServerCapabilities(ctx, fw.ServerCapabilities),
+		DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+		Diagnostics:              Diagnostics(ctx, fw.Diagnostics),
+		EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+		Functions:                make(map[string]*tfprotov5.Function, len(fw.FunctionDefinitions)),
+		ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+		ResourceSchemas:          map[string]*tfprotov5.Schema{},
+		ServerCapabilities:       ServerCapabilities(ctx, fw.ServerCapabilities),
+	}
+
+	for name, functionDefinition := range fw.FunctionDefinitions {
+		protov5.Functions[name] = Function(ctx, functionDefinition)
 	}
 
 	var err error
@@ -64,6 +71,34 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		}
 	}
 
+	for ephemeralResourceType, ephemeralResourceSchema := range fw.EphemeralResourceSchemas {
+		protov5.EphemeralResourceSchemas[ephemeralResourceType], err = Schema(ctx, ephemeralResourceSchema)
+
+		if err != nil {
+			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Error converting ephemeral resource schema",
+				Detail:   "The schema for the ephemeral resource \"" + ephemeralResourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+
+			return protov5
+		}
+	}
+
+	for listResourceType, listResourceSchema := range fw.ListResourceSchemas {
+		protov5.ListResourceSchemas[listResourceType], err = Schema(ctx, listResourceSchema)
+
+		if err != nil {
+			protov5.Diagnostics = append(protov5.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Error converting list resource schema",
+				Detail:   "The schema for the list resource \"" + listResourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+
+			return protov5
+		}
+	}
+
 	for resourceType, resourceSchema := range fw.ResourceSchemas {
 		protov5.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
 
@@ -10,9 +10,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -80,7 +82,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-computed": {
@@ -109,7 +114,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-deprecated": {
@@ -140,7 +148,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-optional": {
@@ -169,7 +180,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-optional-computed": {
@@ -200,7 +214,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-required": {
@@ -229,7 +246,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-sensitive": {
@@ -260,7 +280,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-bool": {
@@ -289,7 +312,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-float64": {
@@ -318,7 +344,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-int64": {
@@ -347,7 +376,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-list-string": {
@@ -383,7 +415,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-nested-attributes": {
@@ -416,7 +451,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-object": {
@@ -456,7 +494,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-string": {
@@ -488,7 +529,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-nested-attributes": {
@@ -521,7 +565,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-string": {
@@ -553,7 +600,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-number": {
@@ -582,7 +632,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-object": {
@@ -618,7 +671,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-nested-attributes": {
@@ -651,7 +707,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-object": {
@@ -691,7 +750,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-set-string": {
@@ -727,7 +789,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-string": {
@@ -759,7 +824,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-single-nested-attributes": {
@@ -790,7 +858,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-attribute-type-string": {
@@ -819,7 +890,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-block-list": {
@@ -862,7 +936,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-block-set": {
@@ -905,7 +982,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"data-source-block-single": {
@@ -946,6 +1026,109 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
+			},
+		},
+		"ephemeral-resource-multiple-ephemeral-resources": {
+			input: &fwserver.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]fwschema.Schema{
+					"test_ephemeral_resource_1": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Computed: true,
+							},
+						},
+					},
+					"test_ephemeral_resource_2": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_ephemeral_resource_1": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test_attribute",
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+					"test_ephemeral_resource_2": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test_attribute",
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+				},
+				ListResourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:           map[string]*tfprotov5.Function{},
+				ResourceSchemas:     map[string]*tfprotov5.Schema{},
+			},
+		},
+		"list-resource-multiple-list-resources": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ListResourceSchemas: map[string]fwschema.Schema{
+					"test_list_resource_1": listschema.Schema{
+						Attributes: map[string]listschema.Attribute{
+							"test_attribute": listschema.BoolAttribute{
+								Optional: true,
+							},
+						},
+					},
+					"test_list_resource_2": listschema.Schema{
+						Attributes: map[string]listschema.Attribute{
+							"test_attribute": listschema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_list_resource_1": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Optional: true,
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+					"test_list_resource_2": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
@@ -961,7 +1144,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -988,7 +1174,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1014,7 +1203,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1041,7 +1233,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1068,7 +1263,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1094,7 +1292,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1120,7 +1321,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1149,7 +1353,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1194,7 +1401,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-attribute-type-list-object": {
@@ -1213,7 +1423,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1246,7 +1459,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1289,7 +1505,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-attribute-type-map-string": {
@@ -1304,7 +1523,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1332,7 +1554,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1361,7 +1586,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1406,7 +1634,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-attribute-type-set-object": {
@@ -1425,7 +1656,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1460,7 +1694,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1491,7 +1728,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1532,7 +1772,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-attribute-type-string": {
@@ -1546,7 +1789,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1578,7 +1824,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						BlockTypes: []*tfprotov5.SchemaNestedBlock{
@@ -1618,7 +1867,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						BlockTypes: []*tfprotov5.SchemaNestedBlock{
@@ -1656,7 +1908,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				Provider: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						BlockTypes: []*tfprotov5.SchemaNestedBlock{
@@ -1690,7 +1945,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1716,7 +1974,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1742,7 +2003,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1768,7 +2032,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1794,7 +2061,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1823,7 +2093,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1868,7 +2141,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-meta-attribute-type-list-object": {
@@ -1887,7 +2163,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1920,7 +2199,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -1963,7 +2245,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-meta-attribute-type-map-string": {
@@ -1978,7 +2263,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2006,7 +2294,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2035,7 +2326,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2080,7 +2374,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-meta-attribute-type-set-object": {
@@ -2099,7 +2396,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2134,7 +2434,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2165,7 +2468,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2206,7 +2512,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
+				ResourceSchemas:          map[string]*tfprotov5.Schema{},
 			},
 		},
 		"provider-meta-attribute-type-string": {
@@ -2220,7 +2529,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ProviderMeta: &tfprotov5.Schema{
 					Block: &tfprotov5.SchemaBlock{
 						Attributes: []*tfprotov5.SchemaAttribute{
@@ -2255,7 +2567,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource_1": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2295,7 +2610,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2325,7 +2643,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2355,7 +2676,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2385,7 +2709,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2415,7 +2742,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2445,7 +2775,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2475,7 +2808,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2504,7 +2840,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2533,7 +2872,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2565,7 +2907,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2613,6 +2958,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the resource \"test_resource\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": nil,
 				},
@@ -2636,7 +2984,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2672,7 +3023,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2718,6 +3072,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the resource \"test_resource\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": nil,
 				},
@@ -2737,7 +3094,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2768,7 +3128,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2800,7 +3163,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2848,6 +3214,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the resource \"test_resource\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": nil,
 				},
@@ -2871,7 +3240,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2909,7 +3281,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2943,7 +3318,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -2987,6 +3365,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						Detail:   "The schema for the resource \"test_resource\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": nil,
 				},
@@ -3005,7 +3386,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -3040,7 +3424,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -3083,7 +3470,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -3124,7 +3514,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block: &tfprotov5.SchemaBlock{
@@ -3157,7 +3550,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov5.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov5.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov5.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov5.Schema{},
+				Functions:                map[string]*tfprotov5.Function{},
 				ResourceSchemas: map[string]*tfprotov5.Schema{
 					"test_resource": {
 						Block:   &tfprotov5.SchemaBlock{},
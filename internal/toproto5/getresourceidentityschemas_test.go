@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+func TestGetResourceIdentitySchemasResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *fwserver.GetResourceIdentitySchemasResponse
+		expected *tfprotov5.GetResourceIdentitySchemasResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input: &fwserver.GetResourceIdentitySchemasResponse{},
+			expected: &tfprotov5.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{},
+			},
+		},
+		"diagnostics": {
+			input: &fwserver.GetResourceIdentitySchemasResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+					diag.NewErrorDiagnostic("test error summary", "test error details"),
+				},
+			},
+			expected: &tfprotov5.GetResourceIdentitySchemasResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "test warning summary",
+						Detail:   "test warning details",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "test error summary",
+						Detail:   "test error details",
+					},
+				},
+				IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{},
+			},
+		},
+		"identityschemas": {
+			input: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{
+					"test_resource": identityschema.Schema{
+						Attributes: map[string]identityschema.Attribute{
+							"test_id": identityschema.StringAttribute{
+								RequiredForImport: true,
+							},
+						},
+						Version: 1,
+					},
+				},
+			},
+			expected: &tfprotov5.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{
+					"test_resource": {
+						IdentityAttributes: []*tfprotov5.ResourceIdentitySchemaAttribute{
+							{
+								Name:              "test_id",
+								Type:              tftypes.String,
+								RequiredForImport: true,
+							},
+						},
+						Version: 1,
+					},
+				},
+			},
+		},
+		"identityschemas-invalid": {
+			input: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{
+					"test_resource": identityschema.Schema{
+						Attributes: map[string]identityschema.Attribute{
+							"test_id": identityschema.StringAttribute{},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetResourceIdentitySchemasResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Error converting resource identity schema",
+						Detail: "The identity schema for the resource \"test_resource\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" +
+							"AttributeName(\"test_id\"): must have exactly one of RequiredForImport or OptionalForImport set",
+					},
+				},
+				IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{
+					"test_resource": nil,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.GetResourceIdentitySchemasResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func ImportResourceStateResponse(ctx context.Context, fw *fwserver.ImportResourc
 
 	proto5 := &tfprotov5.ImportResourceStateResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    ResourceDeferred(fw.Deferred),
 	}
 
 	for _, fwImportedResource := range fw.ImportedResources {
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// ListResultsStream returns the *tfprotov5.ListResourceServerStream
+// equivalent of a *fwserver.ListResultsStream. Results are converted lazily
+// as they are consumed.
+func ListResultsStream(ctx context.Context, fw *fwserver.ListResultsStream) *tfprotov5.ListResourceServerStream {
+	if fw == nil || fw.Results == nil {
+		return &tfprotov5.ListResourceServerStream{
+			Results: tfprotov5.NoListResults,
+		}
+	}
+
+	proto5 := &tfprotov5.ListResourceServerStream{
+		Results: func(push func(tfprotov5.ListResourceResult) bool) {
+			for result := range fw.Results {
+				if !push(ListResourceResult(ctx, result)) {
+					return
+				}
+			}
+		},
+	}
+
+	return proto5
+}
+
+// ListResourceResult returns the tfprotov5.ListResourceResult equivalent of
+// a list.ListResult.
+func ListResourceResult(ctx context.Context, fw list.ListResult) tfprotov5.ListResourceResult {
+	proto5 := tfprotov5.ListResourceResult{
+		DisplayName: fw.DisplayName,
+		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+	}
+
+	identity, diags := ResourceIdentity(ctx, fw.Identity)
+
+	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
+	proto5.Identity = identity
+
+	resource, diags := State(ctx, fw.Resource)
+
+	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
+	proto5.Resource = resource
+
+	return proto5
+}
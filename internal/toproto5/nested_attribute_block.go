@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NestedAttributeBlock returns the *tfprotov5.SchemaNestedBlock equivalent of
+// a NestedAttribute. This is only used when the context was returned by
+// fwschema.ContextWithNestedAttributesAsBlocks, since protocol version 5 does
+// not support nested attributes. Errors will be tftypes.AttributePathErrors
+// based on `path`. `name` is the name of the attribute.
+func NestedAttributeBlock(ctx context.Context, name string, path *tftypes.AttributePath, a fwschema.NestedAttribute) (*tfprotov5.SchemaNestedBlock, error) {
+	// Blocks are always configurable and cannot be marked as computed,
+	// sensitive, or write-only, so these attributes cannot be represented.
+	if a.IsComputed() {
+		return nil, path.NewErrorf("protocol version 5 cannot convert Computed nested attributes to blocks")
+	}
+
+	if a.IsSensitive() {
+		return nil, path.NewErrorf("protocol version 5 cannot convert Sensitive nested attributes to blocks")
+	}
+
+	if a.IsWriteOnly() {
+		return nil, path.NewErrorf("protocol version 5 cannot convert WriteOnly nested attributes to blocks")
+	}
+
+	schemaNestedBlock := &tfprotov5.SchemaNestedBlock{
+		Block: &tfprotov5.SchemaBlock{
+			Deprecated: a.GetDeprecationMessage() != "",
+		},
+		TypeName: name,
+	}
+
+	if a.GetDescription() != "" {
+		schemaNestedBlock.Block.Description = a.GetDescription()
+		schemaNestedBlock.Block.DescriptionKind = tfprotov5.StringKindPlain
+	}
+
+	if a.GetMarkdownDescription() != "" {
+		schemaNestedBlock.Block.Description = a.GetMarkdownDescription()
+		schemaNestedBlock.Block.DescriptionKind = tfprotov5.StringKindMarkdown
+	}
+
+	nm := a.GetNestingMode()
+	switch nm {
+	case fwschema.NestingModeList:
+		schemaNestedBlock.Nesting = tfprotov5.SchemaNestedBlockNestingModeList
+	case fwschema.NestingModeSet:
+		schemaNestedBlock.Nesting = tfprotov5.SchemaNestedBlockNestingModeSet
+	case fwschema.NestingModeSingle:
+		schemaNestedBlock.Nesting = tfprotov5.SchemaNestedBlockNestingModeSingle
+	case fwschema.NestingModeMap:
+		return nil, path.NewErrorf("protocol version 5 cannot convert map nested attributes to blocks")
+	default:
+		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
+	}
+
+	for attrName, attr := range a.GetNestedObject().GetAttributes() {
+		attrPath := path.WithAttributeName(attrName)
+
+		if nestedAttr, ok := attr.(fwschema.NestedAttribute); ok {
+			blockProto5, err := NestedAttributeBlock(ctx, attrName, attrPath, nestedAttr)
+
+			if err != nil {
+				return nil, err
+			}
+
+			schemaNestedBlock.Block.BlockTypes = append(schemaNestedBlock.Block.BlockTypes, blockProto5)
+
+			continue
+		}
+
+		attrProto5, err := SchemaAttribute(ctx, attrName, attrPath, attr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schemaNestedBlock.Block.Attributes = append(schemaNestedBlock.Block.Attributes, attrProto5)
+	}
+
+	sort.Slice(schemaNestedBlock.Block.Attributes, func(i, j int) bool {
+		if schemaNestedBlock.Block.Attributes[i] == nil {
+			return true
+		}
+
+		if schemaNestedBlock.Block.Attributes[j] == nil {
+			return false
+		}
+
+		return schemaNestedBlock.Block.Attributes[i].Name < schemaNestedBlock.Block.Attributes[j].Name
+	})
+
+	sort.Slice(schemaNestedBlock.Block.BlockTypes, func(i, j int) bool {
+		if schemaNestedBlock.Block.BlockTypes[i] == nil {
+			return true
+		}
+
+		if schemaNestedBlock.Block.BlockTypes[j] == nil {
+			return false
+		}
+
+		return schemaNestedBlock.Block.BlockTypes[i].TypeName < schemaNestedBlock.Block.BlockTypes[j].TypeName
+	})
+
+	return schemaNestedBlock, nil
+}
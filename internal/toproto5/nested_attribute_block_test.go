@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNestedAttributeBlock(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		attr        fwschema.NestedAttribute
+		path        *tftypes.AttributePath
+		expected    *tfprotov5.SchemaNestedBlock
+		expectedErr string
+	}
+
+	testNestedObject := testschema.NestedAttributeObject{
+		Attributes: map[string]fwschema.Attribute{
+			"sub_test": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	tests := map[string]testCase{
+		"nestingmode-invalid": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				Optional:     true,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "unrecognized nesting mode 0",
+		},
+		"nestingmode-list": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeList,
+				Optional:     true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-map": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeMap,
+				Optional:     true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot convert map nested attributes to blocks",
+		},
+		"nestingmode-set": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeSet,
+				Required:     true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeSingle,
+				Optional:     true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+		"nested-attributes": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"sub_nested": testschema.NestedAttribute{
+							NestedObject: testNestedObject,
+							NestingMode:  fwschema.NestingModeSet,
+							Optional:     true,
+						},
+						"sub_test": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Optional:    true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_test",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+							TypeName: "sub_nested",
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nested-attributes-map": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"sub_nested": testschema.NestedAttribute{
+							NestedObject: testNestedObject,
+							NestingMode:  fwschema.NestingModeMap,
+							Optional:     true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\").AttributeName(\"sub_nested\"): protocol version 5 cannot convert map nested attributes to blocks",
+		},
+		"computed": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				Computed:     true,
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeList,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot convert Computed nested attributes to blocks",
+		},
+		"optional-computed": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				Computed:     true,
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeList,
+				Optional:     true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot convert Computed nested attributes to blocks",
+		},
+		"sensitive": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeList,
+				Optional:     true,
+				Sensitive:    true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot convert Sensitive nested attributes to blocks",
+		},
+		"write-only": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				NestedObject: testNestedObject,
+				NestingMode:  fwschema.NestingModeList,
+				Optional:     true,
+				WriteOnly:    true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test"),
+			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot convert WriteOnly nested attributes to blocks",
+		},
+		"deprecated-description": {
+			name: "test",
+			attr: testschema.NestedAttribute{
+				DeprecationMessage:  "deprecated",
+				MarkdownDescription: "test `description`",
+				NestedObject:        testNestedObject,
+				NestingMode:         fwschema.NestingModeSingle,
+				Optional:            true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Deprecated:      true,
+					Description:     "test `description`",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toproto5.NestedAttributeBlock(context.Background(), tc.name, tc.path, tc.attr)
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if err.Error() != tc.expectedErr {
+					t.Errorf("Expected error to be %q, got %q", tc.expectedErr, err.Error())
+					return
+				}
+				// got expected error
+				return
+			}
+			if err == nil && tc.expectedErr != "" {
+				t.Errorf("Expected error to be %q, got nil", tc.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+				return
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 
 	proto5 := &tfprotov5.PlanResourceChangeResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    ResourceDeferred(fw.Deferred),
 	}
 
 	plannedState, diags := State(ctx, fw.PlannedState)
@@ -28,6 +29,11 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
 	proto5.PlannedState = plannedState
 
+	plannedIdentity, diags := ResourceIdentity(ctx, fw.PlannedIdentity)
+
+	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
+	proto5.PlannedIdentity = plannedIdentity
+
 	requiresReplace, diags := totftypes.AttributePaths(ctx, fw.RequiresReplace)
 
 	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
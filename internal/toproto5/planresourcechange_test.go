@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -81,6 +82,18 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 			input:    &fwserver.PlanResourceChangeResponse{},
 			expected: &tfprotov5.PlanResourceChangeResponse{},
 		},
+		"deferred": {
+			input: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonProviderConfigUnknown,
+				},
+			},
+			expected: &tfprotov5.PlanResourceChangeResponse{
+				Deferred: &tfprotov5.Deferred{
+					Reason: tfprotov5.DeferredReasonProviderConfigUnknown,
+				},
+			},
+		},
 		"diagnostics": {
 			input: &fwserver.PlanResourceChangeResponse{
 				Diagnostics: diag.Diagnostics{
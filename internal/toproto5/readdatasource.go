@@ -19,6 +19,7 @@ func ReadDataSourceResponse(ctx context.Context, fw *fwserver.ReadDataSourceResp
 
 	proto5 := &tfprotov5.ReadDataSourceResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    DataSourceDeferred(fw.Deferred),
 	}
 
 	state, diags := State(ctx, fw.State)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -80,6 +81,18 @@ func TestReadResourceResponse(t *testing.T) {
 			input:    &fwserver.ReadResourceResponse{},
 			expected: &tfprotov5.ReadResourceResponse{},
 		},
+		"deferred": {
+			input: &fwserver.ReadResourceResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonProviderConfigUnknown,
+				},
+			},
+			expected: &tfprotov5.ReadResourceResponse{
+				Deferred: &tfprotov5.Deferred{
+					Reason: tfprotov5.DeferredReasonProviderConfigUnknown,
+				},
+			},
+		},
 		"diagnostics": {
 			input: &fwserver.ReadResourceResponse{
 				Diagnostics: diag.Diagnostics{
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// ResourceIdentitySchema returns the *tfprotov5.ResourceIdentitySchema
+// equivalent of a resource identity Schema.
+func ResourceIdentitySchema(ctx context.Context, s fwschema.Schema) (*tfprotov5.ResourceIdentitySchema, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	result := &tfprotov5.ResourceIdentitySchema{
+		Version: s.GetVersion(),
+	}
+
+	var attrs []*tfprotov5.ResourceIdentitySchemaAttribute
+
+	for name, attr := range s.GetAttributes() {
+		a, err := ResourceIdentitySchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attrs = append(attrs, a)
+	}
+
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i] == nil {
+			return true
+		}
+
+		if attrs[j] == nil {
+			return false
+		}
+
+		return attrs[i].Name < attrs[j].Name
+	})
+
+	result.IdentityAttributes = attrs
+
+	return result, nil
+}
+
+// ResourceIdentitySchemaAttribute returns the
+// *tfprotov5.ResourceIdentitySchemaAttribute equivalent of an Attribute.
+// Errors will be tftypes.AttributePathErrors based on `path`. `name` is the
+// name of the attribute.
+func ResourceIdentitySchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePath, a fwschema.Attribute) (*tfprotov5.ResourceIdentitySchemaAttribute, error) {
+	identityAttribute, ok := a.(fwschema.IdentityAttribute)
+
+	if !ok {
+		return nil, path.NewErrorf("must be a resource identity attribute")
+	}
+
+	if identityAttribute.GetType() == nil {
+		return nil, path.NewErrorf("must have Type set")
+	}
+
+	if identityAttribute.IsRequiredForImport() == identityAttribute.IsOptionalForImport() {
+		return nil, path.NewErrorf("must have exactly one of RequiredForImport or OptionalForImport set")
+	}
+
+	schemaAttribute := &tfprotov5.ResourceIdentitySchemaAttribute{
+		Name:              name,
+		Type:              identityAttribute.GetType().TerraformType(ctx),
+		RequiredForImport: identityAttribute.IsRequiredForImport(),
+		OptionalForImport: identityAttribute.IsOptionalForImport(),
+	}
+
+	if identityAttribute.GetDescription() != "" {
+		schemaAttribute.Description = identityAttribute.GetDescription()
+	}
+
+	if identityAttribute.GetMarkdownDescription() != "" {
+		schemaAttribute.Description = identityAttribute.GetMarkdownDescription()
+	}
+
+	return schemaAttribute, nil
+}
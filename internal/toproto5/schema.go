@@ -26,6 +26,18 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov5.Schema, error) {
 	var blocks []*tfprotov5.SchemaNestedBlock
 
 	for name, attr := range s.GetAttributes() {
+		if nestedAttr, ok := attr.(fwschema.NestedAttribute); ok && fwschema.NestedAttributesAsBlocks(ctx) {
+			proto5, err := NestedAttributeBlock(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), nestedAttr)
+
+			if err != nil {
+				return nil, err
+			}
+
+			blocks = append(blocks, proto5)
+
+			continue
+		}
+
 		a, err := SchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr)
 
 		if err != nil {
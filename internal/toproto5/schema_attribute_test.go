@@ -262,6 +262,21 @@ func TestSchemaAttribute(t *testing.T) {
 				Sensitive: true,
 			},
 		},
+		"write-only": {
+			name: "string",
+			attr: testschema.Attribute{
+				Type:      types.StringType,
+				Optional:  true,
+				WriteOnly: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaAttribute{
+				Name:      "string",
+				Type:      tftypes.String,
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
 		"nested-attr-single": {
 			name: "single_nested",
 			attr: testschema.NestedAttribute{
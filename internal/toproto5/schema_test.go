@@ -24,6 +24,8 @@ func TestSchema(t *testing.T) {
 		input       fwschema.Schema
 		expected    *tfprotov5.Schema
 		expectedErr string
+
+		nestedAttributesAsBlocks bool
 	}
 
 	tests := map[string]testCase{
@@ -270,6 +272,117 @@ func TestSchema(t *testing.T) {
 			},
 			expectedErr: "AttributeName(\"test\"): protocol version 5 cannot have Attributes set",
 		},
+		"nested-attrs-as-blocks": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"list_nested": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"string": testschema.Attribute{
+									Type:     types.StringType,
+									Required: true,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Optional:    true,
+					},
+					"string": testschema.Attribute{
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"single_block": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"set_nested": testschema.NestedAttribute{
+									NestedObject: testschema.NestedAttributeObject{
+										Attributes: map[string]fwschema.Attribute{
+											"string": testschema.Attribute{
+												Type:     types.StringType,
+												Optional: true,
+											},
+										},
+									},
+									NestingMode: fwschema.NestingModeSet,
+									Optional:    true,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeSingle,
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "string",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "string",
+										Required: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							TypeName: "list_nested",
+						},
+						{
+							Block: &tfprotov5.SchemaBlock{
+								BlockTypes: []*tfprotov5.SchemaNestedBlock{
+									{
+										Block: &tfprotov5.SchemaBlock{
+											Attributes: []*tfprotov5.SchemaAttribute{
+												{
+													Name:     "string",
+													Optional: true,
+													Type:     tftypes.String,
+												},
+											},
+										},
+										Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+										TypeName: "set_nested",
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+							TypeName: "single_block",
+						},
+					},
+				},
+			},
+			nestedAttributesAsBlocks: true,
+		},
+		"map-nested-attrs-as-blocks": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"string": testschema.Attribute{
+									Type:     types.StringType,
+									Required: true,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeMap,
+						Optional:    true,
+					},
+				},
+			},
+			expectedErr:              "AttributeName(\"test\"): protocol version 5 cannot convert map nested attributes to blocks",
+			nestedAttributesAsBlocks: true,
+		},
 		"nested-blocks": {
 			input: testschema.Schema{
 				Version: 3,
@@ -527,7 +640,13 @@ func TestSchema(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := toproto5.Schema(context.Background(), tc.input)
+			ctx := context.Background()
+
+			if tc.nestedAttributesAsBlocks {
+				ctx = fwschema.ContextWithNestedAttributesAsBlocks(ctx)
+			}
+
+			got, err := toproto5.Schema(ctx, tc.input)
 			if err != nil {
 				if tc.expectedErr == "" {
 					t.Errorf("Unexpected error: %s", err)
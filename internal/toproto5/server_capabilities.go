@@ -18,6 +18,8 @@ func ServerCapabilities(ctx context.Context, fw *fwserver.ServerCapabilities) *t
 	}
 
 	return &tfprotov5.ServerCapabilities{
-		PlanDestroy: fw.PlanDestroy,
+		GetProviderSchemaOptional: fw.GetProviderSchemaOptional,
+		MoveResourceState:         fw.MoveResourceState,
+		PlanDestroy:               fw.PlanDestroy,
 	}
 }
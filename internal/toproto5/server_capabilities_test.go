@@ -24,6 +24,22 @@ func TestServerCapabilities(t *testing.T) {
 			fw:       nil,
 			expected: nil,
 		},
+		"GetProviderSchemaOptional": {
+			fw: &fwserver.ServerCapabilities{
+				GetProviderSchemaOptional: true,
+			},
+			expected: &tfprotov5.ServerCapabilities{
+				GetProviderSchemaOptional: true,
+			},
+		},
+		"MoveResourceState": {
+			fw: &fwserver.ServerCapabilities{
+				MoveResourceState: true,
+			},
+			expected: &tfprotov5.ServerCapabilities{
+				MoveResourceState: true,
+			},
+		},
 		"PlanDestroy": {
 			fw: &fwserver.ServerCapabilities{
 				PlanDestroy: true,
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeResourceIdentityResponse(t *testing.T) {
+	t.Parallel()
+
+	testProto5Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto5Value := tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testProto5DynamicValue, err := tfprotov5.NewDynamicValue(testProto5Type, testProto5Value)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testIdentity := &tfsdk.ResourceIdentity{
+		Raw: testProto5Value,
+		Schema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"test_attribute": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+			},
+		},
+	}
+
+	testIdentityInvalid := &tfsdk.ResourceIdentity{
+		Raw: testProto5Value,
+		Schema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"test_attribute": identityschema.BoolAttribute{
+					RequiredForImport: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input    *fwserver.UpgradeResourceIdentityResponse
+		expected *tfprotov5.UpgradeResourceIdentityResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input:    &fwserver.UpgradeResourceIdentityResponse{},
+			expected: &tfprotov5.UpgradeResourceIdentityResponse{},
+		},
+		"diagnostics": {
+			input: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+					diag.NewErrorDiagnostic("test error summary", "test error details"),
+				},
+			},
+			expected: &tfprotov5.UpgradeResourceIdentityResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "test warning summary",
+						Detail:   "test warning details",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "test error summary",
+						Detail:   "test error details",
+					},
+				},
+			},
+		},
+		"diagnostics-invalid-upgradedidentity": {
+			input: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+					diag.NewErrorDiagnostic("test error summary", "test error details"),
+				},
+				UpgradedIdentity: testIdentityInvalid,
+			},
+			expected: &tfprotov5.UpgradeResourceIdentityResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "test warning summary",
+						Detail:   "test warning details",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "test error summary",
+						Detail:   "test error details",
+					},
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Unable to Convert Resource Identity",
+						Detail: "An unexpected error was encountered when converting the resource identity to the protocol type. " +
+							"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+							"Please report this to the provider developer:\n\n" +
+							"Unable to create DynamicValue: AttributeName(\"test_attribute\"): unexpected value type string, tftypes.Bool values must be of type bool",
+					},
+				},
+			},
+		},
+		"upgradedidentity": {
+			input: &fwserver.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: testIdentity,
+			},
+			expected: &tfprotov5.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfprotov5.ResourceIdentityData{
+					IdentityData: &testProto5DynamicValue,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.UpgradeResourceIdentityResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
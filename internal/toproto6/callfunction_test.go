@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCallFunctionResponse(t *testing.T) {
+	t.Parallel()
+
+	testFunctionArgument := int64(1)
+
+	testCases := map[string]struct {
+		input    *fwserver.CallFunctionResponse
+		expected *tfprotov6.CallFunctionResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"error": {
+			input: &fwserver.CallFunctionResponse{
+				Error: function.NewArgumentFuncError(1, "test error"),
+			},
+			expected: &tfprotov6.CallFunctionResponse{
+				Error: &tfprotov6.FunctionError{
+					Text:             "test error",
+					FunctionArgument: &testFunctionArgument,
+				},
+			},
+		},
+		"result": {
+			input: &fwserver.CallFunctionResponse{
+				Result: function.NewResultData(basetypes.NewStringValue("test")),
+			},
+			expected: &tfprotov6.CallFunctionResponse{
+				Result: testNewDynamicValueMust(t, tftypes.NewValue(tftypes.String, "test")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.CallFunctionResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
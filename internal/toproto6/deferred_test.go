@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDataSourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *datasource.Deferred
+		expected *tfprotov6.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &datasource.Deferred{
+				Reason: datasource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov6.Deferred{
+				Reason: tfprotov6.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.DataSourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEphemeralResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *ephemeral.Deferred
+		expected *tfprotov6.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &ephemeral.Deferred{
+				Reason: ephemeral.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov6.Deferred{
+				Reason: tfprotov6.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.EphemeralResourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *resource.Deferred
+		expected *tfprotov6.Deferred
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"reason": {
+			input: &resource.Deferred{
+				Reason: resource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov6.Deferred{
+				Reason: tfprotov6.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.ResourceDeferred(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGetFunctionsResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *fwserver.GetFunctionsResponse
+		expected *tfprotov6.GetFunctionsResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"diagnostics": {
+			input: &fwserver.GetFunctionsResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+			},
+			expected: &tfprotov6.GetFunctionsResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "test error summary",
+						Detail:   "test error detail",
+					},
+				},
+				Functions: map[string]*tfprotov6.Function{},
+			},
+		},
+		"functions": {
+			input: &fwserver.GetFunctionsResponse{
+				FunctionDefinitions: map[string]function.Definition{
+					"testfunction1": {
+						Return: function.StringReturn{},
+					},
+					"testfunction2": {
+						Return: function.BoolReturn{},
+					},
+				},
+			},
+			expected: &tfprotov6.GetFunctionsResponse{
+				Functions: map[string]*tfprotov6.Function{
+					"testfunction1": {
+						Parameters: []*tfprotov6.FunctionParameter{},
+						Return: &tfprotov6.FunctionReturn{
+							Type: tftypes.String,
+						},
+					},
+					"testfunction2": {
+						Parameters: []*tfprotov6.FunctionParameter{},
+						Return: &tfprotov6.FunctionReturn{
+							Type: tftypes.Bool,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.GetFunctionsResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
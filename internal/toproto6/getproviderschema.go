@@ -18,10 +18,17 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	}
 
 	protov6 := &tfprotov6.GetProviderSchemaResponse{
-		DataSourceSchemas:  map[string]*tfprotov6.Schema{},
-		Diagnostics:        Diagnostics(ctx, fw.Diagnostics),
-		ResourceSchemas:    map[string]*tfprotov6.Schema{},
-		ServerCapabilities: ServerCapabilities(ctx, fw.ServerCapabilities),
+		DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+		Diagnostics:              Diagnostics(ctx, fw.Diagnostics),
+		EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+		Functions:                make(map[string]*tfprotov6.Function, len(fw.FunctionDefinitions)),
+		ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+		ResourceSchemas:          map[string]*tfprotov6.Schema{},
+		ServerCapabilities:       ServerCapabilities(ctx, fw.ServerCapabilities),
+	}
+
+	for name, functionDefinition := range fw.FunctionDefinitions {
+		protov6.Functions[name] = Function(ctx, functionDefinition)
 	}
 
 	var err error
@@ -64,6 +71,34 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		}
 	}
 
+	for ephemeralResourceType, ephemeralResourceSchema := range fw.EphemeralResourceSchemas {
+		protov6.EphemeralResourceSchemas[ephemeralResourceType], err = Schema(ctx, ephemeralResourceSchema)
+
+		if err != nil {
+			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error converting ephemeral resource schema",
+				Detail:   "The schema for the ephemeral resource \"" + ephemeralResourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+
+			return protov6
+		}
+	}
+
+	for listResourceType, listResourceSchema := range fw.ListResourceSchemas {
+		protov6.ListResourceSchemas[listResourceType], err = Schema(ctx, listResourceSchema)
+
+		if err != nil {
+			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error converting list resource schema",
+				Detail:   "The schema for the list resource \"" + listResourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+
+			return protov6
+		}
+	}
+
 	for resourceType, resourceSchema := range fw.ResourceSchemas {
 		protov6.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
 
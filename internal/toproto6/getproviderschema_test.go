@@ -10,9 +10,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -80,7 +82,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-computed": {
@@ -109,7 +114,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-deprecated": {
@@ -140,7 +148,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-optional": {
@@ -169,7 +180,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-optional-computed": {
@@ -200,7 +214,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-required": {
@@ -229,7 +246,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-sensitive": {
@@ -260,7 +280,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-bool": {
@@ -289,7 +312,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-float64": {
@@ -318,7 +344,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-int64": {
@@ -347,7 +376,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-list-string": {
@@ -383,7 +415,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-nested-attributes": {
@@ -428,7 +463,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-object": {
@@ -468,7 +506,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-string": {
@@ -500,7 +541,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-nested-attributes": {
@@ -545,7 +589,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-string": {
@@ -577,7 +624,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-number": {
@@ -606,7 +656,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-object": {
@@ -642,7 +695,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-nested-attributes": {
@@ -687,7 +743,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-object": {
@@ -727,7 +786,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-set-string": {
@@ -763,7 +825,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-string": {
@@ -795,7 +860,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-single-nested-attributes": {
@@ -838,7 +906,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-string": {
@@ -867,7 +938,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-list": {
@@ -910,7 +984,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-set": {
@@ -953,7 +1030,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-single": {
@@ -994,6 +1074,109 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
+			},
+		},
+		"ephemeral-resource-multiple-ephemeral-resources": {
+			input: &fwserver.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]fwschema.Schema{
+					"test_ephemeral_resource_1": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Computed: true,
+							},
+						},
+					},
+					"test_ephemeral_resource_2": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_ephemeral_resource_1": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test_attribute",
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+					"test_ephemeral_resource_2": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test_attribute",
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+				},
+				ListResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:           map[string]*tfprotov6.Function{},
+				ResourceSchemas:     map[string]*tfprotov6.Schema{},
+			},
+		},
+		"list-resource-multiple-list-resources": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ListResourceSchemas: map[string]fwschema.Schema{
+					"test_list_resource_1": listschema.Schema{
+						Attributes: map[string]listschema.Attribute{
+							"test_attribute": listschema.BoolAttribute{
+								Optional: true,
+							},
+						},
+					},
+					"test_list_resource_2": listschema.Schema{
+						Attributes: map[string]listschema.Attribute{
+							"test_attribute": listschema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_list_resource_1": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Optional: true,
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+					"test_list_resource_2": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
@@ -1009,7 +1192,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1036,7 +1222,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1062,7 +1251,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1089,7 +1281,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1116,7 +1311,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1142,7 +1340,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1168,7 +1369,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1197,7 +1401,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1234,7 +1441,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1274,7 +1484,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1307,7 +1520,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1342,7 +1558,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1378,7 +1597,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1406,7 +1628,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1435,7 +1660,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1472,7 +1700,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1512,7 +1743,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1547,7 +1781,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1578,7 +1815,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1611,7 +1851,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1646,7 +1889,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1678,7 +1924,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -1718,7 +1967,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -1756,7 +2008,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -1790,7 +2045,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1816,7 +2074,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1842,7 +2103,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1868,7 +2132,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1894,7 +2161,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1923,7 +2193,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1960,7 +2233,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2000,7 +2276,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2033,7 +2312,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2068,7 +2350,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2104,7 +2389,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2132,7 +2420,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2161,7 +2452,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2198,7 +2492,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2238,7 +2535,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2273,7 +2573,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2304,7 +2607,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2337,7 +2643,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2372,7 +2681,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2407,7 +2719,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource_1": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2447,7 +2762,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2477,7 +2795,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2507,7 +2828,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2537,7 +2861,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2567,7 +2894,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2597,7 +2927,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2627,7 +2960,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2656,7 +2992,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2685,7 +3024,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2717,7 +3059,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2757,7 +3102,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2800,7 +3148,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2836,7 +3187,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2874,7 +3228,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2913,7 +3270,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2944,7 +3304,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2976,7 +3339,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3016,7 +3382,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3059,7 +3428,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3097,7 +3469,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3131,7 +3506,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3167,7 +3545,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3205,7 +3586,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3240,7 +3624,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3283,7 +3670,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3324,7 +3714,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3357,7 +3750,10 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				ListResourceSchemas:      map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block:   &tfprotov6.SchemaBlock{},
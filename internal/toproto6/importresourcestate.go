@@ -19,6 +19,7 @@ func ImportResourceStateResponse(ctx context.Context, fw *fwserver.ImportResourc
 
 	proto6 := &tfprotov6.ImportResourceStateResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    ResourceDeferred(fw.Deferred),
 	}
 
 	for _, fwImportedResource := range fw.ImportedResources {
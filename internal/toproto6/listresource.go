@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// ListResultsStream returns the *tfprotov6.ListResourceServerStream
+// equivalent of a *fwserver.ListResultsStream. Results are converted lazily
+// as they are consumed.
+func ListResultsStream(ctx context.Context, fw *fwserver.ListResultsStream) *tfprotov6.ListResourceServerStream {
+	if fw == nil || fw.Results == nil {
+		return &tfprotov6.ListResourceServerStream{
+			Results: tfprotov6.NoListResults,
+		}
+	}
+
+	proto6 := &tfprotov6.ListResourceServerStream{
+		Results: func(push func(tfprotov6.ListResourceResult) bool) {
+			for result := range fw.Results {
+				if !push(ListResourceResult(ctx, result)) {
+					return
+				}
+			}
+		},
+	}
+
+	return proto6
+}
+
+// ListResourceResult returns the tfprotov6.ListResourceResult equivalent of
+// a list.ListResult.
+func ListResourceResult(ctx context.Context, fw list.ListResult) tfprotov6.ListResourceResult {
+	proto6 := tfprotov6.ListResourceResult{
+		DisplayName: fw.DisplayName,
+		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+	}
+
+	identity, diags := ResourceIdentity(ctx, fw.Identity)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.Identity = identity
+
+	resource, diags := State(ctx, fw.Resource)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.Resource = resource
+
+	return proto6
+}
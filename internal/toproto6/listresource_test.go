@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestListResultsStream(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test-id"),
+	})
+
+	testDynamicValue, err := tfprotov6.NewDynamicValue(testType, testValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+	}
+
+	testIdentity := &tfsdk.ResourceIdentity{
+		Raw: testValue,
+		Schema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+			},
+		},
+	}
+
+	testResource := &tfsdk.State{
+		Raw: testValue,
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input    *fwserver.ListResultsStream
+		expected []tfprotov6.ListResourceResult
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"results-nil": {
+			input:    &fwserver.ListResultsStream{},
+			expected: nil,
+		},
+		"diagnostics": {
+			input: &fwserver.ListResultsStream{
+				Results: list.ListResultsStreamDiagnostics(diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+					diag.NewErrorDiagnostic("test error summary", "test error details"),
+				}),
+			},
+			expected: []tfprotov6.ListResourceResult{
+				{
+					Diagnostics: []*tfprotov6.Diagnostic{
+						{
+							Severity: tfprotov6.DiagnosticSeverityWarning,
+							Summary:  "test warning summary",
+							Detail:   "test warning details",
+						},
+						{
+							Severity: tfprotov6.DiagnosticSeverityError,
+							Summary:  "test error summary",
+							Detail:   "test error details",
+						},
+					},
+				},
+			},
+		},
+		"identity": {
+			input: &fwserver.ListResultsStream{
+				Results: slices.Values([]list.ListResult{
+					{
+						DisplayName: "test-display-name",
+						Identity:    testIdentity,
+					},
+				}),
+			},
+			expected: []tfprotov6.ListResourceResult{
+				{
+					DisplayName: "test-display-name",
+					Identity: &tfprotov6.ResourceIdentityData{
+						IdentityData: &testDynamicValue,
+					},
+				},
+			},
+		},
+		"identity-and-resource": {
+			input: &fwserver.ListResultsStream{
+				Results: slices.Values([]list.ListResult{
+					{
+						DisplayName: "test-display-name",
+						Identity:    testIdentity,
+						Resource:    testResource,
+					},
+				}),
+			},
+			expected: []tfprotov6.ListResourceResult{
+				{
+					DisplayName: "test-display-name",
+					Identity: &tfprotov6.ResourceIdentityData{
+						IdentityData: &testDynamicValue,
+					},
+					Resource: &testDynamicValue,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto6.ListResultsStream(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(slices.Collect(got.Results), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
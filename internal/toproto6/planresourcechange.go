@@ -21,6 +21,7 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 
 	proto6 := &tfprotov6.PlanResourceChangeResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    ResourceDeferred(fw.Deferred),
 	}
 
 	plannedState, diags := State(ctx, fw.PlannedState)
@@ -28,6 +29,11 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.PlannedState = plannedState
 
+	plannedIdentity, diags := ResourceIdentity(ctx, fw.PlannedIdentity)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.PlannedIdentity = plannedIdentity
+
 	requiresReplace, diags := totftypes.AttributePaths(ctx, fw.RequiresReplace)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -81,6 +82,18 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 			input:    &fwserver.PlanResourceChangeResponse{},
 			expected: &tfprotov6.PlanResourceChangeResponse{},
 		},
+		"deferred": {
+			input: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonProviderConfigUnknown,
+				},
+			},
+			expected: &tfprotov6.PlanResourceChangeResponse{
+				Deferred: &tfprotov6.Deferred{
+					Reason: tfprotov6.DeferredReasonProviderConfigUnknown,
+				},
+			},
+		},
 		"diagnostics": {
 			input: &fwserver.PlanResourceChangeResponse{
 				Diagnostics: diag.Diagnostics{
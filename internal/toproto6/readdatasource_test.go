@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -70,6 +71,18 @@ func TestReadDataSourceResponse(t *testing.T) {
 			input:    &fwserver.ReadDataSourceResponse{},
 			expected: &tfprotov6.ReadDataSourceResponse{},
 		},
+		"deferred": {
+			input: &fwserver.ReadDataSourceResponse{
+				Deferred: &datasource.Deferred{
+					Reason: datasource.DeferredReasonProviderConfigUnknown,
+				},
+			},
+			expected: &tfprotov6.ReadDataSourceResponse{
+				Deferred: &tfprotov6.Deferred{
+					Reason: tfprotov6.DeferredReasonProviderConfigUnknown,
+				},
+			},
+		},
 		"diagnostics": {
 			input: &fwserver.ReadDataSourceResponse{
 				Diagnostics: diag.Diagnostics{
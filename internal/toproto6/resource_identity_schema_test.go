@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         fwschema.Schema
+		expected      *tfprotov6.ResourceIdentitySchema
+		expectedError string
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input: identityschema.Schema{},
+			expected: &tfprotov6.ResourceIdentitySchema{
+				Version: 0,
+			},
+		},
+		"attributes": {
+			input: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"string": identityschema.StringAttribute{
+						RequiredForImport: true,
+					},
+					"bool": identityschema.BoolAttribute{
+						OptionalForImport: true,
+						Description:       "test description",
+					},
+					"list": identityschema.ListAttribute{
+						ElementType:         types.Int64Type,
+						OptionalForImport:   true,
+						MarkdownDescription: "test *markdown* description",
+					},
+				},
+			},
+			expected: &tfprotov6.ResourceIdentitySchema{
+				IdentityAttributes: []*tfprotov6.ResourceIdentitySchemaAttribute{
+					{
+						Name:              "bool",
+						Type:              tftypes.Bool,
+						OptionalForImport: true,
+						Description:       "test description",
+					},
+					{
+						Name:              "list",
+						Type:              tftypes.List{ElementType: tftypes.Number},
+						OptionalForImport: true,
+						Description:       "test *markdown* description",
+					},
+					{
+						Name:              "string",
+						Type:              tftypes.String,
+						RequiredForImport: true,
+					},
+				},
+			},
+		},
+		"version": {
+			input: identityschema.Schema{
+				Version: 2,
+			},
+			expected: &tfprotov6.ResourceIdentitySchema{
+				Version: 2,
+			},
+		},
+		"non-identity-attribute": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedError: `AttributeName("test"): must be a resource identity attribute`,
+		},
+		"import-requirement-missing": {
+			input: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"test": identityschema.StringAttribute{},
+				},
+			},
+			expectedError: `AttributeName("test"): must have exactly one of RequiredForImport or OptionalForImport set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toproto6.ResourceIdentitySchema(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		Computed:  a.IsComputed(),
 		Sensitive: a.IsSensitive(),
 		Type:      a.GetType().TerraformType(ctx),
+		WriteOnly: a.IsWriteOnly(),
 	}
 
 	if a.GetDeprecationMessage() != "" {
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package list contains all interfaces, request types, and response types
+// for a list resource implementation.
+//
+// In Terraform, a list resource is a concept which enables provider
+// developers to offer practitioners a way to discover existing remote objects
+// of a managed resource type, such as for bulk import. List resources are tied
+// to an existing managed resource type via their type name, such as
+// "examplecloud_thing", and define a schema representing the structure and
+// data types of configuration used to filter results. Each result includes the
+// resource identity and, optionally, the full resource state.
+//
+// The main starting point for implementations in this package is the
+// ListResource type which represents an instance of a list resource that has
+// its own configuration and list logic. The ListResource implementations are
+// referenced by the [provider.ProviderWithListResources] type ListResources
+// method, which enables the list resource for practitioner and testing usage.
+package list
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ListRequest represents a request for the provider to list the remote
+// objects of a list resource type. An instance of this request struct is
+// supplied as an argument to the ListResource type List method.
+type ListRequest struct {
+	// Config is the configuration the user supplied for the list resource,
+	// which is used to filter results.
+	Config tfsdk.Config
+
+	// IncludeResource indicates whether the practitioner requested the full
+	// resource state to be included with each result. When false, results
+	// should only include the resource identity and display name.
+	IncludeResource bool
+
+	// Limit is the maximum number of results Terraform will consume. The
+	// framework stops requesting results from the stream once the limit is
+	// reached, however implementations can use this value to reduce the
+	// number of remote requests.
+	Limit int64
+
+	// ResourceSchema is the schema of the managed resource with the same
+	// type name as the list resource. It is used to create the Resource
+	// field of each ListResult.
+	ResourceSchema fwschema.Schema
+
+	// ResourceIdentitySchema is the identity schema of the managed resource
+	// with the same type name as the list resource. It is used to create the
+	// Identity field of each ListResult.
+	ResourceIdentitySchema fwschema.Schema
+}
+
+// NewListResult returns a ListResult with a null Identity, and a null
+// Resource if IncludeResource is set, matching the schemas of the managed
+// resource. Implementations should use this method to create each result
+// before populating it.
+func (r ListRequest) NewListResult(ctx context.Context) ListResult {
+	result := ListResult{}
+
+	if r.ResourceIdentitySchema != nil {
+		result.Identity = &tfsdk.ResourceIdentity{
+			Raw:    tftypes.NewValue(r.ResourceIdentitySchema.Type().TerraformType(ctx), nil),
+			Schema: r.ResourceIdentitySchema,
+		}
+	}
+
+	if r.IncludeResource && r.ResourceSchema != nil {
+		result.Resource = &tfsdk.State{
+			Raw:    tftypes.NewValue(r.ResourceSchema.Type().TerraformType(ctx), nil),
+			Schema: r.ResourceSchema,
+		}
+	}
+
+	return result
+}
+
+// ListResultsStream represents a streaming response to a ListRequest. An
+// instance of this response struct is supplied as an argument to the
+// ListResource type List method.
+type ListResultsStream struct {
+	// Results is a function that emits ListResult values via its yield
+	// function argument. Implementations should stop emitting results when
+	// the yield function returns false, which occurs when Terraform has
+	// consumed enough results or the request was cancelled.
+	//
+	// To indicate a fatal error, emit a single ListResult containing the
+	// error diagnostics, which can be created with ListResultsStreamDiagnostics.
+	// Set this field to NoListResults when there are no results.
+	Results iter.Seq[ListResult]
+}
+
+// NoListResults is an iterator that emits no results.
+var NoListResults = func(func(ListResult) bool) {}
+
+// ListResultsStreamDiagnostics returns an iterator that emits a single
+// ListResult containing only the given diagnostics. This is useful for
+// returning errors that prevent any results from being listed.
+func ListResultsStreamDiagnostics(diags diag.Diagnostics) iter.Seq[ListResult] {
+	return func(push func(ListResult) bool) {
+		push(ListResult{Diagnostics: diags})
+	}
+}
+
+// ListResult represents a single remote object returned by a list resource.
+type ListResult struct {
+	// DisplayName is a human readable name for the remote object, which is
+	// shown to practitioners alongside the identity.
+	DisplayName string
+
+	// Identity is the resource identity of the remote object. This is
+	// required for every result without error diagnostics.
+	Identity *tfsdk.ResourceIdentity
+
+	// Resource is the full resource state of the remote object. This must
+	// only be set when the ListRequest IncludeResource field is true.
+	Resource *tfsdk.State
+
+	// Diagnostics report errors or warnings related to this result. An empty
+	// slice indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ListResource represents an instance of a list resource type. This is the
+// core interface that all list resources must implement.
+//
+// List resources can optionally implement these additional concepts:
+//
+//   - Configure: Include provider-level data or clients via
+//     ListResourceWithConfigure.
+//   - Validation: Schema-based or entire configuration via
+//     ListResourceWithConfigValidators or ListResourceWithValidateConfig.
+type ListResource interface {
+	// Metadata should return the full name of the list resource, such as
+	// examplecloud_thing. The type name must match an existing managed
+	// resource type, as list results are returned using the identity and
+	// state schemas of that resource.
+	Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse)
+
+	// ListResourceConfigSchema should return the schema for the list
+	// configuration, which is used to filter the results.
+	ListResourceConfigSchema(context.Context, ListResourceSchemaRequest, *ListResourceSchemaResponse)
+
+	// List is called when the provider must stream the remote objects of
+	// this list resource type. Config values should be read from the
+	// ListRequest and results set on the ListResultsStream.
+	List(context.Context, ListRequest, *ListResultsStream)
+}
+
+// ListResourceWithConfigure is an interface type that extends ListResource
+// to include a method which the framework will automatically call so provider
+// developers have the opportunity to setup any necessary provider-level data
+// or clients in the ListResource type.
+//
+// The request ProviderData is the same
+// [provider.ConfigureResponse.ResourceData] field value passed to the managed
+// resource, so list and managed resources can share clients.
+type ListResourceWithConfigure interface {
+	ListResource
+
+	// Configure enables provider-level data or clients to be set in the
+	// provider-defined ListResource type.
+	Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse)
+}
+
+// ListResourceWithConfigValidators is an interface type that extends
+// ListResource to include declarative validations.
+//
+// Declaring validation using this methodology simplifies implementation of
+// reusable functionality. These also include descriptions, which can be used
+// for automating documentation.
+//
+// Validation will include ConfigValidators and ValidateConfig, if both are
+// implemented, in addition to any Attribute or Type validation.
+type ListResourceWithConfigValidators interface {
+	ListResource
+
+	// ConfigValidators returns a list of functions which will all be performed during validation.
+	ConfigValidators(context.Context) []ConfigValidator
+}
+
+// ListResourceWithValidateConfig is an interface type that extends
+// ListResource to include imperative validation.
+//
+// Declaring validation using this methodology simplifies one-off
+// functionality that typically applies to a single list resource. Any
+// documentation of this functionality must be manually added into schema
+// descriptions.
+//
+// Validation will include ConfigValidators and ValidateConfig, if both are
+// implemented, in addition to any Attribute or Type validation.
+type ListResourceWithValidateConfig interface {
+	ListResource
+
+	// ValidateConfig performs the validation.
+	ValidateConfig(context.Context, ValidateConfigRequest, *ValidateConfigResponse)
+}
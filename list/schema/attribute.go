@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// Attribute define a value field inside the Schema. Implementations in this
+// package include:
+//   - BoolAttribute
+//   - DynamicAttribute
+//   - Float32Attribute
+//   - Float64Attribute
+//   - Int32Attribute
+//   - Int64Attribute
+//   - ListAttribute
+//   - MapAttribute
+//   - NumberAttribute
+//   - ObjectAttribute
+//   - SetAttribute
+//   - StringAttribute
+//
+// Nested attributes are not supported in list resource configuration. Use
+// blocks for nested configuration instead.
+//
+// In practitioner configurations, an equals sign (=) is required to set
+// the value. [Configuration Reference]
+//
+// [Configuration Reference]: https://developer.hashicorp.com/terraform/language/syntax/configuration
+type Attribute interface {
+	fwschema.Attribute
+}
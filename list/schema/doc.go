@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schema contains all available schema functionality for list
+// resources. List resource schemas define the structure and value types
+// for the configuration used to filter results. Schemas are implemented via
+// the list.ListResource type ListResourceConfigSchema method.
+package schema
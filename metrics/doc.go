@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metrics contains the interface and types for reporting the
+// duration and outcome of framework operations and provider defined methods.
+//
+// Reporters are configured on the provider server, such as with the
+// providerserver.ServeOpts type MetricsReporter field. The framework then
+// reports a measurement for each operation Terraform requests, such as a
+// managed resource plan, and for each provider defined method called while
+// handling it, such as a resource ModifyPlan method or an individual
+// attribute validator, plan modifier, or default. This can help determine
+// which resources or attributes dominate the time Terraform spends in the
+// provider.
+//
+// The main starting point for implementations in this package is the
+// Reporter type. The InMemory type is a reference implementation which
+// aggregates measurements in memory.
+package metrics
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+)
+
+var _ ReporterWithStopProvider = &InMemory{}
+
+// InMemory is a Reporter which aggregates measurements in memory, keyed by
+// operation, type name, and for methods, the implementation, method name,
+// and attribute path. It is intended as a reference implementation and for
+// ad-hoc performance investigations. The zero value is ready to use.
+type InMemory struct {
+	// SummaryOutput, if set, receives the summary table written by
+	// WriteSummary when Terraform calls the StopProvider RPC.
+	SummaryOutput io.Writer
+
+	mu        sync.Mutex
+	summaries map[summaryKey]*Summary
+}
+
+// Summary is the aggregate of all measurements with the same key.
+type Summary struct {
+	// Operation is the kind of framework operation.
+	Operation interceptor.Operation
+
+	// TypeName is the type name of the operation.
+	TypeName string
+
+	// Implementation is the Go type which implements the method. It is empty
+	// for operation summaries.
+	Implementation string
+
+	// Method is the name of the provider defined method. It is empty for
+	// operation summaries.
+	Method string
+
+	// AttributePath is the string representation of the attribute path of
+	// the method, if any.
+	AttributePath string
+
+	// Count is the total number of measurements.
+	Count int
+
+	// ErrorCount is the number of measurements with OutcomeError.
+	ErrorCount int
+
+	// PanicCount is the number of measurements with OutcomePanic.
+	PanicCount int
+
+	// TotalDuration is the sum of all measurement durations.
+	TotalDuration time.Duration
+
+	// MaxDuration is the longest measurement duration.
+	MaxDuration time.Duration
+}
+
+// summaryKey is the aggregation key of a Summary.
+type summaryKey struct {
+	operation      interceptor.Operation
+	typeName       string
+	implementation string
+	method         string
+	attributePath  string
+}
+
+// ReportOperation satisfies the Reporter interface.
+func (m *InMemory) ReportOperation(_ context.Context, measurement OperationMeasurement) {
+	key := summaryKey{
+		operation: measurement.Operation,
+		typeName:  measurement.TypeName,
+	}
+
+	m.record(key, measurement.Duration, measurement.Outcome)
+}
+
+// ReportMethod satisfies the Reporter interface.
+func (m *InMemory) ReportMethod(_ context.Context, measurement MethodMeasurement) {
+	key := summaryKey{
+		operation:      measurement.Operation,
+		typeName:       measurement.TypeName,
+		implementation: measurement.Implementation,
+		method:         measurement.Method,
+		attributePath:  measurement.AttributePath.String(),
+	}
+
+	m.record(key, measurement.Duration, measurement.Outcome)
+}
+
+// StopProvider satisfies the ReporterWithStopProvider interface. If
+// SummaryOutput is set, the summary table is written to it.
+func (m *InMemory) StopProvider(_ context.Context) {
+	if m.SummaryOutput == nil {
+		return
+	}
+
+	// There is no way to surface this error to Terraform.
+	_ = m.WriteSummary(m.SummaryOutput)
+}
+
+// Summaries returns the aggregated measurements, ordered by descending
+// TotalDuration, so the most time consuming operations and methods are
+// first.
+func (m *InMemory) Summaries() []Summary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]Summary, 0, len(m.summaries))
+
+	for _, summary := range m.summaries {
+		result = append(result, *summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalDuration != result[j].TotalDuration {
+			return result[i].TotalDuration > result[j].TotalDuration
+		}
+
+		return result[i].key().less(result[j].key())
+	})
+
+	return result
+}
+
+// WriteSummary writes the aggregated measurements to the given writer as a
+// human readable table, ordered the same as Summaries.
+func (m *InMemory) WriteSummary(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "OPERATION\tTYPE NAME\tIMPLEMENTATION\tMETHOD\tATTRIBUTE PATH\tCOUNT\tERRORS\tPANICS\tTOTAL\tMAX")
+
+	for _, s := range m.Summaries() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			s.Operation, s.TypeName, s.Implementation, s.Method, s.AttributePath,
+			s.Count, s.ErrorCount, s.PanicCount, s.TotalDuration, s.MaxDuration)
+	}
+
+	return tw.Flush()
+}
+
+// Reset removes all aggregated measurements.
+func (m *InMemory) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.summaries = nil
+}
+
+// record adds a measurement to the summary of the given key.
+func (m *InMemory) record(key summaryKey, duration time.Duration, outcome Outcome) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.summaries == nil {
+		m.summaries = make(map[summaryKey]*Summary)
+	}
+
+	summary, ok := m.summaries[key]
+
+	if !ok {
+		summary = &Summary{
+			Operation:      key.operation,
+			TypeName:       key.typeName,
+			Implementation: key.implementation,
+			Method:         key.method,
+			AttributePath:  key.attributePath,
+		}
+
+		m.summaries[key] = summary
+	}
+
+	summary.Count++
+	summary.TotalDuration += duration
+
+	if duration > summary.MaxDuration {
+		summary.MaxDuration = duration
+	}
+
+	switch outcome {
+	case OutcomeError:
+		summary.ErrorCount++
+	case OutcomePanic:
+		summary.PanicCount++
+	}
+}
+
+// key returns the aggregation key of the Summary.
+func (s Summary) key() summaryKey {
+	return summaryKey{
+		operation:      s.Operation,
+		typeName:       s.TypeName,
+		implementation: s.Implementation,
+		method:         s.Method,
+		attributePath:  s.AttributePath,
+	}
+}
+
+// less returns true if the key sorts before the other key, which keeps
+// Summaries ordering deterministic for equal durations.
+func (k summaryKey) less(other summaryKey) bool {
+	if k.operation != other.operation {
+		return k.operation < other.operation
+	}
+
+	if k.typeName != other.typeName {
+		return k.typeName < other.typeName
+	}
+
+	if k.implementation != other.implementation {
+		return k.implementation < other.implementation
+	}
+
+	if k.method != other.method {
+		return k.method < other.method
+	}
+
+	return k.attributePath < other.attributePath
+}
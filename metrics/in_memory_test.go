@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestInMemorySummaries(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operations []metrics.OperationMeasurement
+		methods    []metrics.MethodMeasurement
+		expected   []metrics.Summary
+	}{
+		"empty": {
+			expected: []metrics.Summary{},
+		},
+		"operations": {
+			operations: []metrics.OperationMeasurement{
+				{
+					Operation: interceptor.OperationReadResource,
+					TypeName:  "test_resource",
+					Duration:  2 * time.Second,
+					Outcome:   metrics.OutcomeSuccess,
+				},
+				{
+					Operation: interceptor.OperationReadResource,
+					TypeName:  "test_resource",
+					Duration:  3 * time.Second,
+					Outcome:   metrics.OutcomeError,
+				},
+				{
+					Operation: interceptor.OperationReadResource,
+					TypeName:  "test_other",
+					Duration:  time.Second,
+					Outcome:   metrics.OutcomeSuccess,
+				},
+			},
+			expected: []metrics.Summary{
+				{
+					Operation:     interceptor.OperationReadResource,
+					TypeName:      "test_resource",
+					Count:         2,
+					ErrorCount:    1,
+					TotalDuration: 5 * time.Second,
+					MaxDuration:   3 * time.Second,
+				},
+				{
+					Operation:     interceptor.OperationReadResource,
+					TypeName:      "test_other",
+					Count:         1,
+					TotalDuration: time.Second,
+					MaxDuration:   time.Second,
+				},
+			},
+		},
+		"methods": {
+			methods: []metrics.MethodMeasurement{
+				{
+					Operation:      interceptor.OperationPlanResourceChange,
+					TypeName:       "test_resource",
+					Implementation: "*provider.testValidator",
+					Method:         "ValidateString",
+					AttributePath:  path.Root("name"),
+					Duration:       time.Second,
+					Outcome:        metrics.OutcomeSuccess,
+				},
+				{
+					Operation:      interceptor.OperationPlanResourceChange,
+					TypeName:       "test_resource",
+					Implementation: "*provider.testValidator",
+					Method:         "ValidateString",
+					AttributePath:  path.Root("name"),
+					Duration:       time.Second,
+					Outcome:        metrics.OutcomePanic,
+				},
+				{
+					Operation:      interceptor.OperationPlanResourceChange,
+					TypeName:       "test_resource",
+					Implementation: "*provider.testValidator",
+					Method:         "ValidateString",
+					AttributePath:  path.Root("description"),
+					Duration:       time.Second,
+					Outcome:        metrics.OutcomeSuccess,
+				},
+			},
+			expected: []metrics.Summary{
+				{
+					Operation:      interceptor.OperationPlanResourceChange,
+					TypeName:       "test_resource",
+					Implementation: "*provider.testValidator",
+					Method:         "ValidateString",
+					AttributePath:  "name",
+					Count:          2,
+					PanicCount:     1,
+					TotalDuration:  2 * time.Second,
+					MaxDuration:    time.Second,
+				},
+				{
+					Operation:      interceptor.OperationPlanResourceChange,
+					TypeName:       "test_resource",
+					Implementation: "*provider.testValidator",
+					Method:         "ValidateString",
+					AttributePath:  "description",
+					Count:          1,
+					TotalDuration:  time.Second,
+					MaxDuration:    time.Second,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reporter := &metrics.InMemory{}
+
+			for _, measurement := range testCase.operations {
+				reporter.ReportOperation(context.Background(), measurement)
+			}
+
+			for _, measurement := range testCase.methods {
+				reporter.ReportMethod(context.Background(), measurement)
+			}
+
+			got := reporter.Summaries()
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInMemoryReset(t *testing.T) {
+	t.Parallel()
+
+	reporter := &metrics.InMemory{}
+
+	reporter.ReportOperation(context.Background(), metrics.OperationMeasurement{
+		Operation: interceptor.OperationReadResource,
+		TypeName:  "test_resource",
+	})
+
+	reporter.Reset()
+
+	if got := reporter.Summaries(); len(got) != 0 {
+		t.Errorf("expected no summaries, got: %v", got)
+	}
+}
+
+func TestInMemoryStopProvider(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	reporter := &metrics.InMemory{
+		SummaryOutput: &output,
+	}
+
+	reporter.ReportOperation(context.Background(), metrics.OperationMeasurement{
+		Operation: interceptor.OperationReadResource,
+		TypeName:  "test_resource",
+		Duration:  2 * time.Second,
+		Outcome:   metrics.OutcomeError,
+	})
+
+	reporter.ReportMethod(context.Background(), metrics.MethodMeasurement{
+		Operation:      interceptor.OperationReadResource,
+		TypeName:       "test_resource",
+		Implementation: "*provider.testResource",
+		Method:         "Read",
+		Duration:       time.Second,
+		Outcome:        metrics.OutcomeSuccess,
+	})
+
+	reporter.StopProvider(context.Background())
+
+	expected := "OPERATION     TYPE NAME      IMPLEMENTATION          METHOD  ATTRIBUTE PATH  COUNT  ERRORS  PANICS  TOTAL  MAX\n" +
+		"ReadResource  test_resource                                                  1      1       0       2s     2s\n" +
+		"ReadResource  test_resource  *provider.testResource  Read                    1      0       0       1s     1s\n"
+
+	if diff := cmp.Diff(expected, output.String()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
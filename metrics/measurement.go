@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Outcome is the result of a measured operation or method.
+type Outcome string
+
+const (
+	// OutcomeSuccess represents an operation or method which did not
+	// return error diagnostics.
+	OutcomeSuccess Outcome = "success"
+
+	// OutcomeError represents an operation or method which returned error
+	// diagnostics, or for function calls, a function error.
+	OutcomeError Outcome = "error"
+
+	// OutcomePanic represents a provider defined method which panicked. The
+	// panic was recovered and converted into an error diagnostic.
+	OutcomePanic Outcome = "panic"
+)
+
+// OperationMeasurement is the measurement of a single framework operation.
+type OperationMeasurement struct {
+	// Operation is the kind of framework operation, such as
+	// interceptor.OperationReadResource.
+	Operation interceptor.Operation
+
+	// TypeName is the type name of the managed resource, data source,
+	// ephemeral resource, or list resource, or the name of the function,
+	// which the operation is for. It is empty for provider-level operations.
+	TypeName string
+
+	// Duration is the time spent in the operation, including any
+	// interceptors.
+	Duration time.Duration
+
+	// Outcome is the result of the operation.
+	Outcome Outcome
+}
+
+// MethodMeasurement is the measurement of a single provider defined method
+// call.
+type MethodMeasurement struct {
+	// Operation is the kind of framework operation which called the method.
+	// It is empty if the method was called while preparing an operation,
+	// such as a resource Schema method called to convert request data.
+	Operation interceptor.Operation
+
+	// TypeName is the type name of the operation which called the method.
+	// It is empty for provider-level operations or if Operation is empty.
+	TypeName string
+
+	// Implementation is the Go type of the provider defined value which
+	// implements the method, such as "*provider.ThingResource" or
+	// "stringvalidator.lengthBetweenValidator".
+	Implementation string
+
+	// Method is the name of the method, such as "Read" or "ValidateString".
+	Method string
+
+	// AttributePath is the path of the attribute being operated on, if the
+	// method is an attribute validator, plan modifier, or default.
+	// Otherwise, it is an empty path.
+	AttributePath path.Path
+
+	// Duration is the time spent in the method.
+	Duration time.Duration
+
+	// Outcome is the result of the method.
+	Outcome Outcome
+}
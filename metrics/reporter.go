@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+)
+
+// Reporter receives measurements of framework operations and provider
+// defined methods. Implementations must be safe for concurrent use, since
+// Terraform can request multiple operations concurrently.
+//
+// Reporter methods are called synchronously while handling the operation,
+// so implementations should return quickly.
+type Reporter interface {
+	// ReportOperation is called after each framework operation, such as a
+	// managed resource read or plan, completes.
+	ReportOperation(context.Context, OperationMeasurement)
+
+	// ReportMethod is called after each provider defined method, such as a
+	// resource Read method or an attribute validator, returns.
+	ReportMethod(context.Context, MethodMeasurement)
+}
+
+// ReporterWithStopProvider is a Reporter with a StopProvider method, which
+// is called when Terraform calls the StopProvider RPC, such as when a
+// practitioner interrupts a Terraform command.
+type ReporterWithStopProvider interface {
+	Reporter
+
+	// StopProvider is called when Terraform calls the StopProvider RPC.
+	StopProvider(context.Context)
+}
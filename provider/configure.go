@@ -24,6 +24,10 @@ type ConfigureRequest struct {
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
 	Config tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ConfigureProvider RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ConfigureProviderClientCapabilities
 }
 
 // ConfigureResponse represents a response to a
@@ -41,8 +45,24 @@ type ConfigureResponse struct {
 	// errors generated.
 	Diagnostics diag.Diagnostics
 
+	// EphemeralResourceData is provider-defined data, clients, etc. that is
+	// passed to [ephemeral.ConfigureRequest.ProviderData] for each
+	// EphemeralResource type that implements the Configure method.
+	EphemeralResourceData any
+
 	// ResourceData is provider-defined data, clients, etc. that is passed
 	// to [resource.ConfigureRequest.ProviderData] for each Resource type
-	// that implements the Configure method.
+	// that implements the Configure method. It is also passed to each
+	// ListResource type that implements the Configure method.
 	ResourceData any
+
+	// Deferred indicates that Terraform should automatically defer
+	// all resources and data sources for this provider.
+	//
+	// This field can only be set if
+	// `(provider.ConfigureRequest).ClientCapabilities.DeferralAllowed` is true.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	Deferred *Deferred
 }
@@ -117,3 +117,9 @@ func (a BoolAttribute) IsRequired() bool {
 func (a BoolAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a BoolAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package metaschema
 
 import (
@@ -8,10 +11,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute = DynamicAttribute{}
 )
 
+// DynamicAttribute represents a schema attribute that is dynamic, whose
+// underlying type is only determined by the configuration or provider logic.
+// When retrieving the value for this attribute, use types.Dynamic as the value
+// type unless the CustomType field is set.
+//
+// Terraform configurations configure this attribute using expressions that
+// return any type of value.
+//
+//	example_attribute = "value"
+//	example_attribute = ["value1", "value2"]
+//
+// Terraform configurations reference this attribute using the attribute name.
+//
+//	.example_attribute
 type DynamicAttribute struct {
 	// CustomType enables the use of a custom attribute type in place of the
 	// default basetypes.DynamicType. When retrieving data, the basetypes.DynamicValuable
@@ -78,7 +96,7 @@ func (a DynamicAttribute) GetType() attr.Type {
 		return a.CustomType
 	}
 
-	return types.DynamicType{}
+	return types.DynamicType
 }
 
 // IsComputed always returns false as provider schemas cannot be Computed.
@@ -101,3 +119,9 @@ func (a DynamicAttribute) IsRequired() bool {
 func (a DynamicAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a DynamicAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -120,3 +120,9 @@ func (a Float64Attribute) IsRequired() bool {
 func (a Float64Attribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a Float64Attribute) IsWriteOnly() bool {
+	return false
+}
@@ -120,3 +120,9 @@ func (a Int64Attribute) IsRequired() bool {
 func (a Int64Attribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a Int64Attribute) IsWriteOnly() bool {
+	return false
+}
@@ -134,6 +134,12 @@ func (a ListAttribute) IsSensitive() bool {
 	return false
 }
 
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a ListAttribute) IsWriteOnly() bool {
+	return false
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC
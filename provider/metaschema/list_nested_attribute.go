@@ -159,3 +159,9 @@ func (a ListNestedAttribute) IsRequired() bool {
 func (a ListNestedAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a ListNestedAttribute) IsWriteOnly() bool {
+	return false
+}
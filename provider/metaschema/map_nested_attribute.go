@@ -159,3 +159,9 @@ func (a MapNestedAttribute) IsRequired() bool {
 func (a MapNestedAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a MapNestedAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -121,3 +121,9 @@ func (a NumberAttribute) IsRequired() bool {
 func (a NumberAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a NumberAttribute) IsWriteOnly() bool {
+	return false
+}
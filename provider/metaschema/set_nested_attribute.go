@@ -154,3 +154,9 @@ func (a SetNestedAttribute) IsRequired() bool {
 func (a SetNestedAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a SetNestedAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -174,3 +174,9 @@ func (a SingleNestedAttribute) IsRequired() bool {
 func (a SingleNestedAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a SingleNestedAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -509,3 +509,35 @@ func TestSingleNestedAttributeIsSensitive(t *testing.T) {
 		})
 	}
 }
+
+func TestSingleNestedAttributeIsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute metaschema.SingleNestedAttribute
+		expected  bool
+	}{
+		"not-writeOnly": {
+			attribute: metaschema.SingleNestedAttribute{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.StringAttribute{},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsWriteOnly()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -117,3 +117,9 @@ func (a StringAttribute) IsRequired() bool {
 func (a StringAttribute) IsSensitive() bool {
 	return false
 }
+
+// IsWriteOnly always returns false as there is no plan or state for
+// provider meta schema data.
+func (a StringAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -7,6 +7,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -17,6 +20,9 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Functions: ProviderWithFunctions
+//   - Ephemeral Resources: ProviderWithEphemeralResources
+//   - List Resources: ProviderWithListResources
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -68,6 +74,55 @@ type ProviderWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ProviderWithEphemeralResources is an interface type that extends Provider to
+// include ephemeral resources for usage in practitioner configurations.
+//
+// Ephemeral resources are supported in Terraform version 1.10 and later.
+type ProviderWithEphemeralResources interface {
+	Provider
+
+	// EphemeralResources returns a slice of functions to instantiate each
+	// EphemeralResource implementation.
+	//
+	// The ephemeral resource type name is determined by the EphemeralResource
+	// implementing the Metadata method. All ephemeral resources must have
+	// unique names.
+	EphemeralResources(context.Context) []func() ephemeral.EphemeralResource
+}
+
+// ProviderWithListResources is an interface type that extends Provider to
+// include list resources for discovering existing remote objects.
+//
+// Each list resource type name must match a managed resource type name
+// returned by the Resources method, as list results use the identity and
+// state schemas of that managed resource.
+type ProviderWithListResources interface {
+	Provider
+
+	// ListResources returns a slice of functions to instantiate each
+	// ListResource implementation.
+	//
+	// The list resource type name is determined by the ListResource
+	// implementing the Metadata method. All list resources must have unique
+	// names.
+	ListResources(context.Context) []func() list.ListResource
+}
+
+// ProviderWithFunctions is an interface type that extends Provider to
+// include provider defined functions for usage in practitioner configurations.
+//
+// Provider-defined functions are supported in Terraform version 1.8 and later.
+type ProviderWithFunctions interface {
+	Provider
+
+	// Functions returns a slice of functions to instantiate each Function
+	// implementation.
+	//
+	// The function name is determined by the Function implementing its Metadata
+	// method. All functions must have unique names.
+	Functions(context.Context) []func() function.Function
+}
+
 // ProviderWithMetaSchema is a provider with a provider meta schema, which
 // is configured by practitioners via the provider_meta configuration block
 // and the configuration data is included with certain data source and resource
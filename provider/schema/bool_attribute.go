@@ -178,3 +178,9 @@ func (a BoolAttribute) IsRequired() bool {
 func (a BoolAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly returns false as write-only attributes are not supported in
+// provider schemas, which are never persisted to plan or state.
+func (a BoolAttribute) IsWriteOnly() bool {
+	return false
+}
@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package schema
 
 import (
@@ -10,11 +13,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                = DynamicAttribute{}
 	_ fwxschema.AttributeWithDynamicValidators = DynamicAttribute{}
 )
 
+// DynamicAttribute represents a schema attribute that is dynamic, whose
+// underlying type is only determined by the configuration or provider logic.
+// When retrieving the value for this attribute, use types.Dynamic as the value
+// type unless the CustomType field is set.
+//
+// Terraform configurations configure this attribute using expressions that
+// return any type of value.
+//
+//	example_attribute = "value"
+//	example_attribute = ["value1", "value2"]
+//
+// Terraform configurations reference this attribute using the attribute name.
+//
+//	.example_attribute
 type DynamicAttribute struct {
 	// CustomType enables the use of a custom attribute type in place of the
 	// default basetypes.DynamicType. When retrieving data, the basetypes.DynamicValuable
@@ -114,8 +132,7 @@ func (a DynamicAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
-// GetDeprecationMessage always returns an empty string as there is no
-// deprecation validation support for provider meta schemas.
+// GetDeprecationMessage returns the DeprecationMessage field value.
 func (a DynamicAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
@@ -136,7 +153,7 @@ func (a DynamicAttribute) GetType() attr.Type {
 		return a.CustomType
 	}
 
-	return types.DynamicType{}
+	return types.DynamicType
 }
 
 // IsComputed always returns false as provider schemas cannot be Computed.
@@ -159,7 +176,13 @@ func (a DynamicAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
-// StringValidators returns the Validators field value.
+// IsWriteOnly returns false as write-only attributes are not supported in
+// provider schemas, which are never persisted to plan or state.
+func (a DynamicAttribute) IsWriteOnly() bool {
+	return false
+}
+
+// DynamicValidators returns the Validators field value.
 func (a DynamicAttribute) DynamicValidators() []validator.Dynamic {
 	return a.Validators
 }
@@ -181,3 +181,9 @@ func (a Float64Attribute) IsRequired() bool {
 func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
+
+// IsWriteOnly returns false as write-only attributes are not supported in
+// provider schemas, which are never persisted to plan or state.
+func (a Float64Attribute) IsWriteOnly() bool {
+	return false
+}
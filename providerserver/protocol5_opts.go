@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Protocol5Opt is an option for the protocol version 5 provider server
+// returned by NewProtocol5 and NewProtocol5WithError.
+type Protocol5Opt func(*protocol5Opts)
+
+// protocol5Opts is the collection of options applied by Protocol5Opt.
+type protocol5Opts struct {
+	interceptors             []interceptor.Interceptor
+	metricsReporter          metrics.Reporter
+	resourceConcurrencyLimit int
+	nestedAttributesAsBlocks bool
+}
+
+// WithProtocol5Interceptors returns a Protocol5Opt which wraps each framework
+// operation, such as a managed resource read, with the given interceptors.
+// The first interceptor is the outermost in the chain. Refer to the
+// interceptor package documentation for more details.
+func WithProtocol5Interceptors(interceptors ...interceptor.Interceptor) Protocol5Opt {
+	return func(o *protocol5Opts) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithNestedAttributesAsBlocks returns a Protocol5Opt which converts single,
+// list, and set nested attributes into the equivalent nested blocks, since
+// protocol version 5 does not support nested attributes. This enables serving
+// the same schemas over both protocol versions, such as when muxing with a
+// terraform-plugin-sdk/v2 provider.
+//
+// Provider data is converted between the two representations automatically,
+// where empty list and set blocks from Terraform are null nested attribute
+// values in the provider. Nested attributes which cannot be represented as
+// blocks return an error diagnostic from the GetProviderSchema RPC:
+//
+//   - Map nested attributes.
+//   - Computed nested attributes, including Optional and Computed.
+//   - Sensitive nested attributes. Attributes underneath the nested
+//     attribute can still be Sensitive.
+//   - WriteOnly nested attributes. Attributes underneath the nested
+//     attribute can still be WriteOnly.
+func WithNestedAttributesAsBlocks() Protocol5Opt {
+	return func(o *protocol5Opts) {
+		o.nestedAttributesAsBlocks = true
+	}
+}
+
+// WithProtocol5MetricsReporter returns a Protocol5Opt which reports the
+// duration and outcome of each framework operation and provider defined
+// method to the given reporter. Refer to the metrics package documentation
+// for more details.
+func WithProtocol5MetricsReporter(reporter metrics.Reporter) Protocol5Opt {
+	return func(o *protocol5Opts) {
+		o.metricsReporter = reporter
+	}
+}
+
+// WithProtocol5ResourceConcurrencyLimit returns a Protocol5Opt which limits
+// the number of concurrent Create, Read, Update, and Delete calls of each
+// managed resource type to the given limit. Resource types which implement
+// resource.ResourceWithConcurrencyLimit override this default.
+func WithProtocol5ResourceConcurrencyLimit(limit int) Protocol5Opt {
+	return func(o *protocol5Opts) {
+		o.resourceConcurrencyLimit = limit
+	}
+}
+
+// newProtocol5Server returns the protocol version 5 provider server for the
+// given Provider and options.
+func newProtocol5Server(p provider.Provider, opts ...Protocol5Opt) *proto5server.Server {
+	var o protocol5Opts
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &proto5server.Server{
+		FrameworkServer: fwserver.Server{
+			Interceptors:             o.interceptors,
+			MetricsReporter:          o.metricsReporter,
+			Provider:                 p,
+			ResourceConcurrencyLimit: o.resourceConcurrencyLimit,
+		},
+		NestedAttributesAsBlocks: o.nestedAttributesAsBlocks,
+	}
+}
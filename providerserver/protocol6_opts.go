@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Protocol6Opt is an option for the protocol version 6 provider server
+// returned by NewProtocol6 and NewProtocol6WithError.
+type Protocol6Opt func(*protocol6Opts)
+
+// protocol6Opts is the collection of options applied by Protocol6Opt.
+type protocol6Opts struct {
+	interceptors             []interceptor.Interceptor
+	metricsReporter          metrics.Reporter
+	resourceConcurrencyLimit int
+}
+
+// WithProtocol6Interceptors returns a Protocol6Opt which wraps each framework
+// operation, such as a managed resource read, with the given interceptors.
+// The first interceptor is the outermost in the chain. Refer to the
+// interceptor package documentation for more details.
+func WithProtocol6Interceptors(interceptors ...interceptor.Interceptor) Protocol6Opt {
+	return func(o *protocol6Opts) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithProtocol6MetricsReporter returns a Protocol6Opt which reports the
+// duration and outcome of each framework operation and provider defined
+// method to the given reporter. Refer to the metrics package documentation
+// for more details.
+func WithProtocol6MetricsReporter(reporter metrics.Reporter) Protocol6Opt {
+	return func(o *protocol6Opts) {
+		o.metricsReporter = reporter
+	}
+}
+
+// WithProtocol6ResourceConcurrencyLimit returns a Protocol6Opt which limits
+// the number of concurrent Create, Read, Update, and Delete calls of each
+// managed resource type to the given limit. Resource types which implement
+// resource.ResourceWithConcurrencyLimit override this default.
+func WithProtocol6ResourceConcurrencyLimit(limit int) Protocol6Opt {
+	return func(o *protocol6Opts) {
+		o.resourceConcurrencyLimit = limit
+	}
+}
+
+// newProtocol6Server returns the protocol version 6 provider server for the
+// given Provider and options.
+func newProtocol6Server(p provider.Provider, opts ...Protocol6Opt) *proto6server.Server {
+	var o protocol6Opts
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &proto6server.Server{
+		FrameworkServer: fwserver.Server{
+			Interceptors:             o.interceptors,
+			MetricsReporter:          o.metricsReporter,
+			Provider:                 p,
+			ResourceConcurrencyLimit: o.resourceConcurrencyLimit,
+		},
+	}
+}
@@ -7,9 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
-	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
-	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
@@ -21,13 +18,11 @@ import (
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
+//
+// Options, such as WithNestedAttributesAsBlocks, can customize the server.
+func NewProtocol5(p provider.Provider, opts ...Protocol5Opt) func() tfprotov5.ProviderServer {
 	return func() tfprotov5.ProviderServer {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol5Server(p, opts...)
 	}
 }
 
@@ -35,14 +30,12 @@ func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV5ProviderFactories.
 //
+// Options, such as WithNestedAttributesAsBlocks, can customize the server.
+//
 // The error return is not currently used, but it may be in the future.
-func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer, error) {
+func NewProtocol5WithError(p provider.Provider, opts ...Protocol5Opt) func() (tfprotov5.ProviderServer, error) {
 	return func() (tfprotov5.ProviderServer, error) {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol5Server(p, opts...), nil
 	}
 }
 
@@ -50,13 +43,11 @@ func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
+//
+// Options, such as WithProtocol6Interceptors, can customize the server.
+func NewProtocol6(p provider.Provider, opts ...Protocol6Opt) func() tfprotov6.ProviderServer {
 	return func() tfprotov6.ProviderServer {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol6Server(p, opts...)
 	}
 }
 
@@ -64,14 +55,12 @@ func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV6ProviderFactories.
 //
+// Options, such as WithProtocol6Interceptors, can customize the server.
+//
 // The error return is not currently used, but it may be in the future.
-func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
+func NewProtocol6WithError(p provider.Provider, opts ...Protocol6Opt) func() (tfprotov6.ProviderServer, error) {
 	return func() (tfprotov6.ProviderServer, error) {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol6Server(p, opts...), nil
 	}
 }
 
@@ -91,16 +80,33 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			tf5serverOpts = append(tf5serverOpts, tf5server.WithManagedDebug())
 		}
 
+		var protocol5Opts []Protocol5Opt
+
+		if opts.NestedAttributesAsBlocks {
+			protocol5Opts = append(protocol5Opts, WithNestedAttributesAsBlocks())
+		}
+
+		if len(opts.Interceptors) > 0 {
+			protocol5Opts = append(protocol5Opts, WithProtocol5Interceptors(opts.Interceptors...))
+		}
+
+		if opts.MetricsReporter != nil {
+			protocol5Opts = append(protocol5Opts, WithProtocol5MetricsReporter(opts.MetricsReporter))
+		}
+
+		if opts.ResourceConcurrencyLimit > 0 {
+			protocol5Opts = append(protocol5Opts, WithProtocol5ResourceConcurrencyLimit(opts.ResourceConcurrencyLimit))
+		}
+
 		return tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
 				provider := providerFunc()
 
-				return &proto5server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				server := newProtocol5Server(provider, protocol5Opts...)
+				server.DisablePanicRecovery = opts.DisablePanicRecovery
+
+				return server
 			},
 			tf5serverOpts...,
 		)
@@ -111,16 +117,29 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 			tf6serverOpts = append(tf6serverOpts, tf6server.WithManagedDebug())
 		}
 
+		var protocol6Opts []Protocol6Opt
+
+		if len(opts.Interceptors) > 0 {
+			protocol6Opts = append(protocol6Opts, WithProtocol6Interceptors(opts.Interceptors...))
+		}
+
+		if opts.MetricsReporter != nil {
+			protocol6Opts = append(protocol6Opts, WithProtocol6MetricsReporter(opts.MetricsReporter))
+		}
+
+		if opts.ResourceConcurrencyLimit > 0 {
+			protocol6Opts = append(protocol6Opts, WithProtocol6ResourceConcurrencyLimit(opts.ResourceConcurrencyLimit))
+		}
+
 		return tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
 				provider := providerFunc()
 
-				return &proto6server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				server := newProtocol6Server(provider, protocol6Opts...)
+				server.DisablePanicRecovery = opts.DisablePanicRecovery
+
+				return server
 			},
 			tf6serverOpts...,
 		)
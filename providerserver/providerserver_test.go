@@ -5,9 +5,14 @@ package providerserver
 
 import (
 	"context"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -28,6 +33,112 @@ func TestNewProtocol5(t *testing.T) {
 	}
 }
 
+func TestNewProtocol5_WithNestedAttributesAsBlocks(t *testing.T) {
+	t.Parallel()
+
+	provider := &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+			resp.Schema = schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_string": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			}
+		},
+	}
+
+	providerServerFunc := NewProtocol5(provider, WithNestedAttributesAsBlocks())
+	providerServer := providerServerFunc()
+
+	resp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(resp.Provider.Block.BlockTypes) != 1 {
+		t.Fatalf("expected nested attribute as block, got: %v", resp.Provider.Block)
+	}
+}
+
+func TestNewProtocol5_WithProtocol5Interceptors(t *testing.T) {
+	t.Parallel()
+
+	var operations []interceptor.Operation
+
+	testInterceptor := interceptor.Func(func(ctx context.Context, req interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+		operations = append(operations, req.Operation)
+
+		next(ctx)
+
+		resp.Diagnostics.AddWarning("test summary", "test detail")
+	})
+
+	providerServerFunc := NewProtocol5(&testprovider.Provider{}, WithProtocol5Interceptors(testInterceptor))
+	providerServer := providerServerFunc()
+
+	resp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if len(operations) != 1 || operations[0] != interceptor.OperationGetProviderSchema {
+		t.Fatalf("expected GetProviderSchema operation, got: %v", operations)
+	}
+
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "test summary" {
+		t.Fatalf("expected interceptor diagnostic, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestNewProtocol5_WithProtocol5MetricsReporter(t *testing.T) {
+	t.Parallel()
+
+	reporter := &metrics.InMemory{}
+
+	providerServerFunc := NewProtocol5(&testprovider.Provider{}, WithProtocol5MetricsReporter(reporter))
+	providerServer := providerServerFunc()
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	var operations []interceptor.Operation
+	var methods []string
+
+	for _, summary := range reporter.Summaries() {
+		if summary.Method == "" {
+			operations = append(operations, summary.Operation)
+			continue
+		}
+
+		methods = append(methods, summary.Method)
+	}
+
+	if len(operations) != 1 || operations[0] != interceptor.OperationGetProviderSchema {
+		t.Errorf("expected GetProviderSchema operation, got: %v", operations)
+	}
+
+	if !slices.Contains(methods, "Schema") {
+		t.Errorf("expected provider Schema method, got: %v", methods)
+	}
+}
+
 func TestNewProtocol5WithError(t *testing.T) {
 	t.Parallel()
 
@@ -63,6 +174,72 @@ func TestNewProtocol6(t *testing.T) {
 	}
 }
 
+func TestNewProtocol6_WithProtocol6Interceptors(t *testing.T) {
+	t.Parallel()
+
+	var operations []interceptor.Operation
+
+	testInterceptor := interceptor.Func(func(ctx context.Context, req interceptor.Request, resp *interceptor.Response, next interceptor.Next) {
+		operations = append(operations, req.Operation)
+
+		next(ctx)
+
+		resp.Diagnostics.AddWarning("test summary", "test detail")
+	})
+
+	providerServerFunc := NewProtocol6(&testprovider.Provider{}, WithProtocol6Interceptors(testInterceptor))
+	providerServer := providerServerFunc()
+
+	resp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	if len(operations) != 1 || operations[0] != interceptor.OperationGetProviderSchema {
+		t.Fatalf("expected GetProviderSchema operation, got: %v", operations)
+	}
+
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "test summary" {
+		t.Fatalf("expected interceptor diagnostic, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestNewProtocol6_WithProtocol6MetricsReporter(t *testing.T) {
+	t.Parallel()
+
+	reporter := &metrics.InMemory{}
+
+	providerServerFunc := NewProtocol6(&testprovider.Provider{}, WithProtocol6MetricsReporter(reporter))
+	providerServer := providerServerFunc()
+
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	var operations []interceptor.Operation
+	var methods []string
+
+	for _, summary := range reporter.Summaries() {
+		if summary.Method == "" {
+			operations = append(operations, summary.Operation)
+			continue
+		}
+
+		methods = append(methods, summary.Method)
+	}
+
+	if len(operations) != 1 || operations[0] != interceptor.OperationGetProviderSchema {
+		t.Errorf("expected GetProviderSchema operation, got: %v", operations)
+	}
+
+	if !slices.Contains(methods, "Schema") {
+		t.Errorf("expected provider Schema method, got: %v", methods)
+	}
+}
+
 func TestNewProtocol6WithError(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/metrics"
 )
 
 // ServeOpts are options for serving the provider.
@@ -31,9 +34,46 @@ type ServeOpts struct {
 	// Protocol version 5 has the following functionality limitations, which
 	// will raise an error during the GetProviderSchema or other RPCs:
 	//
-	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
+	//     - tfsdk.Attribute cannot use Attributes field (nested attributes),
+	//       unless NestedAttributesAsBlocks is enabled.
 	//
 	ProtocolVersion int
+
+	// NestedAttributesAsBlocks converts single, list, and set nested
+	// attributes into the equivalent nested blocks when serving protocol
+	// version 5. Refer to the WithNestedAttributesAsBlocks function
+	// documentation for the limitations of this conversion. This can only be
+	// enabled when ProtocolVersion is 5.
+	NestedAttributesAsBlocks bool
+
+	// DisablePanicRecovery prevents the framework from recovering panics in
+	// provider defined methods, such as resource Read or attribute
+	// validators. By default, a recovered panic is logged with its stack
+	// trace and returned as an error diagnostic, rather than crashing the
+	// provider. Disabling recovery can be useful while debugging a provider.
+	DisablePanicRecovery bool
+
+	// Interceptors wrap each framework operation, such as a managed resource
+	// read, with provider defined logic for request-scoped behaviors like
+	// tracing, audit logging, or injecting request identifiers. The first
+	// interceptor is the outermost in the chain. Refer to the interceptor
+	// package documentation for more details.
+	Interceptors []interceptor.Interceptor
+
+	// MetricsReporter receives the duration and outcome of each framework
+	// operation and provider defined method, such as resource Read methods
+	// and attribute validators, which can help find the resources and
+	// attributes that dominate Terraform run times. The metrics.InMemory type
+	// is a reference implementation. Refer to the metrics package
+	// documentation for more details.
+	MetricsReporter metrics.Reporter
+
+	// ResourceConcurrencyLimit is the default maximum number of concurrent
+	// Create, Read, Update, and Delete calls for each managed resource type,
+	// since the Terraform -parallelism flag applies to all resources.
+	// Resource types which implement resource.ResourceWithConcurrencyLimit
+	// override this default. Zero means unlimited.
+	ResourceConcurrencyLimit int
 }
 
 // Validate a given provider address. This is only used for the Address field
@@ -65,6 +105,8 @@ func (opts ServeOpts) validateAddress(_ context.Context) error {
 //   - If Address is not set
 //   - Address is a valid full provider address
 //   - ProtocolVersion, if set, is 5 or 6
+//   - NestedAttributesAsBlocks, if enabled, has ProtocolVersion 5
+//   - ResourceConcurrencyLimit is not negative
 func (opts ServeOpts) validate(ctx context.Context) error {
 	if opts.Address == "" {
 		return fmt.Errorf("Address must be provided")
@@ -83,5 +125,13 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
 	}
 
+	if opts.NestedAttributesAsBlocks && opts.ProtocolVersion != 5 {
+		return fmt.Errorf("NestedAttributesAsBlocks can only be enabled with ProtocolVersion 5")
+	}
+
+	if opts.ResourceConcurrencyLimit < 0 {
+		return fmt.Errorf("ResourceConcurrencyLimit, if set, must be greater than zero")
+	}
+
 	return nil
 }
@@ -51,6 +51,41 @@ func TestServeOptsValidate(t *testing.T) {
 				ProtocolVersion: 5,
 			},
 		},
+		"NestedAttributesAsBlocks-ProtocolVersion-5": {
+			serveOpts: ServeOpts{
+				Address:                  "registry.terraform.io/hashicorp/testing",
+				NestedAttributesAsBlocks: true,
+				ProtocolVersion:          5,
+			},
+		},
+		"NestedAttributesAsBlocks-ProtocolVersion-6": {
+			serveOpts: ServeOpts{
+				Address:                  "registry.terraform.io/hashicorp/testing",
+				NestedAttributesAsBlocks: true,
+				ProtocolVersion:          6,
+			},
+			expectedError: fmt.Errorf("NestedAttributesAsBlocks can only be enabled with ProtocolVersion 5"),
+		},
+		"NestedAttributesAsBlocks-ProtocolVersion-unset": {
+			serveOpts: ServeOpts{
+				Address:                  "registry.terraform.io/hashicorp/testing",
+				NestedAttributesAsBlocks: true,
+			},
+			expectedError: fmt.Errorf("NestedAttributesAsBlocks can only be enabled with ProtocolVersion 5"),
+		},
+		"ResourceConcurrencyLimit": {
+			serveOpts: ServeOpts{
+				Address:                  "registry.terraform.io/hashicorp/testing",
+				ResourceConcurrencyLimit: 2,
+			},
+		},
+		"ResourceConcurrencyLimit-negative": {
+			serveOpts: ServeOpts{
+				Address:                  "registry.terraform.io/hashicorp/testing",
+				ResourceConcurrencyLimit: -1,
+			},
+			expectedError: fmt.Errorf("ResourceConcurrencyLimit, if set, must be greater than zero"),
+		},
 		"ProtocolVersion-6": {
 			serveOpts: ServeOpts{
 				Address:         "registry.terraform.io/hashicorp/testing",
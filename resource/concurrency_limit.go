@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ConcurrencyLimitRequest represents a request for the Resource to return its
+// concurrency limit. An instance of this request struct is supplied as an
+// argument to the ResourceWithConcurrencyLimit type ConcurrencyLimit method.
+type ConcurrencyLimitRequest struct{}
+
+// ConcurrencyLimitResponse represents a response to a
+// ConcurrencyLimitRequest. An instance of this response struct is supplied as
+// an argument to the ResourceWithConcurrencyLimit type ConcurrencyLimit
+// method.
+type ConcurrencyLimitResponse struct {
+	// Limit is the maximum number of concurrent Create, Read, Update, and
+	// Delete calls for the resource type. A value of zero or less means the
+	// calls are not limited, even if a provider-wide default limit is
+	// configured on the provider server.
+	Limit int
+
+	// Diagnostics report errors or warnings related to determining the
+	// concurrency limit. An empty slice indicates success, with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+}
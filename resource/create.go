@@ -18,6 +18,9 @@ type CreateRequest struct {
 	// This configuration may contain unknown values if a user uses
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
+	//
+	// Config is the only source of write-only attribute values, as those
+	// values are always null in the plan and state.
 	Config tfsdk.Config
 
 	// Plan is the planned state for the resource.
@@ -46,4 +49,10 @@ type CreateResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Identity is the identity of the resource following the Create
+	// operation. This field is only populated if the resource implements
+	// ResourceWithIdentity, in which case it is pre-populated with a null
+	// value and must be set during the resource's Create operation.
+	Identity *tfsdk.ResourceIdentity
 }
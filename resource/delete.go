@@ -25,6 +25,11 @@ type DeleteRequest struct {
 	//
 	// Use the GetKey method to read data.
 	Private *privatestate.ProviderData
+
+	// Identity is the current identity of the resource prior to the Delete
+	// operation. This field is only populated if the resource implements
+	// ResourceWithIdentity.
+	Identity *tfsdk.ResourceIdentity
 }
 
 // DeleteResponse represents a response to a DeleteRequest. An
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// Attribute define a value field inside the Schema. Implementations in this
+// package include:
+//   - BoolAttribute
+//   - Float32Attribute
+//   - Float64Attribute
+//   - Int32Attribute
+//   - Int64Attribute
+//   - ListAttribute
+//   - NumberAttribute
+//   - StringAttribute
+//
+// Terraform only supports these primitive types, and lists of them, within
+// resource identity data. Nested attributes and blocks are not supported.
+//
+// In practitioner configurations, such as the identity argument of an import
+// block, an equals sign (=) is required to set the value.
+type Attribute interface {
+	fwschema.IdentityAttribute
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ Attribute                                    = BoolAttribute{}
+	_ fwschema.AttributeWithValidateImplementation = BoolAttribute{}
+)
+
+// BoolAttribute represents a schema attribute that is a boolean. When
+// retrieving the value for this attribute, use types.Bool as the value type
+// unless the CustomType field is set.
+//
+// Terraform configurations configure this attribute using expressions that
+// return a boolean or directly via the true/false keywords.
+//
+//	example_attribute = true
+//
+// Terraform configurations reference this attribute using the attribute name.
+//
+//	.example_attribute
+type BoolAttribute struct {
+	// CustomType enables the use of a custom attribute type in place of the
+	// default basetypes.BoolType. When retrieving data, the basetypes.BoolValuable
+	// associated with this custom type must be used in place of types.Bool.
+	CustomType basetypes.BoolTypable
+
+	// RequiredForImport indicates whether the practitioner must enter a value
+	// for this attribute when importing a managed resource by identity.
+	// Exactly one of RequiredForImport or OptionalForImport must be true.
+	RequiredForImport bool
+
+	// OptionalForImport indicates whether the practitioner can choose to enter
+	// a value for this attribute when importing a managed resource by
+	// identity, such as when the value can be derived from provider
+	// configuration. Exactly one of RequiredForImport or OptionalForImport
+	// must be true.
+	OptionalForImport bool
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this attribute is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this attribute is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as it is not
+// possible to step further into a BoolAttribute.
+func (a BoolAttribute) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal returns true if the given Attribute is a BoolAttribute
+// and all fields are equal.
+func (a BoolAttribute) Equal(o fwschema.Attribute) bool {
+	if _, ok := o.(BoolAttribute); !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage always returns an empty string as there is no
+// deprecation validation support for resource identity schemas.
+func (a BoolAttribute) GetDeprecationMessage() string {
+	return ""
+}
+
+// GetDescription returns the Description field value.
+func (a BoolAttribute) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription returns the MarkdownDescription field value.
+func (a BoolAttribute) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType returns types.StringType or the CustomType field value if defined.
+func (a BoolAttribute) GetType() attr.Type {
+	if a.CustomType != nil {
+		return a.CustomType
+	}
+
+	return types.BoolType
+}
+
+// IsComputed always returns false as resource identity data is never
+// planned, so it cannot be Computed.
+func (a BoolAttribute) IsComputed() bool {
+	return false
+}
+
+// IsOptional always returns false as resource identity data is never
+// configured. Use IsOptionalForImport instead.
+func (a BoolAttribute) IsOptional() bool {
+	return false
+}
+
+// IsOptionalForImport returns the OptionalForImport field value.
+func (a BoolAttribute) IsOptionalForImport() bool {
+	return a.OptionalForImport
+}
+
+// IsRequired always returns false as resource identity data is never
+// configured. Use IsRequiredForImport instead.
+func (a BoolAttribute) IsRequired() bool {
+	return false
+}
+
+// IsRequiredForImport returns the RequiredForImport field value.
+func (a BoolAttribute) IsRequiredForImport() bool {
+	return a.RequiredForImport
+}
+
+// IsSensitive always returns false as resource identity data cannot be
+// sensitive.
+func (a BoolAttribute) IsSensitive() bool {
+	return false
+}
+
+// IsWriteOnly always returns false as resource identity data is always
+// persisted.
+func (a BoolAttribute) IsWriteOnly() bool {
+	return false
+}
+
+// ValidateImplementation contains logic for validating the
+// provider-defined implementation of the attribute to prevent unexpected
+// errors or panics. This logic runs during the GetResourceIdentitySchemas RPC
+// and should never include false positives.
+func (a BoolAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.RequiredForImport == a.OptionalForImport {
+		resp.Diagnostics.Append(fwschema.AttributeInvalidImportRequirementDiag(req.Path))
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identityschema contains all available schema functionality for
+// resource identity. Resource identity schemas define the structure and value
+// types for the data which uniquely identifies a managed resource, such as
+// the attributes of a composite key. Identity schemas are implemented via the
+// resource.ResourceWithIdentity type IdentitySchema method.
+//
+// Resource identity is only supported in Terraform 1.12 and later.
+package identityschema
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ImportStateRequest represents a request for the provider to import a
@@ -22,7 +23,29 @@ type ImportStateRequest struct {
 	// as an Attribute. However, this identifier can also be treated as
 	// its own type of value and parsed during import. This value
 	// is not stored in the state unless the provider explicitly stores it.
+	//
+	// ID is empty when the practitioner imports the resource by identity.
 	ID string
+
+	// Identity is the identity supplied by the practitioner when importing
+	// the resource by identity, such as with the identity argument of an
+	// import block. This field is only populated if the resource implements
+	// ResourceWithIdentity and is null when the practitioner imports the
+	// resource by ID.
+	//
+	// Importing by identity is only supported in Terraform 1.12 and later.
+	Identity *tfsdk.ResourceIdentity
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ImportResourceState RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ImportStateClientCapabilities
+
+	// NewImportedResource returns an ImportedResource for the given managed
+	// resource type name of this provider, with a null State and Identity
+	// matching the schemas of that resource type. Implementations should use
+	// this function to create each ImportStateResponse.AdditionalResources
+	// entry before populating it. This field is populated by the framework.
+	NewImportedResource func(ctx context.Context, typeName string) (ImportedResource, diag.Diagnostics)
 }
 
 // ImportStateResponse represents a response to a ImportStateRequest.
@@ -44,6 +67,50 @@ type ImportStateResponse struct {
 	// This field is not pre-populated as there is no pre-existing private state
 	// data during the resource's Import operation.
 	Private *privatestate.ProviderData
+
+	// Deferred indicates that Terraform should defer this resource import until a
+	// followup apply operation.
+	//
+	// This field can only be set if
+	// `(resource.ImportStateRequest).ClientCapabilities.DeferralAllowed` is true.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	Deferred *Deferred
+
+	// Identity is the identity of the resource following the Import
+	// operation. This field is only populated if the resource implements
+	// ResourceWithIdentity, in which case it is pre-populated from
+	// ImportStateRequest.Identity and should be set during the resource's
+	// ImportState operation when importing by ID.
+	Identity *tfsdk.ResourceIdentity
+
+	// AdditionalResources are other managed resources of this provider to
+	// import alongside this resource, such as the listeners of an imported
+	// load balancer. Create each entry with the ImportStateRequest type
+	// NewImportedResource function. The framework validates each entry
+	// against the schemas of its resource type.
+	AdditionalResources []ImportedResource
+}
+
+// ImportedResource is an additional managed resource returned by the
+// ImportState method in the ImportStateResponse type AdditionalResources
+// field.
+type ImportedResource struct {
+	// TypeName is the managed resource type name of this provider.
+	TypeName string
+
+	// State is the state of the resource. It must contain enough information
+	// so Terraform can successfully refresh the resource, e.g. call the
+	// Resource Read method.
+	State tfsdk.State
+
+	// Identity is the identity of the resource. This field is only populated
+	// if the resource type implements ResourceWithIdentity.
+	Identity *tfsdk.ResourceIdentity
+
+	// Private is the private state data of the resource.
+	Private *privatestate.ProviderData
 }
 
 // ImportStatePassthroughID is a helper function to set the import
@@ -60,3 +127,55 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ImportStatePassthroughWithIdentity is a helper function to set the import
+// identifier or the identity attribute value to a given state attribute
+// path. When the practitioner imports the resource by ID, the ID is set to
+// the state attribute path. When the practitioner imports the resource by
+// identity, the value at the identity attribute path is set to the state
+// attribute path. Both attributes must accept a string value.
+func ImportStatePassthroughWithIdentity(ctx context.Context, stateAttrPath, identityAttrPath path.Path, req ImportStateRequest, resp *ImportStateResponse) {
+	if stateAttrPath.Equal(path.Empty()) {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Attribute Path",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughWithIdentity path must be set to a valid attribute path that can accept a string value.",
+		)
+	}
+
+	if req.ID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, stateAttrPath, req.ID)...)
+
+		return
+	}
+
+	if identityAttrPath.Equal(path.Empty()) {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Identity Attribute Path",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughWithIdentity identity path must be set to a valid identity attribute path that contains a string value.",
+		)
+
+		return
+	}
+
+	if req.Identity == nil {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Identity",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughWithIdentity requires the resource to implement ResourceWithIdentity.",
+		)
+
+		return
+	}
+
+	var identityValue types.String
+
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, identityAttrPath, &identityValue)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, stateAttrPath, identityValue)...)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ImportStateSeparatedID is a helper function to split the import identifier
+// by the given separator and set each part to the attribute path at the same
+// position, such as the import identifier "project/region/name" with the
+// separator "/" and three attribute paths. Each part is converted to the
+// type of its attribute, which must be a string, number, or boolean type,
+// such as types.String, types.Int64, or types.Bool.
+//
+// An error diagnostic showing the expected format is returned if the import
+// identifier does not contain exactly one non-empty part for each attribute
+// path.
+func ImportStateSeparatedID(ctx context.Context, separator string, attrPaths []path.Path, req ImportStateRequest, resp *ImportStateResponse) {
+	if separator == "" || len(attrPaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Resource Import Separated ID Missing Separator or Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStateSeparatedID must have a non-empty separator and at least one attribute path.",
+		)
+
+		return
+	}
+
+	formatParts := make([]string, 0, len(attrPaths))
+
+	for _, attrPath := range attrPaths {
+		formatParts = append(formatParts, attrPath.String())
+	}
+
+	format := strings.Join(formatParts, separator)
+	idParts := strings.Split(req.ID, separator)
+
+	if len(idParts) != len(attrPaths) {
+		addUnexpectedImportIDError(resp, format, req.ID)
+
+		return
+	}
+
+	for _, idPart := range idParts {
+		if idPart == "" {
+			addUnexpectedImportIDError(resp, format, req.ID)
+
+			return
+		}
+	}
+
+	for i, attrPath := range attrPaths {
+		setImportIDPart(ctx, attrPath, idParts[i], format, resp)
+	}
+}
+
+// ImportStateTemplateID is a helper function to parse the import identifier
+// using a template, such as "{project}/{region}/{name}", and set each
+// placeholder value to the root attribute of the same name. Placeholders must
+// be separated by at least one literal character, which cannot occur in the
+// value of the preceding placeholder. Each value is converted to the type of
+// its attribute, which must be a string, number, or boolean type, such as
+// types.String, types.Int64, or types.Bool.
+//
+// An error diagnostic showing the expected format is returned if the import
+// identifier does not match the template or any placeholder value is empty.
+func ImportStateTemplateID(ctx context.Context, template string, req ImportStateRequest, resp *ImportStateResponse) {
+	segments, err := parseImportIDTemplate(template)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Resource Import Template ID Invalid Template",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Resource ImportState method call to ImportStateTemplateID has an invalid template %q: %s", template, err),
+		)
+
+		return
+	}
+
+	values, ok := matchImportIDTemplate(segments, req.ID)
+
+	if !ok {
+		addUnexpectedImportIDError(resp, template, req.ID)
+
+		return
+	}
+
+	for _, segment := range segments {
+		if !segment.placeholder {
+			continue
+		}
+
+		setImportIDPart(ctx, path.Root(segment.text), values[segment.text], template, resp)
+	}
+}
+
+// importIDTemplateSegment is a literal or placeholder portion of an
+// ImportStateTemplateID template.
+type importIDTemplateSegment struct {
+	placeholder bool
+	text        string
+}
+
+// parseImportIDTemplate splits an ImportStateTemplateID template into its
+// literal and placeholder segments.
+func parseImportIDTemplate(template string) ([]importIDTemplateSegment, error) {
+	var segments []importIDTemplateSegment
+
+	placeholders := make(map[string]struct{})
+	remaining := template
+
+	for remaining != "" {
+		start := strings.IndexByte(remaining, '{')
+
+		if start == -1 {
+			segments = append(segments, importIDTemplateSegment{text: remaining})
+
+			break
+		}
+
+		if start > 0 {
+			segments = append(segments, importIDTemplateSegment{text: remaining[:start]})
+		}
+
+		end := strings.IndexByte(remaining[start:], '}')
+
+		if end == -1 {
+			return nil, fmt.Errorf("unclosed placeholder")
+		}
+
+		name := remaining[start+1 : start+end]
+
+		if name == "" || strings.ContainsAny(name, "{") {
+			return nil, fmt.Errorf("invalid placeholder %q", remaining[start:start+end+1])
+		}
+
+		if _, ok := placeholders[name]; ok {
+			return nil, fmt.Errorf("duplicate placeholder %q", name)
+		}
+
+		if len(segments) > 0 && segments[len(segments)-1].placeholder {
+			return nil, fmt.Errorf("placeholder %q must be separated from the preceding placeholder", name)
+		}
+
+		placeholders[name] = struct{}{}
+		segments = append(segments, importIDTemplateSegment{placeholder: true, text: name})
+		remaining = remaining[start+end+1:]
+	}
+
+	if len(placeholders) == 0 {
+		return nil, fmt.Errorf("no placeholders")
+	}
+
+	return segments, nil
+}
+
+// matchImportIDTemplate returns the placeholder values of the import
+// identifier, or false if it does not match the template segments.
+func matchImportIDTemplate(segments []importIDTemplateSegment, id string) (map[string]string, bool) {
+	values := make(map[string]string)
+	remaining := id
+
+	for i, segment := range segments {
+		if !segment.placeholder {
+			if !strings.HasPrefix(remaining, segment.text) {
+				return nil, false
+			}
+
+			remaining = remaining[len(segment.text):]
+
+			continue
+		}
+
+		value := remaining
+
+		// Placeholders are always followed by a literal segment, if any.
+		if i+1 < len(segments) {
+			end := strings.Index(remaining, segments[i+1].text)
+
+			if end == -1 {
+				return nil, false
+			}
+
+			value = remaining[:end]
+		}
+
+		if value == "" {
+			return nil, false
+		}
+
+		values[segment.text] = value
+		remaining = remaining[len(value):]
+	}
+
+	if remaining != "" {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// setImportIDPart converts the import identifier part to the type of the
+// attribute at the given path and sets it in the response State.
+func setImportIDPart(ctx context.Context, attrPath path.Path, part string, format string, resp *ImportStateResponse) {
+	attrType, diags := resp.State.Schema.TypeAtPath(ctx, attrPath)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	var tfValue tftypes.Value
+
+	switch tfType := attrType.TerraformType(ctx); {
+	case tfType.Is(tftypes.String):
+		tfValue = tftypes.NewValue(tftypes.String, part)
+	case tfType.Is(tftypes.Number):
+		number, _, err := big.ParseFloat(part, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			addInvalidImportIDPartError(resp, attrPath, part, "The value is not a number.", format)
+
+			return
+		}
+
+		tfValue = tftypes.NewValue(tftypes.Number, number)
+	case tfType.Is(tftypes.Bool):
+		boolean, err := strconv.ParseBool(part)
+
+		if err != nil {
+			addInvalidImportIDPartError(resp, attrPath, part, "The value is not a boolean, such as true or false.", format)
+
+			return
+		}
+
+		tfValue = tftypes.NewValue(tftypes.Bool, boolean)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Resource Import ID Unsupported Attribute Type",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Resource ImportState method import identifier parts can only be set to string, number, or boolean attributes. Got attribute type: %s", attrType),
+		)
+
+		return
+	}
+
+	value, err := attrType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		addInvalidImportIDPartError(resp, attrPath, part, err.Error(), format)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, value)...)
+}
+
+// addUnexpectedImportIDError adds an error diagnostic to the response for an
+// import identifier which does not match the expected format.
+func addUnexpectedImportIDError(resp *ImportStateResponse, format string, id string) {
+	resp.Diagnostics.AddError(
+		"Unexpected Import Identifier",
+		fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, id),
+	)
+}
+
+// addInvalidImportIDPartError adds an error diagnostic to the response for an
+// import identifier part which cannot be converted to its attribute type.
+func addInvalidImportIDPartError(resp *ImportStateResponse, attrPath path.Path, part string, reason string, format string) {
+	resp.Diagnostics.AddAttributeError(
+		attrPath,
+		"Invalid Import Identifier",
+		fmt.Sprintf("The import identifier part %q for %s could not be converted to the attribute type. %s\n\n", part, attrPath, reason)+
+			fmt.Sprintf("Expected import identifier with format: %s", format),
+	)
+}
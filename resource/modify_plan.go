@@ -39,6 +39,10 @@ type ModifyPlanRequest struct {
 	// Use the GetKey method to read data. Use the SetKey method on
 	// ModifyPlanResponse.Private to update or remove a value.
 	Private *privatestate.ProviderData
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the PlanResourceChange RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ModifyPlanClientCapabilities
 }
 
 // ModifyPlanResponse represents a response to a
@@ -65,4 +69,14 @@ type ModifyPlanResponse struct {
 	// indicates a successful plan modification with no warnings or errors
 	// generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer this resource change until a
+	// followup apply operation.
+	//
+	// This field can only be set if
+	// `(resource.ModifyPlanRequest).ClientCapabilities.DeferralAllowed` is true.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	Deferred *Deferred
 }
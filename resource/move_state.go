@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Request information for the provider logic to move a resource state from
+// a source resource type into the current resource type and schema. An
+// instance of this is supplied as a parameter to a StateMover, which
+// ultimately comes from a Resource's MoveState method.
+type MoveStateRequest struct {
+	// SourcePrivate is the private state data of the source resource. Only
+	// the provider defined keys are available.
+	SourcePrivate *privatestate.ProviderData
+
+	// SourceProviderAddress is the address of the provider for the source
+	// resource type, such as registry.terraform.io/hashicorp/examplecloud.
+	SourceProviderAddress string
+
+	// SourceRawState is the state of the source resource in JSON format.
+	// This data is always available, regardless whether the wrapping
+	// StateMover type SourceSchema field was present.
+	//
+	// This is advanced functionality for providers wanting to skip the full
+	// redeclaration of source schemas and instead use lower level handlers to
+	// transform data. A typical implementation for working with this data will
+	// call the Unmarshal() method.
+	//
+	// TODO: Create framework defined type that is not protocol specific.
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/340
+	SourceRawState *tfprotov6.RawState
+
+	// SourceSchemaVersion is the schema version of the source resource state.
+	SourceSchemaVersion int64
+
+	// SourceState is the state of the source resource if the wrapping
+	// StateMover type SourceSchema field was present. When available, this
+	// allows for easier data handling such as calling Get() or
+	// GetAttribute().
+	SourceState *tfsdk.State
+
+	// SourceTypeName is the type name of the source resource, such as
+	// examplecloud_thing.
+	SourceTypeName string
+}
+
+// Response information for the provider logic to move a resource state from
+// a source resource type into the current resource type and schema. An
+// instance of this is supplied as a parameter to a StateMover, which
+// ultimately came from a Resource's MoveState method.
+type MoveStateResponse struct {
+	// Diagnostics report errors or warnings related to moving the resource
+	// state. An empty slice indicates a successful operation with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// TargetPrivate is the private state data of the target resource after
+	// the move. No source private state data is copied automatically.
+	TargetPrivate *privatestate.ProviderData
+
+	// TargetState is the state of the target resource after the move, which
+	// should match the current resource schema.
+	//
+	// All data must be populated to prevent data loss during the move
+	// operation. No source state data is copied automatically. If not set,
+	// the framework will attempt the next StateMover.
+	TargetState tfsdk.State
+}
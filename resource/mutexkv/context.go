@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mutexkv
+
+import (
+	"context"
+)
+
+// contextKey is the context key for NewContext.
+type contextKey struct{}
+
+// NewContext returns a context which carries the given MutexKV. The framework
+// calls this for each request with the MutexKV of the provider server, so
+// providers typically only need this for unit testing.
+func NewContext(ctx context.Context, m *MutexKV) context.Context {
+	return context.WithValue(ctx, contextKey{}, m)
+}
+
+// FromContext returns the MutexKV of the context, which is the MutexKV of the
+// provider server for contexts passed to provider defined methods by the
+// framework. It returns nil if the context does not carry a MutexKV, in which
+// case the Lock method returns an error diagnostic.
+func FromContext(ctx context.Context) *MutexKV {
+	m, ok := ctx.Value(contextKey{}).(*MutexKV)
+
+	if !ok {
+		return nil
+	}
+
+	return m
+}
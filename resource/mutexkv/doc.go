@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package mutexkv contains a keyed mutex for serializing operations across
+// resources which mutate the same remote object, such as multiple rule
+// resources modifying one security group.
+//
+// The framework creates a MutexKV for each provider server, which resource,
+// data source, and other provider defined methods can retrieve from their
+// context with FromContext:
+//
+//	func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+//		// ...
+//		locks := mutexkv.FromContext(ctx)
+//
+//		resp.Diagnostics.Append(locks.Lock(ctx, groupID)...)
+//
+//		if resp.Diagnostics.HasError() {
+//			return
+//		}
+//
+//		defer locks.Unlock(ctx, groupID)
+//		// ...
+//	}
+//
+// Alternatively, providers can create their own MutexKV with New and share it
+// with resources through provider data, such as the
+// provider.ConfigureResponse type ResourceData field.
+//
+// Each lock, wait, and unlock is logged at TRACE level by the framework with
+// the tf_mutex_key field, which can help diagnose deadlocks.
+package mutexkv
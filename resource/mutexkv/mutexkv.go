@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mutexkv
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// MutexKV is a keyed mutex, where each key can be locked independently of
+// other keys. Unlike sync.Mutex, waiting to lock a key respects context
+// cancellation. The zero value is not usable; use New instead.
+type MutexKV struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+// keyLock is the lock of a single key, which is removed from the MutexKV
+// once no callers hold or wait for it.
+type keyLock struct {
+	// ch has a capacity of one and contains a value while the key is
+	// locked.
+	ch chan struct{}
+
+	// refs is the number of callers holding or waiting for the lock.
+	refs int
+}
+
+// New returns a new MutexKV.
+func New() *MutexKV {
+	return &MutexKV{
+		locks: make(map[string]*keyLock),
+	}
+}
+
+// Lock locks the given key, waiting until any other caller unlocks it. If the
+// context is cancelled or its deadline is exceeded while waiting, such as
+// when Terraform calls the StopProvider RPC, an error diagnostic is returned
+// and the key is not locked. Otherwise, Unlock must be called with the same
+// key once the serialized operation is complete.
+//
+// Calling Lock on a nil MutexKV, such as the result of FromContext with a
+// context which does not carry a MutexKV, returns an error diagnostic.
+func (m *MutexKV) Lock(ctx context.Context, key string) diag.Diagnostics {
+	logFields := map[string]interface{}{
+		logging.KeyMutexKey: key,
+	}
+
+	if m == nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing Mutex",
+				fmt.Sprintf("The operation could not lock %q because no MutexKV was available. ", key)+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"The context passed to Lock must be from the framework or returned by mutexkv.NewContext.",
+			),
+		}
+	}
+
+	l := m.acquire(key)
+
+	select {
+	case l.ch <- struct{}{}:
+		logging.FrameworkTrace(ctx, "Locked mutex", logFields)
+
+		return nil
+	default:
+	}
+
+	logging.FrameworkTrace(ctx, "Waiting for mutex", logFields)
+
+	select {
+	case l.ch <- struct{}{}:
+		logging.FrameworkTrace(ctx, "Locked mutex", logFields)
+
+		return nil
+	case <-ctx.Done():
+		m.release(key)
+
+		logging.FrameworkTrace(ctx, "Cancelled waiting for mutex", logFields)
+
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Mutex Lock Cancelled",
+				fmt.Sprintf("The operation was cancelled while waiting for other operations on %q to complete. ", key)+
+					"This can occur when Terraform is interrupted or a timeout is exceeded.\n\n"+
+					fmt.Sprintf("Error: %s", ctx.Err()),
+			),
+		}
+	}
+}
+
+// Unlock unlocks the given key, allowing the next waiting caller, if any, to
+// lock it. It panics if the key is not locked, similar to sync.Mutex.
+// Calling Unlock on a nil MutexKV does nothing, since Lock cannot succeed on
+// a nil MutexKV.
+func (m *MutexKV) Unlock(ctx context.Context, key string) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	m.mu.Unlock()
+
+	if !ok {
+		panic(fmt.Sprintf("mutexkv: unlock of unlocked key %q", key))
+	}
+
+	select {
+	case <-l.ch:
+	default:
+		panic(fmt.Sprintf("mutexkv: unlock of unlocked key %q", key))
+	}
+
+	m.release(key)
+
+	logging.FrameworkTrace(ctx, "Unlocked mutex", map[string]interface{}{
+		logging.KeyMutexKey: key,
+	})
+}
+
+// acquire returns the lock of the given key, creating it if necessary, and
+// records the caller as holding or waiting for it.
+func (m *MutexKV) acquire(key string) *keyLock {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.locks[key]
+
+	if !ok {
+		l = &keyLock{
+			ch: make(chan struct{}, 1),
+		}
+
+		m.locks[key] = l
+	}
+
+	l.refs++
+
+	return l
+}
+
+// release records that a caller no longer holds or waits for the lock of
+// the given key, removing the lock once it is unused.
+func (m *MutexKV) release(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.locks[key]
+
+	if !ok {
+		return
+	}
+
+	l.refs--
+
+	if l.refs <= 0 {
+		delete(m.locks, key)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mutexkv_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/mutexkv"
+)
+
+func TestMutexKV(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := mutexkv.New()
+
+	if diags := m.Lock(ctx, "test-key"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Other keys are locked independently.
+	if diags := m.Lock(ctx, "other-key"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	m.Unlock(ctx, "other-key")
+
+	locked := make(chan struct{})
+
+	go func() {
+		if diags := m.Lock(ctx, "test-key"); diags.HasError() {
+			t.Errorf("unexpected diagnostics: %v", diags)
+		}
+
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected second Lock to wait for Unlock")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock(ctx, "test-key")
+
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected second Lock after Unlock")
+	}
+
+	m.Unlock(ctx, "test-key")
+}
+
+func TestMutexKVLock_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	m := mutexkv.New()
+
+	if diags := m.Lock(context.Background(), "test-key"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	diags := m.Lock(ctx, "test-key")
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Mutex Lock Cancelled",
+			"The operation was cancelled while waiting for other operations on \"test-key\" to complete. "+
+				"This can occur when Terraform is interrupted or a timeout is exceeded.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	m.Unlock(context.Background(), "test-key")
+
+	// The key is usable after the cancelled waiter is released.
+	if diags := m.Lock(context.Background(), "test-key"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	m.Unlock(context.Background(), "test-key")
+}
+
+func TestMutexKVUnlock_Unlocked(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r != "mutexkv: unlock of unlocked key \"test-key\"" {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+
+	mutexkv.New().Unlock(context.Background(), "test-key")
+
+	t.Fatal("expected panic")
+}
+
+func TestMutexKV_Nil(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := mutexkv.FromContext(ctx)
+
+	diags := m.Lock(ctx, "test-key")
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Missing Mutex",
+			"The operation could not lock \"test-key\" because no MutexKV was available. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"The context passed to Lock must be from the framework or returned by mutexkv.NewContext.",
+		),
+	}
+
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// Unlock must not panic.
+	m.Unlock(ctx, "test-key")
+}
+
+func TestMutexKV_Logging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	m := mutexkv.New()
+
+	if diags := m.Lock(ctx, "test-key"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	m.Unlock(ctx, "test-key")
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":       "trace",
+			"@message":     "Locked mutex",
+			"@module":      "sdk.framework",
+			"tf_mutex_key": "test-key",
+		},
+		{
+			"@level":       "trace",
+			"@message":     "Unlocked mutex",
+			"@module":      "sdk.framework",
+			"tf_mutex_key": "test-key",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	m := mutexkv.New()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected *mutexkv.MutexKV
+	}{
+		"unset": {
+			ctx:      context.Background(),
+			expected: nil,
+		},
+		"set": {
+			ctx:      mutexkv.NewContext(context.Background(), m),
+			expected: m,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := mutexkv.FromContext(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %p, got: %p", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Data is the private state data of a resource, such as the Private field
+// of the resource.ReadRequest and resource.ReadResponse types.
+type Data interface {
+	// GetKey returns the JSON encoded data associated with the given key
+	// or nil if there is no data.
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+
+	// SetKey sets the JSON encoded data associated with the given key.
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
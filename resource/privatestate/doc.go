@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package privatestate contains helpers for storing typed and versioned Go
+// values in resource private state data.
+//
+// The Private fields of resource request and response types only accept
+// JSON encoded bytes. The GetValue and SetValue functions handle encoding
+// and decoding a Go value at a key:
+//
+//	type etagData struct {
+//		ETag string `json:"etag"`
+//	}
+//
+//	func (r *ThingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+//		data, found, diags := privatestate.GetValue[etagData](ctx, req.Private, "etag")
+//		// ...
+//		resp.Diagnostics.Append(privatestate.SetValue(ctx, resp.Private, "etag", data)...)
+//	}
+//
+// The Versioned type additionally stores a version number alongside the
+// value, so the structure of stored data can change over time. Upgraders
+// convert data written by prior versions to the current version, similar to
+// resource.StateUpgrader for resource state. The UpgradeResourceState RPC
+// does not include private state data, so upgraders are instead called
+// whenever prior version data is read with the Versioned type Get method.
+// Set the returned value on the response Private field to persist the
+// upgraded data.
+package privatestate
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// GetValue decodes the JSON encoded private state data at the given key into
+// a value of type T. The returned boolean is false if there is no data at the
+// key, in which case the zero value of T is returned.
+func GetValue[T any](ctx context.Context, data Data, key string) (T, bool, diag.Diagnostics) {
+	var value T
+
+	if data == nil {
+		return value, false, nil
+	}
+
+	raw, diags := data.GetKey(ctx, key)
+
+	if diags.HasError() || len(raw) == 0 {
+		return value, false, diags
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		diags.AddError(
+			"Error Decoding Private State",
+			fmt.Sprintf("An error was encountered when decoding the private state value for key %q: %s\n\n", key, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return value, false, diags
+	}
+
+	return value, true, diags
+}
+
+// SetValue encodes the given value as JSON and sets it as the private state
+// data at the given key.
+func SetValue[T any](ctx context.Context, data Data, key string, value T) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := json.Marshal(value)
+
+	if err != nil {
+		diags.AddError(
+			"Error Encoding Private State",
+			fmt.Sprintf("An error was encountered when encoding the private state value for key %q: %s\n\n", key, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	if data == nil {
+		diags.AddError(
+			"Uninitialized Private State",
+			fmt.Sprintf("The private state value for key %q could not be set as the private state data is not initialized.\n\n", key)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	diags.Append(data.SetKey(ctx, key, raw)...)
+
+	return diags
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	internalprivatestate "github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/privatestate"
+)
+
+type testValue struct {
+	Name string `json:"name"`
+}
+
+func TestGetValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          privatestate.Data
+		key           string
+		expected      testValue
+		expectedFound bool
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			data: nil,
+			key:  "key",
+		},
+		"key-not-found": {
+			data: internalprivatestate.MustProviderData(context.Background(), []byte(`{"other": "e30="}`)),
+			key:  "key",
+		},
+		"key-invalid": {
+			data: internalprivatestate.EmptyProviderData(context.Background()),
+			key:  ".key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".key" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"value-mismatch": {
+			// {"name": 1}
+			data: internalprivatestate.MustProviderData(context.Background(), []byte(`{"key": "eyJuYW1lIjogMX0="}`)),
+			key:  "key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					"An error was encountered when decoding the private state value for key \"key\": json: cannot unmarshal number into Go struct field testValue.name of type string\n\n"+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"value": {
+			// {"name": "test"}
+			data:          internalprivatestate.MustProviderData(context.Background(), []byte(`{"key": "eyJuYW1lIjogInRlc3QifQ=="}`)),
+			key:           "key",
+			expected:      testValue{Name: "test"},
+			expectedFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found, diags := privatestate.GetValue[testValue](context.Background(), testCase.data, testCase.key)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if found != testCase.expectedFound {
+				t.Errorf("expected found %t, got %t", testCase.expectedFound, found)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key           string
+		value         any
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"key-invalid": {
+			key:   ".key",
+			value: testValue{Name: "test"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".key" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"value-unsupported": {
+			key:   "key",
+			value: make(chan int),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Encoding Private State",
+					"An error was encountered when encoding the private state value for key \"key\": json: unsupported type: chan int\n\n"+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"value": {
+			key:      "key",
+			value:    testValue{Name: "test"},
+			expected: []byte(`{"name":"test"}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := internalprivatestate.EmptyProviderData(context.Background())
+
+			diags := privatestate.SetValue(context.Background(), data, testCase.key, testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.expected == nil {
+				return
+			}
+
+			got, _ := data.GetKey(context.Background(), testCase.key)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwpanic"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// Versioned stores a value of type T at a private state key along with a
+// version number. Data written with a prior version is converted to the
+// current version by the Upgraders when it is read.
+//
+// Data is stored as a JSON object with exactly the "$version" and "$value"
+// properties. Data at the key which is not in this format, such as data
+// previously written with SetValue or the SetKey method, is treated as
+// version 0.
+type Versioned[T any] struct {
+	// Key is the private state key. It is required.
+	Key string
+
+	// Version is the current version of the data, which is written by Set.
+	// Increment the version each time the structure of T changes in a way
+	// that requires upgrading existing data.
+	Version int64
+
+	// Upgraders maps prior versions to the Upgrader which converts data
+	// written with that version directly to the current version, similar
+	// to the map returned by the resource.ResourceWithUpgradeState type
+	// UpgradeState method. Versions without an upgrader return an error
+	// diagnostic when read.
+	Upgraders map[int64]Upgrader
+}
+
+// versionedEnvelope is the JSON encoding of Versioned data. The property
+// names are prefixed to avoid mistaking version 0 data with "version" and
+// "value" properties for an envelope.
+type versionedEnvelope struct {
+	Version int64           `json:"$version"`
+	Value   json.RawMessage `json:"$value"`
+}
+
+// unmarshalVersionedEnvelope returns the envelope encoded in raw and true,
+// or false if raw is not a JSON object with exactly the envelope properties.
+func unmarshalVersionedEnvelope(raw []byte) (versionedEnvelope, bool) {
+	var (
+		envelope   versionedEnvelope
+		properties map[string]json.RawMessage
+	)
+
+	if err := json.Unmarshal(raw, &properties); err != nil || len(properties) != 2 {
+		return envelope, false
+	}
+
+	rawVersion, ok := properties["$version"]
+
+	if !ok {
+		return envelope, false
+	}
+
+	envelope.Value, ok = properties["$value"]
+
+	if !ok {
+		return envelope, false
+	}
+
+	if err := json.Unmarshal(rawVersion, &envelope.Version); err != nil {
+		return envelope, false
+	}
+
+	return envelope, true
+}
+
+// Get returns the value stored at the key, upgrading it to the current
+// version if necessary. The returned boolean is false if there is no data at
+// the key, in which case the zero value of T is returned.
+//
+// Upgraded data is not written back automatically. Call Set with the returned
+// value on a response Private field to persist the upgraded data.
+func (v Versioned[T]) Get(ctx context.Context, data Data) (T, bool, diag.Diagnostics) {
+	var value T
+
+	if data == nil {
+		return value, false, nil
+	}
+
+	raw, diags := data.GetKey(ctx, v.Key)
+
+	if diags.HasError() || len(raw) == 0 {
+		return value, false, diags
+	}
+
+	var version int64
+
+	// Data which is not an envelope, such as data written before the
+	// Versioned type was adopted, is treated as version 0.
+	if envelope, ok := unmarshalVersionedEnvelope(raw); ok {
+		version = envelope.Version
+		raw = envelope.Value
+	}
+
+	if version > v.Version {
+		diags.AddError(
+			"Unable to Read Private State",
+			fmt.Sprintf("The private state value for key %q was written with version %d, which is newer than the current version %d. ", v.Key, version, v.Version)+
+				"This can occur if the resource was last managed by a newer provider version.",
+		)
+
+		return value, false, diags
+	}
+
+	if version < v.Version {
+		upgrader, ok := v.Upgraders[version]
+
+		if !ok || upgrader.Upgrade == nil {
+			diags.AddError(
+				"Unable to Upgrade Private State",
+				fmt.Sprintf("The private state value for key %q was written with version %d, ", v.Key, version)+
+					fmt.Sprintf("however no upgrader was implemented for upgrading it to version %d.\n\n", v.Version)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+
+			return value, false, diags
+		}
+
+		upgradeReq := UpgradeRequest{
+			Key:      v.Key,
+			RawValue: raw,
+			Version:  version,
+		}
+		upgradeResp := UpgradeResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined private state Upgrader")
+		fwpanic.Call(ctx, &upgradeResp.Diagnostics, fwpanic.Method{Implementation: upgrader, Name: "Upgrade"}, func() {
+			upgrader.Upgrade(ctx, upgradeReq, &upgradeResp)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined private state Upgrader")
+
+		diags.Append(upgradeResp.Diagnostics...)
+
+		if diags.HasError() {
+			return value, false, diags
+		}
+
+		raw = upgradeResp.RawValue
+
+		if len(raw) == 0 {
+			return value, false, diags
+		}
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		diags.AddError(
+			"Error Decoding Private State",
+			fmt.Sprintf("An error was encountered when decoding the private state value for key %q: %s\n\n", v.Key, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return value, false, diags
+	}
+
+	return value, true, diags
+}
+
+// Set stores the given value at the key with the current version.
+func (v Versioned[T]) Set(ctx context.Context, data Data, value T) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rawValue, err := json.Marshal(value)
+
+	if err != nil {
+		diags.AddError(
+			"Error Encoding Private State",
+			fmt.Sprintf("An error was encountered when encoding the private state value for key %q: %s\n\n", v.Key, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	return SetValue(ctx, data, v.Key, versionedEnvelope{
+		Version: v.Version,
+		Value:   rawValue,
+	})
+}
+
+// Upgrader converts private state data written with a prior version to the
+// current version of a Versioned.
+type Upgrader struct {
+	// Upgrade is the provider defined logic for converting the prior version
+	// data to the current version.
+	//
+	// The context.Context parameter contains framework-defined loggers and
+	// supports request cancellation.
+	//
+	// The UpgradeRequest parameter contains the prior version data.
+	//
+	// The UpgradeResponse parameter should contain the upgraded data and can
+	// be used to signal any logic warnings or errors.
+	Upgrade func(context.Context, UpgradeRequest, *UpgradeResponse)
+}
+
+// UpgradeRequest is the request for an Upgrader.
+type UpgradeRequest struct {
+	// Key is the private state key of the data.
+	Key string
+
+	// RawValue is the JSON encoded prior version data.
+	RawValue []byte
+
+	// Version is the version the data was written with.
+	Version int64
+}
+
+// UpgradeResponse is the response for an Upgrader.
+type UpgradeResponse struct {
+	// Diagnostics report errors or warnings related to upgrading the data.
+	// An empty slice indicates a successful operation with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+
+	// RawValue is the JSON encoded data, which must match the current version.
+	// Leaving this empty is equivalent to there being no data at the key.
+	RawValue []byte
+}
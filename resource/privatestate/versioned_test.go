@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	internalprivatestate "github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/privatestate"
+)
+
+func TestVersionedGet(t *testing.T) {
+	t.Parallel()
+
+	// Version 0 stored the name as a bare JSON string.
+	testUpgraders := map[int64]privatestate.Upgrader{
+		0: {
+			Upgrade: func(_ context.Context, req privatestate.UpgradeRequest, resp *privatestate.UpgradeResponse) {
+				resp.RawValue = []byte(`{"name":` + string(req.RawValue) + `}`)
+			},
+		},
+	}
+
+	testData := func(raw string) *internalprivatestate.ProviderData {
+		data := internalprivatestate.EmptyProviderData(context.Background())
+
+		if diags := data.SetKey(context.Background(), "key", []byte(raw)); diags.HasError() {
+			t.Fatalf("unexpected error setting key: %v", diags)
+		}
+
+		return data
+	}
+
+	testCases := map[string]struct {
+		versioned     privatestate.Versioned[testValue]
+		data          privatestate.Data
+		expected      testValue
+		expectedFound bool
+		expectedDiags diag.Diagnostics
+	}{
+		"key-not-found": {
+			versioned: privatestate.Versioned[testValue]{Key: "key", Version: 1},
+			data:      internalprivatestate.EmptyProviderData(context.Background()),
+		},
+		"current-version": {
+			versioned:     privatestate.Versioned[testValue]{Key: "key", Version: 1, Upgraders: testUpgraders},
+			data:          testData(`{"$version":1,"$value":{"name":"test"}}`),
+			expected:      testValue{Name: "test"},
+			expectedFound: true,
+		},
+		"unversioned-upgrade": {
+			versioned:     privatestate.Versioned[testValue]{Key: "key", Version: 1, Upgraders: testUpgraders},
+			data:          testData(`"test"`),
+			expected:      testValue{Name: "test"},
+			expectedFound: true,
+		},
+		"unversioned-envelope-extra-properties": {
+			versioned:     privatestate.Versioned[testValue]{Key: "key", Version: 0},
+			data:          testData(`{"$version":1,"$value":{"name":"test"},"name":"other"}`),
+			expected:      testValue{Name: "other"},
+			expectedFound: true,
+		},
+		"prior-version-upgrade": {
+			versioned:     privatestate.Versioned[testValue]{Key: "key", Version: 1, Upgraders: testUpgraders},
+			data:          testData(`{"$version":0,"$value":"test"}`),
+			expected:      testValue{Name: "test"},
+			expectedFound: true,
+		},
+		"prior-version-missing-upgrader": {
+			versioned: privatestate.Versioned[testValue]{Key: "key", Version: 2, Upgraders: testUpgraders},
+			data:      testData(`{"$version":1,"$value":{"name":"test"}}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Upgrade Private State",
+					"The private state value for key \"key\" was written with version 1, "+
+						"however no upgrader was implemented for upgrading it to version 2.\n\n"+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"prior-version-upgrader-error": {
+			versioned: privatestate.Versioned[testValue]{
+				Key:     "key",
+				Version: 1,
+				Upgraders: map[int64]privatestate.Upgrader{
+					0: {
+						Upgrade: func(_ context.Context, _ privatestate.UpgradeRequest, resp *privatestate.UpgradeResponse) {
+							resp.Diagnostics.AddError("error summary", "error detail")
+						},
+					},
+				},
+			},
+			data: testData(`"test"`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"newer-version": {
+			versioned: privatestate.Versioned[testValue]{Key: "key", Version: 1},
+			data:      testData(`{"$version":2,"$value":{"name":"test"}}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read Private State",
+					"The private state value for key \"key\" was written with version 2, which is newer than the current version 1. "+
+						"This can occur if the resource was last managed by a newer provider version.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found, diags := testCase.versioned.Get(context.Background(), testCase.data)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if found != testCase.expectedFound {
+				t.Errorf("expected found %t, got %t", testCase.expectedFound, found)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestVersionedGet_EnvelopeShapedValue(t *testing.T) {
+	t.Parallel()
+
+	// Version 0 data which happens to have "version" and "value" properties
+	// must not be mistaken for an envelope.
+	type testEnvelopeShapedValue struct {
+		Version int64  `json:"version"`
+		Value   string `json:"value"`
+	}
+
+	ctx := context.Background()
+	data := internalprivatestate.EmptyProviderData(ctx)
+
+	if diags := data.SetKey(ctx, "key", []byte(`{"version":2,"value":"test"}`)); diags.HasError() {
+		t.Fatalf("unexpected error setting key: %v", diags)
+	}
+
+	versioned := privatestate.Versioned[testEnvelopeShapedValue]{Key: "key", Version: 0}
+
+	got, found, diags := versioned.Get(ctx, data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !found {
+		t.Fatal("expected found")
+	}
+
+	if diff := cmp.Diff(got, testEnvelopeShapedValue{Version: 2, Value: "test"}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestVersionedSet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := internalprivatestate.EmptyProviderData(ctx)
+	versioned := privatestate.Versioned[testValue]{Key: "key", Version: 3}
+
+	if diags := versioned.Set(ctx, data, testValue{Name: "test"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got, _ := data.GetKey(ctx, "key")
+
+	if diff := cmp.Diff(string(got), `{"$version":3,"$value":{"name":"test"}}`); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	roundtrip, found, diags := versioned.Get(ctx, data)
+
+	if diags.HasError() || !found {
+		t.Fatalf("unexpected roundtrip result: %t, %v", found, diags)
+	}
+
+	if diff := cmp.Diff(roundtrip, testValue{Name: "test"}); diff != "" {
+		t.Errorf("unexpected roundtrip difference: %s", diff)
+	}
+}
@@ -29,6 +29,16 @@ type ReadRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ReadResource RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ReadClientCapabilities
+
+	// Identity is the current identity of the resource prior to the Read
+	// operation. This field is only populated if the resource implements
+	// ResourceWithIdentity. The value is null if the resource was created
+	// before identity support was added to the resource.
+	Identity *tfsdk.ResourceIdentity
 }
 
 // ReadResponse represents a response to a ReadRequest. An
@@ -50,4 +60,20 @@ type ReadResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer this resource read until a
+	// followup apply operation.
+	//
+	// This field can only be set if
+	// `(resource.ReadRequest).ClientCapabilities.DeferralAllowed` is true.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	Deferred *Deferred
+
+	// Identity is the identity of the resource following the Read operation.
+	// This field is pre-populated from ReadRequest.Identity and should be set
+	// during the resource's Read operation if it was null. Once set, the
+	// identity must not change.
+	Identity *tfsdk.ResourceIdentity
 }
@@ -19,6 +19,8 @@ import (
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - State Moves: ResourceWithMoveState
+//   - Concurrency Limits: ResourceWithConcurrencyLimit
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -54,6 +56,24 @@ type Resource interface {
 	Delete(context.Context, DeleteRequest, *DeleteResponse)
 }
 
+// ResourceWithConcurrencyLimit represents a resource which limits how many of
+// its Create, Read, Update, and Delete methods can run concurrently across
+// all instances of the resource type. This can prevent overloading remote
+// APIs which only allow a few concurrent operations, since the Terraform
+// -parallelism flag applies to all resources.
+//
+// Calls waiting for the limit are logged and respect context cancellation,
+// such as when Terraform calls the StopProvider RPC.
+type ResourceWithConcurrencyLimit interface {
+	Resource
+
+	// ConcurrencyLimit should return the maximum number of concurrent Create,
+	// Read, Update, and Delete calls for the resource type. It is called
+	// once per resource type, after Configure, before the first of those
+	// calls.
+	ConcurrencyLimit(context.Context, ConcurrencyLimitRequest, *ConcurrencyLimitResponse)
+}
+
 // ResourceWithConfigure is an interface type that extends Resource to
 // include a method which the framework will automatically call so provider
 // developers have the opportunity to setup any necessary provider-level data
@@ -101,6 +121,41 @@ type ResourceWithImportState interface {
 	ImportState(context.Context, ImportStateRequest, *ImportStateResponse)
 }
 
+// ResourceWithIdentity is an interface type that extends Resource to
+// implement managed resource identity.
+//
+// Resource identity is data, separate from resource state, which uniquely
+// identifies the remote object, such as the attributes of a composite key.
+// Terraform stores the identity alongside the resource state and supports
+// importing the resource by identity rather than an opaque import ID. Once
+// a resource has an identity, the framework verifies that it does not change
+// during later operations.
+//
+// This functionality is only supported in Terraform 1.12 and later.
+type ResourceWithIdentity interface {
+	Resource
+
+	// IdentitySchema should return the identity schema for this resource.
+	IdentitySchema(context.Context, IdentitySchemaRequest, *IdentitySchemaResponse)
+}
+
+// Optional interface on top of Resource that enables provider control over
+// the MoveResourceState RPC. This RPC is called by Terraform when there is a
+// moved configuration block that changes the resource type and the current
+// resource type is the target of the move. The source resource type may be
+// managed by a different provider.
+//
+// This functionality is only supported in Terraform 1.8 and later.
+type ResourceWithMoveState interface {
+	Resource
+
+	// An ordered list of source resource to current schema version state
+	// move implementations. Each StateMover is attempted in order until one
+	// returns state data or error diagnostics. The framework will return an
+	// error diagnostic should no StateMover handle the request.
+	MoveState(context.Context) []StateMover
+}
+
 // ResourceWithModifyPlan represents a resource instance with a ModifyPlan
 // function.
 type ResourceWithModifyPlan interface {
@@ -153,6 +208,34 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]StateUpgrader
 }
 
+// Optional interface on top of ResourceWithIdentity that enables provider
+// control over the UpgradeResourceIdentity RPC. This RPC is automatically
+// called by Terraform when the current identity schema type Version field is
+// greater than the stored identity. Terraform does not store previous identity
+// schema information, so any breaking changes to identity data types must be
+// handled by providers.
+//
+// Terraform CLI can execute the UpgradeResourceIdentity RPC even when the
+// prior identity version matches the current identity schema version. The
+// framework will automatically intercept this request and attempt to respond
+// with the existing identity. In this situation the framework will not
+// execute any provider defined logic, so declaring it for this version is
+// extraneous.
+type ResourceWithUpgradeIdentity interface {
+	ResourceWithIdentity
+
+	// A mapping of prior identity version to current identity schema version
+	// upgrade implementations. Only the specified identity upgrader for the
+	// prior identity version is called, rather than each version in between,
+	// so it must encapsulate all logic to convert the prior identity to the
+	// current identity schema version.
+	//
+	// Version keys begin at 0, which is the default identity schema version
+	// when undefined. The framework will return an error diagnostic should
+	// the requested identity version not be implemented.
+	UpgradeIdentity(context.Context) map[int64]IdentityUpgrader
+}
+
 // ResourceWithValidateConfig is an interface type that extends Resource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package retry contains helpers for resource implementations which call
+// eventually consistent or long-running remote APIs.
+//
+// UntilDone calls a function until it succeeds, returns a non-retryable
+// error, or the timeout is reached:
+//
+//	diags := retry.UntilDone(ctx, 5*time.Minute, func(ctx context.Context) *retry.Error {
+//		err := client.AttachVolume(ctx, id)
+//
+//		if isConflict(err) {
+//			return retry.RetryableError(err)
+//		}
+//
+//		if err != nil {
+//			return retry.NonRetryableError(err)
+//		}
+//
+//		return nil
+//	})
+//
+// StateChangeConf polls a remote object until it reaches one of the target
+// states, such as waiting for a server to become "running" after creation.
+//
+// Each attempt is logged at TRACE level by the framework, including the
+// tf_resource_type field of the current resource operation.
+package retry
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+const (
+	// defaultMinPollInterval is the wait before the second attempt when a
+	// minimum poll interval is not configured.
+	defaultMinPollInterval = 100 * time.Millisecond
+
+	// defaultMaxPollInterval is the upper bound of the exponentially
+	// increasing wait between attempts when a maximum poll interval is not
+	// configured.
+	defaultMaxPollInterval = 10 * time.Second
+)
+
+// RetryFunc is the function called by UntilDone. Return nil when the
+// operation is done, RetryableError to try again, or NonRetryableError to
+// stop immediately.
+type RetryFunc func(context.Context) *Error
+
+// Error is the error returned by a RetryFunc, which signals whether the
+// operation should be attempted again.
+type Error struct {
+	// Err is the underlying error.
+	Err error
+
+	// Retryable is true if the operation should be attempted again.
+	Retryable bool
+}
+
+// Error returns the underlying error message.
+func (e *Error) Error() string {
+	if e == nil || e.Err == nil {
+		return "<nil>"
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+
+	return e.Err
+}
+
+// RetryableError returns an Error which signals UntilDone to call the
+// RetryFunc again.
+func RetryableError(err error) *Error {
+	if err == nil {
+		err = errors.New("empty retryable error received")
+	}
+
+	return &Error{
+		Err:       err,
+		Retryable: true,
+	}
+}
+
+// NonRetryableError returns an Error which signals UntilDone to stop and
+// return the error.
+func NonRetryableError(err error) *Error {
+	if err == nil {
+		err = errors.New("empty non-retryable error received")
+	}
+
+	return &Error{
+		Err:       err,
+		Retryable: false,
+	}
+}
+
+// UntilDone calls the RetryFunc until it returns nil, returns a
+// NonRetryableError, or the timeout is reached. The wait between attempts
+// starts at 100ms and doubles up to 10s. A timeout of zero only uses the
+// deadline of the given context, if any.
+func UntilDone(ctx context.Context, timeout time.Duration, f RetryFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	interval := defaultMinPollInterval
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			diags.Append(untilDoneContextDiagnostic(err, timeout, lastErr))
+
+			return diags
+		}
+
+		logging.FrameworkTrace(ctx, "Calling retry function", map[string]interface{}{
+			logging.KeyRetryAttempt: attempt,
+		})
+
+		retryErr := f(ctx)
+
+		if retryErr == nil {
+			logging.FrameworkTrace(ctx, "Retry function completed", map[string]interface{}{
+				logging.KeyRetryAttempt: attempt,
+			})
+
+			return diags
+		}
+
+		if !retryErr.Retryable {
+			logging.FrameworkTrace(ctx, "Retry function returned non-retryable error", map[string]interface{}{
+				logging.KeyError:        retryErr.Error(),
+				logging.KeyRetryAttempt: attempt,
+			})
+
+			diags.AddError(
+				"Operation Failed",
+				fmt.Sprintf("The operation returned an error which cannot be retried after %d attempt(s): %s", attempt, retryErr.Error()),
+			)
+
+			return diags
+		}
+
+		lastErr = retryErr.Err
+
+		logging.FrameworkTrace(ctx, "Retry function returned retryable error", map[string]interface{}{
+			logging.KeyError:        retryErr.Error(),
+			logging.KeyRetryAttempt: attempt,
+		})
+
+		if err := sleep(ctx, interval); err != nil {
+			diags.Append(untilDoneContextDiagnostic(err, timeout, lastErr))
+
+			return diags
+		}
+
+		interval = nextInterval(interval, defaultMaxPollInterval)
+	}
+}
+
+// untilDoneContextDiagnostic returns the error diagnostic for UntilDone when
+// the context is done before the operation completed.
+func untilDoneContextDiagnostic(ctxErr error, timeout time.Duration, lastErr error) diag.Diagnostic {
+	var lastErrDetail string
+
+	if lastErr != nil {
+		lastErrDetail = fmt.Sprintf(" The last retryable error was: %s", lastErr)
+	}
+
+	if !errors.Is(ctxErr, context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic(
+			"Retry Canceled",
+			"The operation was canceled before it completed."+lastErrDetail,
+		)
+	}
+
+	if timeout > 0 {
+		return diag.NewErrorDiagnostic(
+			"Retry Timeout Exceeded",
+			fmt.Sprintf("The operation did not complete within the timeout of %s.", timeout)+lastErrDetail,
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Retry Timeout Exceeded",
+		"The operation did not complete before the context deadline."+lastErrDetail,
+	)
+}
+
+// nextInterval returns double the given interval, bounded by max.
+func nextInterval(interval time.Duration, maxInterval time.Duration) time.Duration {
+	interval *= 2
+
+	if interval > maxInterval {
+		return maxInterval
+	}
+
+	return interval
+}
+
+// sleep waits for the given duration or until the context is done, in which
+// case the context error is returned.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
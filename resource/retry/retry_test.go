@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
+func TestUntilDone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timeout          time.Duration
+		results          []*retry.Error
+		expectedAttempts int
+		expectedDiags    diag.Diagnostics
+	}{
+		"done": {
+			timeout:          time.Minute,
+			results:          []*retry.Error{nil},
+			expectedAttempts: 1,
+		},
+		"retryable-done": {
+			timeout: time.Minute,
+			results: []*retry.Error{
+				retry.RetryableError(errors.New("test error")),
+				nil,
+			},
+			expectedAttempts: 2,
+		},
+		"non-retryable": {
+			timeout: time.Minute,
+			results: []*retry.Error{
+				retry.RetryableError(errors.New("test retryable error")),
+				retry.NonRetryableError(errors.New("test error")),
+			},
+			expectedAttempts: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Operation Failed",
+					"The operation returned an error which cannot be retried after 2 attempt(s): test error",
+				),
+			},
+		},
+		"timeout": {
+			timeout: 50 * time.Millisecond,
+			results: []*retry.Error{
+				retry.RetryableError(errors.New("test error")),
+			},
+			expectedAttempts: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Retry Timeout Exceeded",
+					"The operation did not complete within the timeout of 50ms. The last retryable error was: test error",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+
+			diags := retry.UntilDone(context.Background(), testCase.timeout, func(_ context.Context) *retry.Error {
+				result := testCase.results[min(attempts, len(testCase.results)-1)]
+				attempts++
+
+				return result
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got: %d", testCase.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestUntilDone_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	diags := retry.UntilDone(ctx, time.Minute, func(_ context.Context) *retry.Error {
+		cancel()
+
+		return retry.RetryableError(errors.New("test error"))
+	})
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Retry Canceled",
+			"The operation was canceled before it completed. The last retryable error was: test error",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestUntilDone_Logging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = tfsdklog.SetField(ctx, logging.KeyResourceType, "test_resource")
+	ctx = logging.InitContext(ctx)
+
+	diags := retry.UntilDone(ctx, time.Minute, func(_ context.Context) *retry.Error {
+		return nil
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":           "trace",
+			"@message":         "Calling retry function",
+			"@module":          "sdk.framework",
+			"tf_resource_type": "test_resource",
+			"tf_retry_attempt": float64(1),
+		},
+		{
+			"@level":           "trace",
+			"@message":         "Retry function completed",
+			"@module":          "sdk.framework",
+			"tf_resource_type": "test_resource",
+			"tf_retry_attempt": float64(1),
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+const (
+	// defaultNotFoundChecks is the number of consecutive not found refreshes
+	// tolerated when NotFoundChecks is not configured.
+	defaultNotFoundChecks = 20
+)
+
+// StateRefreshFunc is the function called by StateChangeConf to refresh the
+// remote object. It returns the remote object, which is passed back to the
+// caller of WaitForState, and its current state.
+//
+// A nil result signals the remote object was not found. If the Target of the
+// StateChangeConf is empty, this is treated as the target, such as when
+// waiting for deletion. Otherwise, it is tolerated up to NotFoundChecks
+// consecutive times to account for eventual consistency.
+type StateRefreshFunc func(context.Context) (result any, state string, err error)
+
+// StateChangeConf configures polling a remote object until it reaches one of
+// the target states.
+type StateChangeConf struct {
+	// Pending is the list of states which are allowed while waiting for the
+	// target states. Any other state returns an error diagnostic.
+	Pending []string
+
+	// Target is the list of states which complete waiting. When empty, the
+	// remote object not being found completes waiting.
+	Target []string
+
+	// Refresh is the function which returns the remote object and its
+	// current state. This field is required.
+	Refresh StateRefreshFunc
+
+	// Timeout is the maximum amount of time to wait for the target states. A
+	// timeout of zero only uses the deadline of the given context, if any.
+	Timeout time.Duration
+
+	// Delay is the wait before the first refresh.
+	Delay time.Duration
+
+	// MinPollInterval is the wait after the first refresh, which doubles
+	// after each refresh up to MaxPollInterval. Defaults to 100ms.
+	MinPollInterval time.Duration
+
+	// MaxPollInterval is the upper bound of the wait between refreshes.
+	// Defaults to 10s.
+	MaxPollInterval time.Duration
+
+	// NotFoundChecks is the number of consecutive times the remote object
+	// can be not found before returning an error diagnostic. Defaults to 20.
+	NotFoundChecks int
+
+	// ContinuousTargetOccurrence is the number of consecutive times a target
+	// state must be returned to complete waiting, which can be used with
+	// remote systems that are eventually consistent. Defaults to 1.
+	ContinuousTargetOccurrence int
+}
+
+// WaitForState refreshes the remote object until it reaches one of the
+// target states and returns the last result of the Refresh function. Error
+// diagnostics are returned if the Refresh function returns an error, an
+// unexpected state is returned, the remote object is not found, or the
+// timeout is reached.
+func (c *StateChangeConf) WaitForState(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c.Refresh == nil {
+		diags.AddError(
+			"Invalid Retry Configuration",
+			"The StateChangeConf Refresh function is required. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return nil, diags
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	notFoundChecks := c.NotFoundChecks
+
+	if notFoundChecks <= 0 {
+		notFoundChecks = defaultNotFoundChecks
+	}
+
+	targetOccurrence := c.ContinuousTargetOccurrence
+
+	if targetOccurrence <= 0 {
+		targetOccurrence = 1
+	}
+
+	interval := c.MinPollInterval
+
+	if interval <= 0 {
+		interval = defaultMinPollInterval
+	}
+
+	maxInterval := c.MaxPollInterval
+
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	var (
+		lastResult    any
+		lastState     string
+		notFoundCount int
+		targetCount   int
+	)
+
+	wait := c.Delay
+
+	for attempt := 1; ; attempt++ {
+		if err := sleep(ctx, wait); err != nil {
+			diags.Append(c.contextDiagnostic(err, lastState))
+
+			return lastResult, diags
+		}
+
+		result, state, err := c.Refresh(ctx)
+
+		if err != nil {
+			logging.FrameworkTrace(ctx, "State refresh returned error", map[string]interface{}{
+				logging.KeyError:        err.Error(),
+				logging.KeyRetryAttempt: attempt,
+			})
+
+			diags.AddError(
+				"Error Refreshing State",
+				fmt.Sprintf("An error was returned while waiting for the remote object to %s: %s", targetDescription(c.Target), err),
+			)
+
+			return result, diags
+		}
+
+		logging.FrameworkTrace(ctx, "Refreshed state", map[string]interface{}{
+			logging.KeyRetryAttempt: attempt,
+			logging.KeyRetryState:   state,
+		})
+
+		lastResult = result
+		lastState = state
+
+		switch {
+		case result == nil && len(c.Target) == 0:
+			notFoundCount = 0
+			targetCount++
+		case result == nil:
+			targetCount = 0
+			notFoundCount++
+
+			if notFoundCount > notFoundChecks {
+				diags.AddError(
+					"Remote Object Not Found",
+					fmt.Sprintf("The remote object was not found after %d consecutive checks while waiting for it to %s.", notFoundCount, targetDescription(c.Target)),
+				)
+
+				return nil, diags
+			}
+		case slices.Contains(c.Target, state):
+			notFoundCount = 0
+			targetCount++
+		case slices.Contains(c.Pending, state):
+			notFoundCount = 0
+			targetCount = 0
+		default:
+			diags.AddError(
+				"Unexpected State",
+				fmt.Sprintf("While waiting for the remote object to %s, the unexpected state %q was returned. Expected pending states: %s.",
+					targetDescription(c.Target), state, quoteStates(c.Pending)),
+			)
+
+			return result, diags
+		}
+
+		if targetCount >= targetOccurrence {
+			logging.FrameworkTrace(ctx, "Reached target state", map[string]interface{}{
+				logging.KeyRetryAttempt: attempt,
+				logging.KeyRetryState:   state,
+			})
+
+			return result, diags
+		}
+
+		wait = interval
+		interval = nextInterval(interval, maxInterval)
+	}
+}
+
+// contextDiagnostic returns the error diagnostic for WaitForState when the
+// context is done before the target states are reached.
+func (c *StateChangeConf) contextDiagnostic(ctxErr error, lastState string) diag.Diagnostic {
+	lastStateDetail := fmt.Sprintf(" The last state was %q.", lastState)
+
+	if !errors.Is(ctxErr, context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic(
+			"Waiting For State Canceled",
+			fmt.Sprintf("Waiting for the remote object to %s was canceled.", targetDescription(c.Target))+lastStateDetail,
+		)
+	}
+
+	if c.Timeout > 0 {
+		return diag.NewErrorDiagnostic(
+			"Timeout Waiting For State",
+			fmt.Sprintf("Waiting for the remote object to %s did not complete within the timeout of %s.", targetDescription(c.Target), c.Timeout)+lastStateDetail,
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Timeout Waiting For State",
+		fmt.Sprintf("Waiting for the remote object to %s did not complete before the context deadline.", targetDescription(c.Target))+lastStateDetail,
+	)
+}
+
+// targetDescription returns a human readable description of waiting for the
+// given target states.
+func targetDescription(target []string) string {
+	if len(target) == 0 {
+		return "be not found"
+	}
+
+	return "reach state " + quoteStates(target)
+}
+
+// quoteStates returns a human readable list of the given states.
+func quoteStates(states []string) string {
+	if len(states) == 0 {
+		return "none"
+	}
+
+	quoted := make([]string, 0, len(states))
+
+	for _, state := range states {
+		quoted = append(quoted, fmt.Sprintf("%q", state))
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
+type testRefreshResult struct {
+	result any
+	state  string
+	err    error
+}
+
+func TestStateChangeConfWaitForState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conf             retry.StateChangeConf
+		results          []testRefreshResult
+		expectedResult   any
+		expectedAttempts int
+		expectedDiags    diag.Diagnostics
+	}{
+		"refresh-missing": {
+			conf: retry.StateChangeConf{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Retry Configuration",
+					"The StateChangeConf Refresh function is required. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"target": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "running"},
+			},
+			expectedResult:   "test-object",
+			expectedAttempts: 1,
+		},
+		"pending-target": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "pending"},
+				{result: "test-object", state: "pending"},
+				{result: "test-object", state: "running"},
+			},
+			expectedResult:   "test-object",
+			expectedAttempts: 3,
+		},
+		"continuous-target-occurrence": {
+			conf: retry.StateChangeConf{
+				ContinuousTargetOccurrence: 2,
+				Pending:                    []string{"pending"},
+				Target:                     []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "running"},
+				{result: "test-object", state: "pending"},
+				{result: "test-object", state: "running"},
+				{result: "test-object", state: "running"},
+			},
+			expectedResult:   "test-object",
+			expectedAttempts: 4,
+		},
+		"not-found-target": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"deleting"},
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "deleting"},
+				{result: nil, state: ""},
+			},
+			expectedAttempts: 2,
+		},
+		"not-found-tolerated": {
+			conf: retry.StateChangeConf{
+				NotFoundChecks: 2,
+				Pending:        []string{"pending"},
+				Target:         []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: nil, state: ""},
+				{result: nil, state: ""},
+				{result: "test-object", state: "running"},
+			},
+			expectedResult:   "test-object",
+			expectedAttempts: 3,
+		},
+		"not-found-exceeded": {
+			conf: retry.StateChangeConf{
+				NotFoundChecks: 2,
+				Pending:        []string{"pending"},
+				Target:         []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: nil, state: ""},
+			},
+			expectedAttempts: 3,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Remote Object Not Found",
+					`The remote object was not found after 3 consecutive checks while waiting for it to reach state "running".`,
+				),
+			},
+		},
+		"unexpected-state": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"pending", "starting"},
+				Target:  []string{"running"},
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "pending"},
+				{result: "test-object", state: "failed"},
+			},
+			expectedResult:   "test-object",
+			expectedAttempts: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected State",
+					`While waiting for the remote object to reach state "running", the unexpected state "failed" was returned. `+
+						`Expected pending states: "pending", "starting".`,
+				),
+			},
+		},
+		"refresh-error": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"running"},
+			},
+			results: []testRefreshResult{
+				{err: errors.New("test error")},
+			},
+			expectedAttempts: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Refreshing State",
+					`An error was returned while waiting for the remote object to reach state "running": test error`,
+				),
+			},
+		},
+		"timeout": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"pending"},
+				Target:  []string{"running"},
+				Timeout: 20 * time.Millisecond,
+			},
+			results: []testRefreshResult{
+				{result: "test-object", state: "pending"},
+			},
+			expectedResult: "test-object",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Waiting For State",
+					`Waiting for the remote object to reach state "running" did not complete within the timeout of 20ms. `+
+						`The last state was "pending".`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+
+			conf := testCase.conf
+			conf.MinPollInterval = time.Millisecond
+			conf.MaxPollInterval = time.Millisecond
+
+			if testCase.results != nil {
+				conf.Refresh = func(_ context.Context) (any, string, error) {
+					result := testCase.results[min(attempts, len(testCase.results)-1)]
+					attempts++
+
+					return result.result, result.state, result.err
+				}
+			}
+
+			got, diags := conf.WaitForState(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResult); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			// Timeouts poll an indeterminate number of times.
+			if testCase.expectedAttempts > 0 && attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got: %d", testCase.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestStateChangeConfWaitForState_Logging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = tfsdklog.SetField(ctx, logging.KeyResourceType, "test_resource")
+	ctx = logging.InitContext(ctx)
+
+	states := []string{"pending", "running"}
+
+	conf := retry.StateChangeConf{
+		MinPollInterval: time.Millisecond,
+		Pending:         []string{"pending"},
+		Refresh: func(_ context.Context) (any, string, error) {
+			state := states[0]
+			states = states[1:]
+
+			return "test-object", state, nil
+		},
+		Target: []string{"running"},
+	}
+
+	_, diags := conf.WaitForState(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":           "trace",
+			"@message":         "Refreshed state",
+			"@module":          "sdk.framework",
+			"tf_resource_type": "test_resource",
+			"tf_retry_attempt": float64(1),
+			"tf_retry_state":   "pending",
+		},
+		{
+			"@level":           "trace",
+			"@message":         "Refreshed state",
+			"@module":          "sdk.framework",
+			"tf_resource_type": "test_resource",
+			"tf_retry_attempt": float64(2),
+			"tf_retry_state":   "running",
+		},
+		{
+			"@level":           "trace",
+			"@message":         "Reached target state",
+			"@module":          "sdk.framework",
+			"tf_resource_type": "test_resource",
+			"tf_retry_attempt": float64(2),
+			"tf_retry_state":   "running",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
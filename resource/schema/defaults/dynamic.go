@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package defaults
 
 import (
@@ -12,18 +15,18 @@ import (
 type Dynamic interface {
 	Describer
 
-	// DefaultDynamic should Dynamic the default value.
+	// DefaultDynamic should set the default value.
 	DefaultDynamic(context.Context, DynamicRequest, *DynamicResponse)
 }
 
 type DynamicRequest struct {
-	// Path contains the path of the attribute for Dynamicting the
+	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
 type DynamicResponse struct {
-	// Diagnostics report errors or warnings related to Dynamicting the
+	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
 	// indicates success, with no warnings or errors generated.
 	Diagnostics diag.Diagnostics
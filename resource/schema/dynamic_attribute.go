@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package schema
 
 import (
@@ -14,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = DynamicAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = DynamicAttribute{}
@@ -22,6 +26,20 @@ var (
 	_ fwxschema.AttributeWithDynamicValidators     = DynamicAttribute{}
 )
 
+// DynamicAttribute represents a schema attribute that is dynamic, whose
+// underlying type is only determined by the configuration or provider logic.
+// When retrieving the value for this attribute, use types.Dynamic as the value
+// type unless the CustomType field is set.
+//
+// Terraform configurations configure this attribute using expressions that
+// return any type of value.
+//
+//	example_attribute = "value"
+//	example_attribute = ["value1", "value2"]
+//
+// Terraform configurations reference this attribute using the attribute name.
+//
+//	.example_attribute
 type DynamicAttribute struct {
 	// CustomType enables the use of a custom attribute type in place of the
 	// default basetypes.DynamicType. When retrieving data, the basetypes.DynamicValuable
@@ -51,6 +69,17 @@ type DynamicAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that Terraform will not store this attribute value
+	// in the plan or state artifacts. The value is only available from the
+	// configuration, such as the Config field of the resource Create and
+	// Update requests. If WriteOnly is true, either Optional or Required must
+	// also be true. WriteOnly cannot be combined with Computed or Default.
+	//
+	// This functionality is only supported in Terraform 1.11 and later.
+	// Practitioners that choose a value for this attribute with older
+	// versions of Terraform will receive an error.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -153,8 +182,7 @@ func (a DynamicAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
-// GetDeprecationMessage always returns an empty string as there is no
-// deprecation validation support for provider meta schemas.
+// GetDeprecationMessage returns the DeprecationMessage field value.
 func (a DynamicAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
@@ -175,10 +203,10 @@ func (a DynamicAttribute) GetType() attr.Type {
 		return a.CustomType
 	}
 
-	return types.DynamicType{}
+	return types.DynamicType
 }
 
-// IsComputed always returns false as provider schemas cannot be Computed.
+// IsComputed returns the Computed field value.
 func (a DynamicAttribute) IsComputed() bool {
 	return a.Computed
 }
@@ -198,6 +226,11 @@ func (a DynamicAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a DynamicAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // DynamicDefaultValue returns the Default field value.
 func (a DynamicAttribute) DynamicDefaultValue() defaults.Dynamic {
 	return a.Default
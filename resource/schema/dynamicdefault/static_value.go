@@ -9,22 +9,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-// StaticDynamic returns a static string value default handler.
+// StaticDynamic returns a static dynamic value default handler.
 //
-// Use StaticDynamic if a static default value for a string should be set.
-func StaticDynamic(defaultVal tftypes.Value) defaults.Dynamic {
+// Use StaticDynamic if a static default value for a dynamic should be set.
+func StaticDynamic(defaultVal types.Dynamic) defaults.Dynamic {
 	return staticDynamicDefault{
 		defaultVal: defaultVal,
 	}
 }
 
 // staticDynamicDefault is static value default handler that
-// sets a value on a string attribute.
+// sets a value on a dynamic attribute.
 type staticDynamicDefault struct {
-	defaultVal tftypes.Value
+	defaultVal types.Dynamic
 }
 
 // Description returns a human-readable description of the default value handler.
@@ -39,5 +38,5 @@ func (d staticDynamicDefault) MarkdownDescription(_ context.Context) string {
 
 // DefaultDynamic implements the static default value logic.
 func (d staticDynamicDefault) DefaultDynamic(_ context.Context, req defaults.DynamicRequest, resp *defaults.DynamicResponse) {
-	resp.PlanValue = types.DynamicValue(&d.defaultVal)
+	resp.PlanValue = d.defaultVal
 }
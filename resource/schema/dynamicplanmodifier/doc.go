@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dynamicplanmodifier provides plan modifiers for types.Dynamic attributes.
+package dynamicplanmodifier
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfUnderlyingTypeChanged returns a plan modifier that
+// conditionally requires resource replacement if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values are not equal.
+//   - The plan and state underlying values are both known to have a type,
+//     and those types are not equal, such as a string changing to a number.
+//
+// Changes to the underlying value which keep the same type, or changes to or
+// from a null dynamic value, do not require replacement.
+//
+// Use RequiresReplace if the resource replacement should occur on any value
+// changes. Use RequiresReplaceIfConfigured if the resource replacement should
+// occur on value changes, but only if there is a configuration value (ignore
+// unconfigured drift detection changes). Use RequiresReplaceIf if the resource
+// replacement should check provider-defined conditional logic.
+func RequiresReplaceIfUnderlyingTypeChanged() planmodifier.Dynamic {
+	return RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.DynamicRequest, resp *RequiresReplaceIfFuncResponse) {
+			planType := req.PlanValue.UnderlyingType(ctx)
+			stateType := req.StateValue.UnderlyingType(ctx)
+
+			// The type cannot be compared if either value is null or unknown
+			// at the dynamic level.
+			if planType == nil || stateType == nil {
+				return
+			}
+
+			if planType.Equal(stateType) {
+				return
+			}
+
+			resp.RequiresReplace = true
+		},
+		"If the type of the value of this attribute changes, Terraform will destroy and recreate the resource.",
+		"If the type of the value of this attribute changes, Terraform will destroy and recreate the resource.",
+	)
+}
@@ -71,6 +71,17 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that Terraform will not store this attribute value
+	// in the plan or state artifacts. The value is only available from the
+	// configuration, such as the Config field of the resource Create and
+	// Update requests. If WriteOnly is true, either Optional or Required must
+	// also be true. WriteOnly cannot be combined with Computed or Default.
+	//
+	// This functionality is only supported in Terraform 1.11 and later.
+	// Practitioners that choose a value for this attribute with older
+	// versions of Terraform will receive an error.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -232,6 +243,11 @@ func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a Float64Attribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package int32planmodifier provides plan modifiers for types.Int32 attributes.
+package int32planmodifier
@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// Dynamic is a schema validator for types.Dynamic attributes.
+// Dynamic is a schema plan modifier for types.Dynamic attributes.
 type Dynamic interface {
 	Describer
 
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -357,6 +358,16 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 			expected:    nil,
 			expectedErr: fwschema.ErrPathIsBlock.Error(),
 		},
+		"WithAttributeName-dynamic-WithElementKeyInt": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.DynamicAttribute{},
+				},
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			expected:    nil,
+			expectedErr: fwschema.ErrPathInsideDynamicAttribute.Error(),
+		},
 		"WithElementKeyInt": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
@@ -963,6 +974,15 @@ func TestSchemaTypeAtTerraformPath(t *testing.T) {
 			path:          tftypes.NewAttributePath().WithAttributeName("non-existent"),
 			expectedError: fmt.Errorf("AttributeName(\"non-existent\") still remains in the path: could not find attribute or block \"non-existent\" in schema"),
 		},
+		"AttributeName-DynamicAttribute-ElementKeyInt": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"dynamic": schema.DynamicAttribute{},
+				},
+			},
+			path:          tftypes.NewAttributePath().WithAttributeName("dynamic").WithElementKeyInt(0),
+			expectedError: fwschema.ErrPathInsideDynamicAttribute,
+		},
 		"ElementKeyInt": {
 			schema:        schema.Schema{},
 			path:          tftypes.NewAttributePath().WithElementKeyInt(0),
@@ -1158,6 +1178,142 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-write-only-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional:  true,
+						Computed:  true,
+						WriteOnly: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" is a WriteOnly Attribute that is also Computed. "+
+						"WriteOnly Attributes must be Optional or Required, as their values are never persisted to plan or state.",
+				),
+			},
+		},
+		"attribute-write-only-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional:  true,
+						WriteOnly: true,
+						Default:   stringdefault.StaticString("test"),
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" is a WriteOnly Attribute that defines a Default. "+
+						"WriteOnly Attributes cannot define a Default, as their values are never persisted to plan or state.",
+				),
+				diag.NewErrorDiagnostic(
+					"Schema Using Attribute Default For Non-Computed Attribute",
+					"Attribute \"test_attr\" must be computed when using default. "+
+						"This is an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"attribute-write-only-nested-child-not-write-only": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test_attr": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional:  true,
+						WriteOnly: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test_attr\" is a child Attribute of a WriteOnly nested Attribute, but is not WriteOnly. "+
+						"All child Attributes of a WriteOnly nested Attribute must also be WriteOnly.",
+				),
+			},
+		},
+		"attribute-write-only-nested-child-write-only": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test_attr": schema.StringAttribute{
+								Optional:  true,
+								WriteOnly: true,
+							},
+						},
+						Optional:  true,
+						WriteOnly: true,
+					},
+				},
+			},
+		},
+		"set-nested-attribute-write-only-child": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"set_nested_attribute": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional:  true,
+									WriteOnly: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"set_nested_attribute\" is a set nested Attribute or Block that contains WriteOnly child Attributes. "+
+						"WriteOnly Attributes are not supported within sets.",
+				),
+			},
+		},
+		"set-nested-block-write-only-child": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"set_nested_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional:  true,
+									WriteOnly: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"set_nested_block\" is a set nested Attribute or Block that contains WriteOnly child Attributes. "+
+						"WriteOnly Attributes are not supported within sets.",
+				),
+			},
+		},
 		"block-using-invalid-field-name": {
 			schema: schema.Schema{
 				Blocks: map[string]schema.Block{
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Implementation handler for a MoveState operation.
+//
+// This is used to encapsulate all move logic from a source resource type,
+// which may be managed by a different provider, to the current resource
+// type and schema when a Resource implements the ResourceWithMoveState
+// interface.
+type StateMover struct {
+	// Schema information for the source resource state. While not required,
+	// setting this will populate the MoveStateRequest type SourceState
+	// field similar to other Resource data types. This allows for easier data
+	// handling such as calling Get() or GetAttribute().
+	//
+	// If not set, source state data is only available in the
+	// MoveStateRequest type SourceRawState field.
+	//
+	// If the source state cannot be decoded with this schema, such as when
+	// the source resource type is unrelated, the StateMover is skipped.
+	SourceSchema *schema.Schema
+
+	// Source resource type name, such as examplecloud_thing, that this
+	// StateMover handles. While not required, setting this will skip calling
+	// the StateMover function when the MoveStateRequest type SourceTypeName
+	// field does not match.
+	//
+	// If not set, the StateMover function is called for any source resource
+	// type and should check the MoveStateRequest type SourceTypeName field.
+	SourceTypeName string
+
+	// Source provider address, such as registry.terraform.io/hashicorp/examplecloud,
+	// that this StateMover handles. While not required, setting this will
+	// skip calling the StateMover function when the MoveStateRequest type
+	// SourceProviderAddress field does not match.
+	//
+	// If not set, the StateMover function is called for any source provider
+	// and should check the MoveStateRequest type SourceProviderAddress field.
+	SourceProviderAddress string
+
+	// Provider defined logic for moving a source resource state into the
+	// current resource type and schema.
+	//
+	// The context.Context parameter contains framework-defined loggers and
+	// supports request cancellation.
+	//
+	// The MoveStateRequest parameter contains the source state data.
+	// If SourceSchema was set, the SourceState field will be available.
+	// Otherwise, the SourceRawState must be used.
+	//
+	// The MoveStateResponse parameter should contain the moved state data
+	// and can be used to signal any logic warnings or errors. If the
+	// TargetState field is not set and no error diagnostics are returned,
+	// the next StateMover is attempted.
+	StateMover func(context.Context, MoveStateRequest, *MoveStateResponse)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeouts contains the framework-provided timeouts block and
+// attribute for resource schemas, which enable practitioners to configure how
+// long create, read, update, and delete operations can take.
+//
+// Add the timeouts to the resource schema with the Block or Attributes
+// functions, then read the configured timeouts in resource methods with a
+// Value field in the resource data model:
+//
+//	type exampleResourceModel struct {
+//		Timeouts timeouts.Value `tfsdk:"timeouts"`
+//	}
+//
+// The CreateContext, ReadContext, UpdateContext, and DeleteContext methods
+// return a context with a deadline for the current operation. When that
+// deadline is exceeded during the Create, Update, or Delete methods, the
+// framework returns an error diagnostic which describes the timeout.
+package timeouts
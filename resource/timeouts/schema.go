@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Opts is used as an argument to Block and Attributes to indicate which
+// timeouts should be included in the schema.
+type Opts struct {
+	// Create enables the create timeout.
+	Create bool
+
+	// Read enables the read timeout.
+	Read bool
+
+	// Update enables the update timeout.
+	Update bool
+
+	// Delete enables the delete timeout.
+	Delete bool
+}
+
+// Block returns a schema.Block containing attributes for each timeout enabled
+// in the given Opts. The block is named "timeouts" by convention and
+// configured as:
+//
+//	timeouts {
+//		create = "60m"
+//	}
+func Block(ctx context.Context, opts Opts) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: timeoutsAttributes(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes(opts),
+			},
+		},
+	}
+}
+
+// BlockAll returns a schema.Block containing attributes for all timeouts.
+func BlockAll(ctx context.Context) schema.Block {
+	return Block(ctx, allOpts())
+}
+
+// Attributes returns a schema.SingleNestedAttribute containing attributes
+// for each timeout enabled in the given Opts. The attribute is named
+// "timeouts" by convention and configured as:
+//
+//	timeouts = {
+//		create = "60m"
+//	}
+//
+// Nested attributes are only compatible with protocol version 6.
+func Attributes(ctx context.Context, opts Opts) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: timeoutsAttributes(opts),
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attributeTypes(opts),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// AttributesAll returns a schema.SingleNestedAttribute containing attributes
+// for all timeouts.
+func AttributesAll(ctx context.Context) schema.Attribute {
+	return Attributes(ctx, allOpts())
+}
+
+// allOpts returns Opts with all timeouts enabled.
+func allOpts() Opts {
+	return Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	}
+}
+
+// attributeNames returns the timeout attribute names enabled in the Opts.
+func attributeNames(opts Opts) []string {
+	var names []string
+
+	if opts.Create {
+		names = append(names, fwtimeouts.AttributeNameCreate)
+	}
+
+	if opts.Read {
+		names = append(names, fwtimeouts.AttributeNameRead)
+	}
+
+	if opts.Update {
+		names = append(names, fwtimeouts.AttributeNameUpdate)
+	}
+
+	if opts.Delete {
+		names = append(names, fwtimeouts.AttributeNameDelete)
+	}
+
+	return names
+}
+
+// attributeTypes returns the object attribute types of the timeouts enabled
+// in the Opts.
+func attributeTypes(opts Opts) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type)
+
+	for _, name := range attributeNames(opts) {
+		attrTypes[name] = types.StringType
+	}
+
+	return attrTypes
+}
+
+// timeoutsAttributes returns the schema attributes of the timeouts enabled in
+// the Opts.
+func timeoutsAttributes(opts Opts) map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute)
+
+	for _, name := range attributeNames(opts) {
+		attributes[name] = schema.StringAttribute{
+			Description: fwtimeouts.AttributeDescription,
+			Optional:    true,
+			Validators: []validator.String{
+				fwtimeouts.DurationValidator{},
+			},
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     timeouts.Opts
+		expected schema.Block
+	}{
+		"empty": {
+			opts: timeouts.Opts{},
+			expected: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{},
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{},
+					},
+				},
+			},
+		},
+		"create-delete": {
+			opts: timeouts.Opts{
+				Create: true,
+				Delete: true,
+			},
+			expected: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Description: fwtimeouts.AttributeDescription,
+						Optional:    true,
+						Validators: []validator.String{
+							fwtimeouts.DurationValidator{},
+						},
+					},
+					"delete": schema.StringAttribute{
+						Description: fwtimeouts.AttributeDescription,
+						Optional:    true,
+						Validators: []validator.String{
+							fwtimeouts.DurationValidator{},
+						},
+					},
+				},
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"create": types.StringType,
+							"delete": types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timeouts.Block(context.Background(), testCase.opts)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBlockAll(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.BlockAll(context.Background())
+
+	block, ok := got.(schema.SingleNestedBlock)
+
+	if !ok {
+		t.Fatalf("expected schema.SingleNestedBlock, got: %T", got)
+	}
+
+	for _, name := range []string{"create", "read", "update", "delete"} {
+		if _, ok := block.Attributes[name]; !ok {
+			t.Errorf("expected %q attribute", name)
+		}
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts     timeouts.Opts
+		expected schema.Attribute
+	}{
+		"empty": {
+			opts: timeouts.Opts{},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{},
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{},
+					},
+				},
+				Optional: true,
+			},
+		},
+		"read-update": {
+			opts: timeouts.Opts{
+				Read:   true,
+				Update: true,
+			},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"read": schema.StringAttribute{
+						Description: fwtimeouts.AttributeDescription,
+						Optional:    true,
+						Validators: []validator.String{
+							fwtimeouts.DurationValidator{},
+						},
+					},
+					"update": schema.StringAttribute{
+						Description: fwtimeouts.AttributeDescription,
+						Optional:    true,
+						Validators: []validator.String{
+							fwtimeouts.DurationValidator{},
+						},
+					},
+				},
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"read":   types.StringType,
+							"update": types.StringType,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timeouts.Attributes(context.Background(), testCase.opts)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributesAll(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.AttributesAll(context.Background())
+
+	attribute, ok := got.(schema.SingleNestedAttribute)
+
+	if !ok {
+		t.Fatalf("expected schema.SingleNestedAttribute, got: %T", got)
+	}
+
+	for _, name := range []string{"create", "read", "update", "delete"} {
+		if _, ok := attribute.Attributes[name]; !ok {
+			t.Errorf("expected %q attribute", name)
+		}
+	}
+}
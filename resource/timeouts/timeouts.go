@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtimeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.ObjectTypable  = Type{}
+	_ basetypes.ObjectValuable = Value{}
+)
+
+// Type is the attribute type of the timeouts block and attribute.
+type Type struct {
+	types.ObjectType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+// String returns a human readable string of the type name.
+func (t Type) String() string {
+	return "timeouts.Type"
+}
+
+// ValueFromObject returns a Value given a basetypes.ObjectValue.
+func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return Value{
+		Object: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := val.(types.Object)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", val)
+	}
+
+	return Value{
+		Object: obj,
+	}, nil
+}
+
+// ValueType returns the Value type.
+func (t Type) ValueType(_ context.Context) attr.Value {
+	return Value{
+		Object: types.ObjectNull(t.AttrTypes),
+	}
+}
+
+// Value is the value of the timeouts block and attribute. Use it as the
+// field type for the timeouts in resource data models.
+type Value struct {
+	types.Object
+}
+
+// Equal returns true if the given value is equivalent.
+func (t Value) Equal(c attr.Value) bool {
+	other, ok := c.(Value)
+
+	if !ok {
+		return false
+	}
+
+	return t.Object.Equal(other.Object)
+}
+
+// Type returns the Type of the value.
+func (t Value) Type(ctx context.Context) attr.Type {
+	return Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: t.AttributeTypes(ctx),
+		},
+	}
+}
+
+// Create returns the configured create timeout, or the given default if the
+// create timeout is not configured.
+func (t Value) Create(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return fwtimeouts.Timeout(ctx, t.Object, fwtimeouts.AttributeNameCreate, defaultTimeout)
+}
+
+// CreateContext returns a context with a deadline of the create timeout,
+// which should be used for the Create method operations. The returned cancel
+// function should be called once the operations complete.
+func (t Value) CreateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return fwtimeouts.Context(ctx, t.Object, fwtimeouts.AttributeNameCreate, defaultTimeout)
+}
+
+// Read returns the configured read timeout, or the given default if the read
+// timeout is not configured.
+func (t Value) Read(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return fwtimeouts.Timeout(ctx, t.Object, fwtimeouts.AttributeNameRead, defaultTimeout)
+}
+
+// ReadContext returns a context with a deadline of the read timeout, which
+// should be used for the Read method operations. The returned cancel function
+// should be called once the operations complete.
+func (t Value) ReadContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return fwtimeouts.Context(ctx, t.Object, fwtimeouts.AttributeNameRead, defaultTimeout)
+}
+
+// Update returns the configured update timeout, or the given default if the
+// update timeout is not configured.
+func (t Value) Update(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return fwtimeouts.Timeout(ctx, t.Object, fwtimeouts.AttributeNameUpdate, defaultTimeout)
+}
+
+// UpdateContext returns a context with a deadline of the update timeout,
+// which should be used for the Update method operations. The returned cancel
+// function should be called once the operations complete.
+func (t Value) UpdateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return fwtimeouts.Context(ctx, t.Object, fwtimeouts.AttributeNameUpdate, defaultTimeout)
+}
+
+// Delete returns the configured delete timeout, or the given default if the
+// delete timeout is not configured.
+func (t Value) Delete(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return fwtimeouts.Timeout(ctx, t.Object, fwtimeouts.AttributeNameDelete, defaultTimeout)
+}
+
+// DeleteContext returns a context with a deadline of the delete timeout,
+// which should be used for the Delete method operations. The returned cancel
+// function should be called once the operations complete.
+func (t Value) DeleteContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return fwtimeouts.Context(ctx, t.Object, fwtimeouts.AttributeNameDelete, defaultTimeout)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testType := timeouts.Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"create": types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		expected attr.Value
+	}{
+		"null": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+				},
+			}, nil),
+			expected: timeouts.Value{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"create": types.StringType,
+				}),
+			},
+		},
+		"value": {
+			value: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "60m"),
+			}),
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("60m"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testType.ValueFromTerraform(context.Background(), testCase.value)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueTimeouts(t *testing.T) {
+	t.Parallel()
+
+	testAttrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+
+	testValue := timeouts.Value{
+		Object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+			"create": types.StringValue("1m"),
+			"read":   types.StringValue("2m"),
+			"update": types.StringValue("3m"),
+			"delete": types.StringNull(),
+		}),
+	}
+
+	testCases := map[string]struct {
+		timeout       func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"create": {
+			timeout:  testValue.Create,
+			expected: time.Minute,
+		},
+		"read": {
+			timeout:  testValue.Read,
+			expected: 2 * time.Minute,
+		},
+		"update": {
+			timeout:  testValue.Update,
+			expected: 3 * time.Minute,
+		},
+		"delete-default": {
+			timeout:  testValue.Delete,
+			expected: 20 * time.Minute,
+		},
+		"null-default": {
+			timeout: timeouts.Value{
+				Object: types.ObjectNull(testAttrTypes),
+			}.Create,
+			expected: 20 * time.Minute,
+		},
+		"invalid": {
+			timeout: timeouts.Value{
+				Object: types.ObjectValueMust(testAttrTypes, map[string]attr.Value{
+					"create": types.StringValue("invalid"),
+					"read":   types.StringNull(),
+					"update": types.StringNull(),
+					"delete": types.StringNull(),
+				}),
+			}.Create,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`The "create" timeout cannot be parsed: time: invalid duration "invalid"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.timeout(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueCreateContext(t *testing.T) {
+	t.Parallel()
+
+	testValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("1h"),
+			},
+		),
+	}
+
+	ctx, cancel, diags := testValue.CreateContext(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	deadline, ok := ctx.Deadline()
+
+	if !ok {
+		t.Fatal("expected context deadline")
+	}
+
+	if remaining := time.Until(deadline); remaining <= 20*time.Minute || remaining > time.Hour {
+		t.Errorf("unexpected context deadline remaining: %s", remaining)
+	}
+}
@@ -18,6 +18,9 @@ type UpdateRequest struct {
 	// This configuration may contain unknown values if a user uses
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
+	//
+	// Config is the only source of write-only attribute values, as those
+	// values are always null in the plan and state.
 	Config tfsdk.Config
 
 	// Plan is the planned state for the resource.
@@ -37,6 +40,11 @@ type UpdateRequest struct {
 	// Use the GetKey method to read data. Use the SetKey method on
 	// UpdateResponse.Private to update or remove a value.
 	Private *privatestate.ProviderData
+
+	// Identity is the current identity of the resource prior to the Update
+	// operation. This field is only populated if the resource implements
+	// ResourceWithIdentity.
+	Identity *tfsdk.ResourceIdentity
 }
 
 // UpdateResponse represents a response to an UpdateRequest. An
@@ -58,4 +66,10 @@ type UpdateResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Identity is the identity of the resource following the Update
+	// operation. This field is pre-populated from UpdateRequest.Identity and
+	// should only be set during the resource's Update operation if it was
+	// null. Once set, the identity must not change.
+	Identity *tfsdk.ResourceIdentity
 }
@@ -19,6 +19,11 @@ type ValidateConfigRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ValidateResourceConfig RPC, such as forward-compatible Terraform
+	// behavior changes.
+	ClientCapabilities ValidateConfigClientCapabilities
 }
 
 // ValidateConfigResponse represents a response to a
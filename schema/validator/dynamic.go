@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package validator
 
 import (
@@ -14,7 +17,7 @@ type Dynamic interface {
 	Describer
 
 	// ValidateDynamic should perform the validation.
-	ValidationDynamic(context.Context, DynamicRequest, *DynamicResponse)
+	ValidateDynamic(context.Context, DynamicRequest, *DynamicResponse)
 }
 
 // DynamicRequest is a request for types.Dynamic schema validation.
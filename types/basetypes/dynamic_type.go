@@ -1,31 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package basetypes
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 // DynamicTypable extends attr.Type for dynamic types.
 // Implement this interface to create a custom DynamicType type.
 type DynamicTypable interface {
 	attr.Type
+
+	// ValueFromDynamic should convert the DynamicValue to a DynamicValuable type.
+	ValueFromDynamic(context.Context, DynamicValue) (DynamicValuable, diag.Diagnostics)
 }
 
 var _ DynamicTypable = DynamicType{}
 
-// DynamicType is the base framework type for a pseudo-dynamic value.
+// DynamicType is the base framework type for a pseudo-dynamic value, whose
+// underlying type is only determined by the configuration or provider logic.
 // DynamicValue is the associated value type.
-type DynamicType struct {
-	inner tftypes.Type
-}
+type DynamicType struct{}
 
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
-// type.
+// type. The underlying type of a dynamic value is not known from the schema,
+// so it is never possible to step further into a DynamicType.
 func (t DynamicType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
-	// WIP
-	return tftypes.DynamicPseudoType, nil
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
 }
 
 // Equal returns true if the given type is equivalent.
@@ -46,19 +54,42 @@ func (t DynamicType) TerraformType(_ context.Context) tftypes.Type {
 	return tftypes.DynamicPseudoType
 }
 
+// ValueFromDynamic returns a DynamicValuable type given a DynamicValue.
+func (t DynamicType) ValueFromDynamic(_ context.Context, v DynamicValue) (DynamicValuable, diag.Diagnostics) {
+	return v, nil
+}
+
 // ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to
 // convert the tftypes.Value into a more convenient Go type for the provider to
 // consume the data with.
+//
+// Values without a concrete type, which Terraform sends for dynamic values
+// that are null or unknown before their type is determined, become a null or
+// unknown DynamicValue. Values with a concrete type, including null and
+// unknown values, are converted into the matching framework value and become
+// the underlying value of a known DynamicValue.
 func (t DynamicType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	if !in.IsKnown() {
-		return NewDynamicUnknown(), nil
-	}
+	if in.Type() == nil || in.Type().Is(tftypes.DynamicPseudoType) {
+		if !in.IsKnown() {
+			return NewDynamicUnknown(), nil
+		}
 
-	if in.IsNull() {
 		return NewDynamicNull(), nil
 	}
 
-	return NewDynamicValue(&in), nil
+	underlyingType, err := tftypeToFrameworkType(in.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	underlyingValue, err := underlyingType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDynamicValue(underlyingValue), nil
 }
 
 // ValueType returns the Value type.
@@ -66,3 +97,84 @@ func (t DynamicType) ValueType(_ context.Context) attr.Value {
 	// This Value does not need to be valid.
 	return DynamicValue{}
 }
+
+// tftypeToFrameworkType returns the framework type equivalent of the given
+// tftypes.Type, for determining the underlying type of a dynamic value.
+func tftypeToFrameworkType(in tftypes.Type) (attr.Type, error) {
+	// Primitive types
+	if in.Is(tftypes.Bool) {
+		return BoolType{}, nil
+	}
+
+	if in.Is(tftypes.Number) {
+		return NumberType{}, nil
+	}
+
+	if in.Is(tftypes.String) {
+		return StringType{}, nil
+	}
+
+	// Nested values, such as the elements of a collection, can still be
+	// dynamic when they are null or unknown.
+	if in.Is(tftypes.DynamicPseudoType) {
+		return DynamicType{}, nil
+	}
+
+	// Collection and structural types
+	switch inType := in.(type) {
+	case tftypes.List:
+		elemType, err := tftypeToFrameworkType(inType.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return ListType{ElemType: elemType}, nil
+	case tftypes.Map:
+		elemType, err := tftypeToFrameworkType(inType.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return MapType{ElemType: elemType}, nil
+	case tftypes.Set:
+		elemType, err := tftypeToFrameworkType(inType.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return SetType{ElemType: elemType}, nil
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(inType.AttributeTypes))
+
+		for name, attrType := range inType.AttributeTypes {
+			frameworkType, err := tftypeToFrameworkType(attrType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[name] = frameworkType
+		}
+
+		return ObjectType{AttrTypes: attrTypes}, nil
+	case tftypes.Tuple:
+		elemTypes := make([]attr.Type, 0, len(inType.ElementTypes))
+
+		for _, elemType := range inType.ElementTypes {
+			frameworkType, err := tftypeToFrameworkType(elemType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elemTypes = append(elemTypes, frameworkType)
+		}
+
+		return TupleType{ElemTypes: elemTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported tftypes.Type in dynamic value: %s", in)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestDynamicTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	_, err := DynamicType{}.ApplyTerraform5AttributePathStep(tftypes.ElementKeyInt(0))
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expectedErr := "cannot apply AttributePathStep tftypes.ElementKeyInt to basetypes.DynamicType"
+
+	if err.Error() != expectedErr {
+		t.Errorf("expected error %q, got %q", expectedErr, err.Error())
+	}
+}
+
+func TestDynamicTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"bool": {
+			input:       tftypes.NewValue(tftypes.Bool, true),
+			expectation: NewDynamicValue(NewBoolValue(true)),
+		},
+		"number": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectation: NewDynamicValue(NewNumberValue(big.NewFloat(123))),
+		},
+		"string": {
+			input:       tftypes.NewValue(tftypes.String, "hello"),
+			expectation: NewDynamicValue(NewStringValue("hello")),
+		},
+		"list": {
+			input: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expectation: NewDynamicValue(NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("hello"),
+			})),
+		},
+		"map": {
+			input: tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.Bool, false),
+			}),
+			expectation: NewDynamicValue(NewMapValueMust(BoolType{}, map[string]attr.Value{
+				"key": NewBoolValue(false),
+			})),
+		},
+		"set": {
+			input: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expectation: NewDynamicValue(NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("hello"),
+			})),
+		},
+		"object": {
+			input: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"string": tftypes.String,
+					"list":   tftypes.List{ElementType: tftypes.Bool},
+				}},
+				map[string]tftypes.Value{
+					"string": tftypes.NewValue(tftypes.String, "hello"),
+					"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.Bool}, nil),
+				},
+			),
+			expectation: NewDynamicValue(NewObjectValueMust(
+				map[string]attr.Type{
+					"string": StringType{},
+					"list":   ListType{ElemType: BoolType{}},
+				},
+				map[string]attr.Value{
+					"string": NewStringValue("hello"),
+					"list":   NewListNull(BoolType{}),
+				},
+			)),
+		},
+		"tuple": {
+			input: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+			expectation: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("hello"), NewBoolValue(true)},
+			)),
+		},
+		"list-dynamic-elements": {
+			input: tftypes.NewValue(tftypes.List{ElementType: tftypes.DynamicPseudoType}, []tftypes.Value{
+				tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			}),
+			expectation: NewDynamicValue(NewListValueMust(DynamicType{}, []attr.Value{
+				NewDynamicUnknown(),
+			})),
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expectation: NewDynamicUnknown(),
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expectation: NewDynamicNull(),
+		},
+		"underlying-unknown": {
+			input:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: NewDynamicValue(NewStringUnknown()),
+		},
+		"underlying-null": {
+			input:       tftypes.NewValue(tftypes.String, nil),
+			expectation: NewDynamicValue(NewStringNull()),
+		},
+		"underlying-null-object": {
+			input: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"string": tftypes.String,
+			}}, nil),
+			expectation: NewDynamicValue(NewObjectNull(map[string]attr.Type{
+				"string": StringType{},
+			})),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DynamicType{}.ValueFromTerraform(context.Background(), test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if test.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", test.expectedErr)
+			}
+
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %s, got %s", test.expectation, got)
+			}
+
+			// Values must round-trip back to the original tftypes.Value.
+			roundTrip, err := got.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if !roundTrip.Equal(test.input) {
+				t.Errorf("Expected round-trip to %s, got %s", test.input, roundTrip)
+			}
+		})
+	}
+}
+
+func TestDynamicTypeValueFromDynamic(t *testing.T) {
+	t.Parallel()
+
+	got, diags := DynamicType{}.ValueFromDynamic(context.Background(), NewDynamicValue(NewStringValue("hello")))
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %s", diags)
+	}
+
+	if !got.Equal(NewDynamicValue(NewStringValue("hello"))) {
+		t.Errorf("Expected %s, got %s", NewDynamicValue(NewStringValue("hello")), got)
+	}
+}
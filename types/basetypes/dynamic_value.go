@@ -1,3 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package basetypes
 
 import (
@@ -17,6 +20,9 @@ var (
 // Implement this interface to create a custom dynamic value type.
 type DynamicValuable interface {
 	attr.Value
+
+	// ToDynamicValue should convert the value type to a Dynamic.
+	ToDynamicValue(ctx context.Context) (DynamicValue, diag.Diagnostics)
 }
 
 // DynamicValuableWithSemanticEquals extends DynamicValuable with semantic
@@ -24,14 +30,14 @@ type DynamicValuable interface {
 type DynamicValuableWithSemanticEquals interface {
 	DynamicValuable
 
-	// DynamicValueSemanticEquals should return true if the given value is
+	// DynamicSemanticEquals should return true if the given value is
 	// semantically equal to the current value. This logic is used to prevent
 	// Terraform data consistency errors and resource drift where a value change
 	// may have inconsequential differences.
 	//
 	// Only known values are compared with this method as changing a value's
 	// state implicitly represents a different value.
-	DynamicValueSemanticEquals(context.Context, DynamicValuable) (bool, diag.Diagnostics)
+	DynamicSemanticEquals(context.Context, DynamicValuable) (bool, diag.Diagnostics)
 }
 
 // NewDynamicNull creates a DynamicValue with a null value. Determine whether the value is
@@ -50,9 +56,12 @@ func NewDynamicUnknown() DynamicValue {
 	}
 }
 
-// NewDynamicValue creates a Dynamic with a known value. If the given value is
-// nil, a null Dynamic is created.
-func NewDynamicValue(value *tftypes.Value) DynamicValue {
+// NewDynamicValue creates a Dynamic with a known value. The given value is
+// the underlying value of the Dynamic, such as a String or an Object, and may
+// itself be null or unknown. If the given value is nil, a null Dynamic is
+// created. Access the underlying value via the Dynamic type UnderlyingValue
+// method.
+func NewDynamicValue(value attr.Value) DynamicValue {
 	if value == nil {
 		return NewDynamicNull()
 	}
@@ -63,32 +72,71 @@ func NewDynamicValue(value *tftypes.Value) DynamicValue {
 	}
 }
 
-// DynamicValue represents a pseudo-dynamic value.
+// DynamicValue represents a pseudo-dynamic value, whose underlying type is
+// only determined by the configuration or provider logic.
+//
+// A DynamicValue has two levels of null and unknown state. The DynamicValue
+// itself can be null or unknown, in which case the underlying type is also
+// not yet determined, and is checked via the IsNull and IsUnknown methods.
+// Otherwise, the underlying value has a known type but may still be null or
+// unknown, which is checked via the IsUnderlyingValueNull and
+// IsUnderlyingValueUnknown methods.
 type DynamicValue struct {
 	// state represents whether the value is null, unknown, or known. The
 	// zero-value is null.
 	state attr.ValueState
 
-	// value contains the known value, if not null or unknown.
-	value *tftypes.Value
+	// value contains the underlying value, if not null or unknown.
+	value attr.Value
+}
+
+// UnderlyingValue returns the underlying value of the DynamicValue, such as a
+// String or an Object. Returns nil if the DynamicValue is null or unknown.
+func (d DynamicValue) UnderlyingValue() attr.Value {
+	return d.value
+}
+
+// UnderlyingType returns the type of the underlying value of the
+// DynamicValue, such as StringType or ObjectType. Returns nil if the
+// DynamicValue is null or unknown.
+func (d DynamicValue) UnderlyingType(ctx context.Context) attr.Type {
+	if d.value == nil {
+		return nil
+	}
+
+	return d.value.Type(ctx)
+}
+
+// IsUnderlyingValueNull returns true if the DynamicValue is known and its
+// underlying value is null. A DynamicValue which is itself null returns
+// false; use IsNull for that check.
+func (d DynamicValue) IsUnderlyingValueNull() bool {
+	return d.value != nil && d.value.IsNull()
+}
+
+// IsUnderlyingValueUnknown returns true if the DynamicValue is known and its
+// underlying value is unknown. A DynamicValue which is itself unknown returns
+// false; use IsUnknown for that check.
+func (d DynamicValue) IsUnderlyingValueUnknown() bool {
+	return d.value != nil && d.value.IsUnknown()
 }
 
 // Type returns a DynamicType.
 func (d DynamicValue) Type(_ context.Context) attr.Type {
-	return DynamicType{
-		inner: d.value.Type(),
-	}
+	return DynamicType{}
 }
 
-// ToTerraformValue returns the data contained in the DynamicValue as a tftypes.Value.
-func (d DynamicValue) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+// ToTerraformValue returns the data contained in the DynamicValue as a
+// tftypes.Value. A known DynamicValue returns the tftypes.Value of its
+// underlying value, with the concrete type of the underlying value.
+func (d DynamicValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	switch d.state {
 	case attr.ValueStateKnown:
 		if d.value == nil {
-			return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+			return tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue), fmt.Errorf("invalid DynamicValue state in ToTerraformValue: known value without an underlying value")
 		}
 
-		return *d.value, nil
+		return d.value.ToTerraformValue(ctx)
 	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
 	case attr.ValueStateUnknown:
@@ -98,7 +146,8 @@ func (d DynamicValue) ToTerraformValue(_ context.Context) (tftypes.Value, error)
 	}
 }
 
-// Equal returns true if `other` is a DynamicValue and has the same value as `d`.
+// Equal returns true if `other` is a DynamicValue, has the same value state,
+// and has an underlying value equal to the underlying value of `d`.
 func (d DynamicValue) Equal(other attr.Value) bool {
 	o, ok := other.(DynamicValue)
 
@@ -118,7 +167,12 @@ func (d DynamicValue) Equal(other attr.Value) bool {
 		return d.value == o.value
 	}
 
-	return d.value.Equal(*o.value)
+	return d.value.Equal(o.value)
+}
+
+// ToDynamicValue returns Dynamic.
+func (d DynamicValue) ToDynamicValue(context.Context) (DynamicValue, diag.Diagnostics) {
+	return d, nil
 }
 
 // IsNull returns true if the DynamicValue represents a null value.
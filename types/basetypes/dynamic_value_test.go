@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestDynamicValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation tftypes.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"known-string": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			expectation: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"known-list": {
+			input: NewDynamicValue(NewListValueMust(BoolType{}, []attr.Value{
+				NewBoolValue(true),
+			})),
+			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.Bool}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+		},
+		"known-tuple": {
+			input: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("test"), NewBoolValue(true)},
+			)),
+			expectation: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test"),
+					tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+		},
+		"underlying-unknown": {
+			input:       NewDynamicValue(NewStringUnknown()),
+			expectation: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"underlying-null": {
+			input:       NewDynamicValue(NewStringNull()),
+			expectation: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			input:       NewDynamicUnknown(),
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		},
+		"known-missing-underlying-value": {
+			input:       DynamicValue{state: attr.ValueStateKnown},
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expectedErr: "invalid DynamicValue state in ToTerraformValue: known value without an underlying value",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.input.ToTerraformValue(context.Background())
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if test.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+			} else if test.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", test.expectedErr)
+			}
+
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %s, got %s", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-known-same": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicValue(NewStringValue("test")),
+			expectation: true,
+		},
+		"known-known-diff": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicValue(NewStringValue("not-test")),
+			expectation: false,
+		},
+		"known-known-diff-type": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicValue(NewBoolValue(true)),
+			expectation: false,
+		},
+		"known-underlying-null": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicValue(NewStringNull()),
+			expectation: false,
+		},
+		"underlying-null-null": {
+			input:       NewDynamicValue(NewStringNull()),
+			candidate:   NewDynamicNull(),
+			expectation: false,
+		},
+		"underlying-unknown-unknown": {
+			input:       NewDynamicValue(NewStringUnknown()),
+			candidate:   NewDynamicUnknown(),
+			expectation: false,
+		},
+		"known-unknown": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicUnknown(),
+			expectation: false,
+		},
+		"known-null": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewDynamicNull(),
+			expectation: false,
+		},
+		"known-underlying": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			candidate:   NewStringValue("test"),
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       NewDynamicUnknown(),
+			candidate:   NewDynamicUnknown(),
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       NewDynamicUnknown(),
+			candidate:   NewDynamicNull(),
+			expectation: false,
+		},
+		"null-null": {
+			input:       NewDynamicNull(),
+			candidate:   NewDynamicNull(),
+			expectation: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if got != test.expectation {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueUnderlyingValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input                     DynamicValue
+		expectedValue             attr.Value
+		expectedType              attr.Type
+		expectedUnderlyingNull    bool
+		expectedUnderlyingUnknown bool
+	}
+	tests := map[string]testCase{
+		"known": {
+			input:         NewDynamicValue(NewStringValue("test")),
+			expectedValue: NewStringValue("test"),
+			expectedType:  StringType{},
+		},
+		"underlying-null": {
+			input:                  NewDynamicValue(NewStringNull()),
+			expectedValue:          NewStringNull(),
+			expectedType:           StringType{},
+			expectedUnderlyingNull: true,
+		},
+		"underlying-unknown": {
+			input:                     NewDynamicValue(NewStringUnknown()),
+			expectedValue:             NewStringUnknown(),
+			expectedType:              StringType{},
+			expectedUnderlyingUnknown: true,
+		},
+		"null": {
+			input: NewDynamicNull(),
+		},
+		"unknown": {
+			input: NewDynamicUnknown(),
+		},
+		"nil": {
+			input: NewDynamicValue(nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(test.input.UnderlyingValue(), test.expectedValue); diff != "" {
+				t.Errorf("Unexpected UnderlyingValue difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(test.input.UnderlyingType(context.Background()), test.expectedType); diff != "" {
+				t.Errorf("Unexpected UnderlyingType difference: %s", diff)
+			}
+
+			if got := test.input.IsUnderlyingValueNull(); got != test.expectedUnderlyingNull {
+				t.Errorf("Expected IsUnderlyingValueNull %t, got %t", test.expectedUnderlyingNull, got)
+			}
+
+			if got := test.input.IsUnderlyingValueUnknown(); got != test.expectedUnderlyingUnknown {
+				t.Errorf("Expected IsUnderlyingValueUnknown %t, got %t", test.expectedUnderlyingUnknown, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation string
+	}
+	tests := map[string]testCase{
+		"known": {
+			input:       NewDynamicValue(NewStringValue("test")),
+			expectation: `"test"`,
+		},
+		"underlying-null": {
+			input:       NewDynamicValue(NewStringNull()),
+			expectation: "<null>",
+		},
+		"unknown": {
+			input:       NewDynamicUnknown(),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: "<null>",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if got != test.expectation {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
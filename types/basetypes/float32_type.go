@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Float32Typable extends attr.Type for float32 types.
+// Implement this interface to create a custom Float32Type type.
+type Float32Typable interface {
+	xattr.TypeWithValidate
+
+	// ValueFromFloat32 should convert the Float32 to a Float32Valuable type.
+	ValueFromFloat32(context.Context, Float32Value) (Float32Valuable, diag.Diagnostics)
+}
+
+var _ Float32Typable = Float32Type{}
+
+// Float32Type is the base framework type for a floating point number.
+// Float32Value is the associated value type.
+type Float32Type struct{}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t Float32Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Float32Type) Equal(o attr.Type) bool {
+	_, ok := o.(Float32Type)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t Float32Type) String() string {
+	return "basetypes.Float32Type"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// framework type.
+func (t Float32Type) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.Number
+}
+
+// Validate implements type validation.
+func (t Float32Type) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+		return diags
+	}
+
+	float32Value, accuracy := value.Float32()
+
+	// Underflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float32
+	if float32Value == 0 && accuracy != big.Exact {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as a 32-bit floating point.", value),
+		)
+		return diags
+	}
+
+	// Overflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float32
+	if math.IsInf(float64(float32Value), 0) {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as a 32-bit floating point.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// ValueFromFloat32 returns a Float32Valuable type given a Float32Value.
+func (t Float32Type) ValueFromFloat32(_ context.Context, v Float32Value) (Float32Valuable, diag.Diagnostics) {
+	return v, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t Float32Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return NewFloat32Unknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewFloat32Null(), nil
+	}
+
+	var bigF *big.Float
+	err := in.As(&bigF)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f, accuracy := bigF.Float32()
+
+	// Underflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float32
+	if f == 0 && accuracy != big.Exact {
+		return nil, fmt.Errorf("Value %s cannot be represented as a 32-bit floating point.", bigF)
+	}
+
+	// Overflow
+	// Reference: https://pkg.go.dev/math/big#Float.Float32
+	if math.IsInf(float64(f), 0) {
+		return nil, fmt.Errorf("Value %s cannot be represented as a 32-bit floating point.", bigF)
+	}
+
+	return NewFloat32Value(f), nil
+}
+
+// ValueType returns the Value type.
+func (t Float32Type) ValueType(_ context.Context) attr.Value {
+	// This Value does not need to be valid.
+	return Float32Value{}
+}
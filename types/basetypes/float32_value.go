@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var (
+	_ Float32Valuable = Float32Value{}
+)
+
+// Float32Valuable extends attr.Value for float32 value types.
+// Implement this interface to create a custom Float32 value type.
+type Float32Valuable interface {
+	attr.Value
+
+	// ToFloat32Value should convert the value type to a Float32.
+	ToFloat32Value(ctx context.Context) (Float32Value, diag.Diagnostics)
+}
+
+// Float32ValuableWithSemanticEquals extends Float32Valuable with semantic
+// equality logic.
+type Float32ValuableWithSemanticEquals interface {
+	Float32Valuable
+
+	// Float32SemanticEquals should return true if the given value is
+	// semantically equal to the current value. This logic is used to prevent
+	// Terraform data consistency errors and resource drift where a value change
+	// may have inconsequential differences, such as rounding.
+	//
+	// Only known values are compared with this method as changing a value's
+	// state implicitly represents a different value.
+	Float32SemanticEquals(context.Context, Float32Valuable) (bool, diag.Diagnostics)
+}
+
+// NewFloat32Null creates a Float32 with a null value. Determine whether the value is
+// null via the Float32 type IsNull method.
+func NewFloat32Null() Float32Value {
+	return Float32Value{
+		state: attr.ValueStateNull,
+	}
+}
+
+// NewFloat32Unknown creates a Float32 with an unknown value. Determine whether the
+// value is unknown via the Float32 type IsUnknown method.
+func NewFloat32Unknown() Float32Value {
+	return Float32Value{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// NewFloat32Value creates a Float32 with a known value. Access the value via the Float32
+// type ValueFloat32 method.
+func NewFloat32Value(value float32) Float32Value {
+	return Float32Value{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// NewFloat32PointerValue creates a Float32 with a null value if nil or a known
+// value. Access the value via the Float32 type ValueFloat32Pointer method.
+func NewFloat32PointerValue(value *float32) Float32Value {
+	if value == nil {
+		return NewFloat32Null()
+	}
+
+	return NewFloat32Value(*value)
+}
+
+// Float32Value represents a 32-bit floating point value, exposed as a float32.
+type Float32Value struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value float32
+}
+
+// Equal returns true if `other` is a Float32 and has the same value as `f`.
+func (f Float32Value) Equal(other attr.Value) bool {
+	o, ok := other.(Float32Value)
+
+	if !ok {
+		return false
+	}
+
+	if f.state != o.state {
+		return false
+	}
+
+	if f.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return f.value == o.value
+}
+
+// ToTerraformValue returns the data contained in the Float32 as a tftypes.Value.
+func (f Float32Value) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	switch f.state {
+	case attr.ValueStateKnown:
+		// Convert using the shortest decimal representation of the 32-bit
+		// value, so values such as 1.1 are not sent to Terraform with the
+		// additional precision of a 64-bit conversion (1.100000023841858).
+		bf, _, err := big.ParseFloat(strconv.FormatFloat(float64(f.value), 'g', -1, 32), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Float32 state in ToTerraformValue: %s", f.state))
+	}
+}
+
+// Type returns a Float32Type.
+func (f Float32Value) Type(ctx context.Context) attr.Type {
+	return Float32Type{}
+}
+
+// IsNull returns true if the Float32 represents a null value.
+func (f Float32Value) IsNull() bool {
+	return f.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Float32 represents a currently unknown value.
+func (f Float32Value) IsUnknown() bool {
+	return f.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Float32 value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (f Float32Value) String() string {
+	if f.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if f.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%f", f.value)
+}
+
+// ValueFloat32 returns the known float32 value. If Float32 is null or unknown, returns
+// 0.0.
+func (f Float32Value) ValueFloat32() float32 {
+	return f.value
+}
+
+// ValueFloat32Pointer returns a pointer to the known float32 value, nil for a
+// null value, or a pointer to 0.0 for an unknown value.
+func (f Float32Value) ValueFloat32Pointer() *float32 {
+	if f.IsNull() {
+		return nil
+	}
+
+	return &f.value
+}
+
+// ToFloat32Value returns Float32.
+func (f Float32Value) ToFloat32Value(context.Context) (Float32Value, diag.Diagnostics) {
+	return f, nil
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFloat32ValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32Value
+		expectation interface{}
+	}
+	tests := map[string]testCase{
+		"known-int": {
+			input:       NewFloat32Value(123),
+			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.0)),
+		},
+		"known-float": {
+			input:       NewFloat32Value(123.456),
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("123.456")),
+		},
+		"known-float-shortest-decimal": {
+			input:       NewFloat32Value(1.1),
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("1.1")),
+		},
+		"unknown": {
+			input:       NewFloat32Unknown(),
+			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       NewFloat32Null(),
+			expectation: tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32Value
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-known-same": {
+			input:       NewFloat32Value(123),
+			candidate:   NewFloat32Value(123),
+			expectation: true,
+		},
+		"known-known-diff": {
+			input:       NewFloat32Value(123),
+			candidate:   NewFloat32Value(456),
+			expectation: false,
+		},
+		"known-unknown": {
+			input:       NewFloat32Value(123),
+			candidate:   NewFloat32Unknown(),
+			expectation: false,
+		},
+		"known-null": {
+			input:       NewFloat32Value(123),
+			candidate:   NewFloat32Null(),
+			expectation: false,
+		},
+		"unknown-value": {
+			input:       NewFloat32Unknown(),
+			candidate:   NewFloat32Value(123),
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       NewFloat32Unknown(),
+			candidate:   NewFloat32Unknown(),
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       NewFloat32Unknown(),
+			candidate:   NewFloat32Null(),
+			expectation: false,
+		},
+		"null-known": {
+			input:       NewFloat32Null(),
+			candidate:   NewFloat32Value(123),
+			expectation: false,
+		},
+		"null-unknown": {
+			input:       NewFloat32Null(),
+			candidate:   NewFloat32Unknown(),
+			expectation: false,
+		},
+		"null-null": {
+			input:       NewFloat32Null(),
+			candidate:   NewFloat32Null(),
+			expectation: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float32Value
+		expected bool
+	}{
+		"known": {
+			input:    NewFloat32Value(2.4),
+			expected: false,
+		},
+		"null": {
+			input:    NewFloat32Null(),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewFloat32Unknown(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float32Value
+		expected bool
+	}{
+		"known": {
+			input:    NewFloat32Value(2.4),
+			expected: false,
+		},
+		"null": {
+			input:    NewFloat32Null(),
+			expected: false,
+		},
+		"unknown": {
+			input:    NewFloat32Unknown(),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32Value
+		expectation string
+	}
+	tests := map[string]testCase{
+		"less-than-one": {
+			input:       NewFloat32Value(0.12340984302980000),
+			expectation: "0.123410",
+		},
+		"more-than-one": {
+			input:       NewFloat32Value(92387938173219.327663),
+			expectation: "92387934208000.000000",
+		},
+		"negative-more-than-one": {
+			input:       NewFloat32Value(-0.12340984302980000),
+			expectation: "-0.123410",
+		},
+		"negative-less-than-one": {
+			input:       NewFloat32Value(-92387938173219.327663),
+			expectation: "-92387934208000.000000",
+		},
+		"min-float32": {
+			input:       NewFloat32Value(math.SmallestNonzeroFloat32),
+			expectation: "0.000000",
+		},
+		"max-float32": {
+			input:       NewFloat32Value(math.MaxFloat32),
+			expectation: "340282346638528859811704183484516925440.000000",
+		},
+		"unknown": {
+			input:       NewFloat32Unknown(),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewFloat32Null(),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       Float32Value{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueValueFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float32Value
+		expected float32
+	}{
+		"known": {
+			input:    NewFloat32Value(2.4),
+			expected: 2.4,
+		},
+		"null": {
+			input:    NewFloat32Null(),
+			expected: 0.0,
+		},
+		"unknown": {
+			input:    NewFloat32Unknown(),
+			expected: 0.0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueFloat32()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueValueFloat32Pointer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float32Value
+		expected *float32
+	}{
+		"known": {
+			input:    NewFloat32Value(2.4),
+			expected: pointer(float32(2.4)),
+		},
+		"null": {
+			input:    NewFloat32Null(),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewFloat32Unknown(),
+			expected: pointer(float32(0.0)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueFloat32Pointer()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFloat32PointerValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    *float32
+		expected Float32Value
+	}{
+		"nil": {
+			value:    nil,
+			expected: NewFloat32Null(),
+		},
+		"value": {
+			value:    pointer(float32(1.2)),
+			expected: NewFloat32Value(1.2),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewFloat32PointerValue(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestTupleTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := TupleType{ElemTypes: []attr.Type{StringType{}, ListType{ElemType: BoolType{}}}}.TerraformType(context.Background())
+	expected := tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.List{ElementType: tftypes.Bool}}}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected difference: %s", diff)
+	}
+}
+
+func TestTupleTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tupleType := TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}}
+	tftypesTupleType := tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}
+
+	type testCase struct {
+		receiver    TupleType
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"tuple": {
+			receiver: tupleType,
+			input: tftypes.NewValue(tftypesTupleType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expected: NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("hello"), NewBoolValue(true)},
+			),
+		},
+		"unknown": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(tftypesTupleType, tftypes.UnknownValue),
+			expected: NewTupleUnknown([]attr.Type{StringType{}, BoolType{}}),
+		},
+		"null": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(tftypesTupleType, nil),
+			expected: NewTupleNull([]attr.Type{StringType{}, BoolType{}}),
+		},
+		"nil-type": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(nil, nil),
+			expected: NewTupleNull([]attr.Type{StringType{}, BoolType{}}),
+		},
+		"wrong-type": {
+			receiver:    tupleType,
+			input:       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			expectedErr: "expected tftypes.Tuple[tftypes.String, tftypes.Bool], got tftypes.List[tftypes.String]",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.receiver.ValueFromTerraform(context.Background(), test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if test.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", test.expectedErr)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver TupleType
+		input    attr.Type
+		expected bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}},
+			input:    TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}},
+			expected: true,
+		},
+		"different-order": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}},
+			input:    TupleType{ElemTypes: []attr.Type{BoolType{}, StringType{}}},
+			expected: false,
+		},
+		"different-length": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}},
+			input:    TupleType{ElemTypes: []attr.Type{StringType{}}},
+			expected: false,
+		},
+		"wrong-type": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}}},
+			input:    ListType{ElemType: StringType{}},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.Equal(test.input)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver    TupleType
+		input       tftypes.AttributePathStep
+		expected    interface{}
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"ElementKeyInt": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}, BoolType{}}},
+			input:    tftypes.ElementKeyInt(1),
+			expected: BoolType{},
+		},
+		"ElementKeyInt-out-of-range": {
+			receiver:    TupleType{ElemTypes: []attr.Type{StringType{}}},
+			input:       tftypes.ElementKeyInt(1),
+			expectedErr: "no element defined at index 1 in TupleType",
+		},
+		"AttributeName": {
+			receiver:    TupleType{ElemTypes: []attr.Type{StringType{}}},
+			input:       tftypes.AttributeName("test"),
+			expectedErr: "cannot apply step tftypes.AttributeName to TupleType",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.receiver.ApplyTerraform5AttributePathStep(test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if test.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", test.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("Unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ attr.Value = TupleValue{}
+
+// NewTupleNull creates a Tuple with a null value. Determine whether the value is
+// null via the Tuple type IsNull method.
+func NewTupleNull(elementTypes []attr.Type) TupleValue {
+	return TupleValue{
+		elementTypes: elementTypes,
+		state:        attr.ValueStateNull,
+	}
+}
+
+// NewTupleUnknown creates a Tuple with an unknown value. Determine whether the
+// value is unknown via the Tuple type IsUnknown method.
+func NewTupleUnknown(elementTypes []attr.Type) TupleValue {
+	return TupleValue{
+		elementTypes: elementTypes,
+		state:        attr.ValueStateUnknown,
+	}
+}
+
+// NewTupleValue creates a Tuple with a known value. Access the value via the
+// Tuple type Elements method.
+func NewTupleValue(elementTypes []attr.Type, elements []attr.Value) (TupleValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	if len(elementTypes) != len(elements) {
+		diags.AddError(
+			"Invalid Tuple Elements",
+			"While creating a Tuple value, a mismatch between the number of element types and elements was detected. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Tuple Element Type Count: %d\n", len(elementTypes))+
+				fmt.Sprintf("Tuple Element Count: %d", len(elements)),
+		)
+
+		return NewTupleUnknown(elementTypes), diags
+	}
+
+	for idx, element := range elements {
+		if !elementTypes[idx].Equal(element.Type(ctx)) {
+			diags.AddError(
+				"Invalid Tuple Element Type",
+				"While creating a Tuple value, an invalid element was detected. "+
+					"A Tuple must use the element type defined at each index. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Tuple Index (%d) Expected Type: %s\n", idx, elementTypes[idx])+
+					fmt.Sprintf("Tuple Index (%d) Given Type: %s", idx, element.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewTupleUnknown(elementTypes), diags
+	}
+
+	return TupleValue{
+		elementTypes: elementTypes,
+		elements:     elements,
+		state:        attr.ValueStateKnown,
+	}, nil
+}
+
+// NewTupleValueMust creates a Tuple with a known value, converting any
+// diagnostics into a panic at runtime. Access the value via the Tuple type
+// Elements method.
+//
+// This creation function is only recommended to create Tuple values which will
+// not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func NewTupleValueMust(elementTypes []attr.Type, elements []attr.Value) TupleValue {
+	tuple, diags := NewTupleValue(elementTypes, elements)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewTupleValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return tuple
+}
+
+// TupleValue represents an ordered collection of attr.Value, where each
+// element may be of a different type, indicated by the element types.
+type TupleValue struct {
+	// elements is the ordered list of known values in the Tuple.
+	elements []attr.Value
+
+	// elementTypes is the ordered list of types for each element in the Tuple.
+	elementTypes []attr.Type
+
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+}
+
+// Elements returns a copy of the ordered list of elements for the Tuple.
+func (v TupleValue) Elements() []attr.Value {
+	// Ensure callers cannot mutate the internal elements
+	result := make([]attr.Value, 0, len(v.elements))
+	result = append(result, v.elements...)
+
+	return result
+}
+
+// ElementTypes returns the ordered list of element types for the Tuple.
+func (v TupleValue) ElementTypes(_ context.Context) []attr.Type {
+	return v.elementTypes
+}
+
+// Type returns a TupleType with the same element types as `v`.
+func (v TupleValue) Type(ctx context.Context) attr.Type {
+	return TupleType{ElemTypes: v.ElementTypes(ctx)}
+}
+
+// ToTerraformValue returns the data contained in the Tuple as a tftypes.Value.
+func (v TupleValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	tupleType := v.Type(ctx).TerraformType(ctx)
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make([]tftypes.Value, 0, len(v.elements))
+
+		for _, elem := range v.elements {
+			val, err := elem.ToTerraformValue(ctx)
+
+			if err != nil {
+				return tftypes.NewValue(tupleType, tftypes.UnknownValue), err
+			}
+
+			vals = append(vals, val)
+		}
+
+		if err := tftypes.ValidateValue(tupleType, vals); err != nil {
+			return tftypes.NewValue(tupleType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tupleType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tupleType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tupleType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Tuple state in ToTerraformValue: %s", v.state))
+	}
+}
+
+// Equal returns true if the given attr.Value is also a TupleValue, has the
+// same element types, same value state, and contains exactly the element
+// values as defined by the Equal method of each element type.
+func (v TupleValue) Equal(o attr.Value) bool {
+	other, ok := o.(TupleValue)
+
+	if !ok {
+		return false
+	}
+
+	if !v.Type(context.Background()).Equal(other.Type(context.Background())) {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if len(v.elements) != len(other.elements) {
+		return false
+	}
+
+	for idx, vElem := range v.elements {
+		if !vElem.Equal(other.elements[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsNull returns true if the Tuple represents a null value.
+func (v TupleValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Tuple represents a currently unknown value.
+// Returns false if the Tuple has a known number of elements, even if all are
+// unknown values.
+func (v TupleValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Tuple value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (v TupleValue) String() string {
+	if v.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if v.IsNull() {
+		return attr.NullValueString
+	}
+
+	var res strings.Builder
+
+	res.WriteString("[")
+	for i, e := range v.Elements() {
+		if i != 0 {
+			res.WriteString(",")
+		}
+		res.WriteString(e.String())
+	}
+	res.WriteString("]")
+
+	return res.String()
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestNewTupleValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		elementTypes  []attr.Type
+		elements      []attr.Value
+		expected      TupleValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"valid": {
+			elementTypes: []attr.Type{StringType{}, BoolType{}},
+			elements:     []attr.Value{NewStringValue("test"), NewBoolValue(true)},
+			expected: TupleValue{
+				elementTypes: []attr.Type{StringType{}, BoolType{}},
+				elements:     []attr.Value{NewStringValue("test"), NewBoolValue(true)},
+				state:        attr.ValueStateKnown,
+			},
+		},
+		"invalid-element-type": {
+			elementTypes: []attr.Type{StringType{}},
+			elements:     []attr.Value{NewBoolValue(true)},
+			expected:     NewTupleUnknown([]attr.Type{StringType{}}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Element Type",
+					"While creating a Tuple value, an invalid element was detected. "+
+						"A Tuple must use the element type defined at each index. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Tuple Index (0) Expected Type: basetypes.StringType\n"+
+						"Tuple Index (0) Given Type: basetypes.BoolType",
+				),
+			},
+		},
+		"invalid-element-count": {
+			elementTypes: []attr.Type{StringType{}, BoolType{}},
+			elements:     []attr.Value{NewStringValue("test")},
+			expected:     NewTupleUnknown([]attr.Type{StringType{}, BoolType{}}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Elements",
+					"While creating a Tuple value, a mismatch between the number of element types and elements was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Tuple Element Type Count: 2\n"+
+						"Tuple Element Count: 1",
+				),
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewTupleValue(test.elementTypes, test.elements)
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	tftypesTupleType := tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}
+
+	type testCase struct {
+		input       TupleValue
+		expectation tftypes.Value
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("test"), NewBoolNull()},
+			),
+			expectation: tftypes.NewValue(tftypesTupleType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test"),
+				tftypes.NewValue(tftypes.Bool, nil),
+			}),
+		},
+		"unknown": {
+			input:       NewTupleUnknown([]attr.Type{StringType{}, BoolType{}}),
+			expectation: tftypes.NewValue(tftypesTupleType, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       NewTupleNull([]attr.Type{StringType{}, BoolType{}}),
+			expectation: tftypes.NewValue(tftypesTupleType, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.input.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %s, got %s", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestTupleValueEqual(t *testing.T) {
+	t.Parallel()
+
+	elementTypes := []attr.Type{StringType{}, BoolType{}}
+
+	type testCase struct {
+		input       TupleValue
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-known-same": {
+			input:       NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(true)}),
+			candidate:   NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(true)}),
+			expectation: true,
+		},
+		"known-known-diff-value": {
+			input:       NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(true)}),
+			candidate:   NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(false)}),
+			expectation: false,
+		},
+		"known-known-diff-type": {
+			input:       NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(true)}),
+			candidate:   NewTupleValueMust([]attr.Type{StringType{}}, []attr.Value{NewStringValue("test")}),
+			expectation: false,
+		},
+		"known-unknown": {
+			input:       NewTupleValueMust(elementTypes, []attr.Value{NewStringValue("test"), NewBoolValue(true)}),
+			candidate:   NewTupleUnknown(elementTypes),
+			expectation: false,
+		},
+		"null-null": {
+			input:       NewTupleNull(elementTypes),
+			candidate:   NewTupleNull(elementTypes),
+			expectation: true,
+		},
+		"known-list": {
+			input:       NewTupleValueMust([]attr.Type{StringType{}}, []attr.Value{NewStringValue("test")}),
+			candidate:   NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expectation: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if got != test.expectation {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestTupleValueString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       TupleValue
+		expectation string
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("test"), NewBoolValue(true)},
+			),
+			expectation: `["test",true]`,
+		},
+		"unknown": {
+			input:       NewTupleUnknown([]attr.Type{StringType{}}),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewTupleNull([]attr.Type{StringType{}}),
+			expectation: "<null>",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if got != test.expectation {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package types
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 type Dynamic = basetypes.DynamicValue
@@ -19,8 +22,9 @@ func DynamicUnknown() basetypes.DynamicValue {
 	return basetypes.NewDynamicUnknown()
 }
 
-// DynamicValue creates a DynamicValue with a known value. If the given value
-// is nil, a null DynamicValue is created.
-func DynamicValue(value *tftypes.Value) basetypes.DynamicValue {
+// DynamicValue creates a DynamicValue with a known underlying value, such as a
+// String or an Object. If the given value is nil, a null DynamicValue is
+// created.
+func DynamicValue(value attr.Value) basetypes.DynamicValue {
 	return basetypes.NewDynamicValue(value)
 }